@@ -51,8 +51,11 @@ val services = mapOf(
     "computeoptimizer" to ServiceSpec("Compute Optimizer"),
     "configservice" to ServiceSpec("Config"),
     "connect" to ServiceSpec("Connect"),
+    "connectcampaigns" to ServiceSpec("Connect Campaigns"),
+    "connectcases" to ServiceSpec("Connect Cases"),
     "controltower" to ServiceSpec("Control Tower"),
     "cur" to ServiceSpec("Cost and Usage Report", regionOverride = "us-east-1"),
+    "customerprofiles" to ServiceSpec("Connect Customer Profiles"),
     "dataexchange" to ServiceSpec("Data Exchange"),
     "datapipeline" to ServiceSpec("Data Pipeline"),
     "datasync" to ServiceSpec("DataSync", vpcLock = true),
@@ -141,6 +144,7 @@ val services = mapOf(
     "pinpoint" to ServiceSpec("Pinpoint"),
     "pipes" to ServiceSpec("EventBridge Pipes"),
     "pricing" to ServiceSpec("Pricing Calculator", regionOverride = "us-east-1"),
+    "qconnect" to ServiceSpec("Q in Connect"),
     "qldb" to ServiceSpec("QLDB (Quantum Ledger Database)"),
     "quicksight" to ServiceSpec("QuickSight"),
     "ram" to ServiceSpec("RAM (Resource Access Manager)"),
@@ -191,10 +195,12 @@ val services = mapOf(
     "timestreamwrite" to ServiceSpec("Timestream Write"),
     "transcribe" to ServiceSpec("Transcribe"),
     "transfer" to ServiceSpec("Transfer Family", vpcLock = true),
+    "voiceid" to ServiceSpec("Connect Voice ID"),
     "vpclattice" to ServiceSpec("VPC Lattice"),
     "waf" to ServiceSpec("WAF Classic", regionOverride = "us-east-1"),
     "wafregional" to ServiceSpec("WAF Classic Regional"),
     "wafv2" to ServiceSpec("WAF"),
+    "wisdom" to ServiceSpec("Connect Wisdom"),
     "worklink" to ServiceSpec("WorkLink"),
     "workspaces" to ServiceSpec("WorkSpaces", vpcLock = true),
     "xray" to ServiceSpec("X-Ray"),
@@ -69,6 +69,8 @@ variable "service_labels" {
     "computeoptimizer",
     "configservice",
     "connect",
+    "connectcampaigns",
+    "connectcases",
     "connectcontactlens",
     "connectparticipant",
     "controltower",
@@ -186,7 +188,6 @@ variable "service_labels" {
     "lookoutmetrics",
     "lookoutvision",
     "machinelearning",
-    "macie",
     "macie2",
     "managedblockchain",
     "marketplacecatalog",
@@ -235,6 +236,7 @@ variable "service_labels" {
     "polly",
     "pricing",
     "proton",
+    "qconnect",
     "qldb",
     "qldbsession",
     "quicksight",
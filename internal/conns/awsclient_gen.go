@@ -13,6 +13,9 @@ import (
 	cloudwatchlogs_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go-v2/service/comprehend"
 	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	connect_sdkv2 "github.com/aws/aws-sdk-go-v2/service/connect"
+	connectcases_sdkv2 "github.com/aws/aws-sdk-go-v2/service/connectcases"
+	customerprofiles_sdkv2 "github.com/aws/aws-sdk-go-v2/service/customerprofiles"
 	directoryservice_sdkv2 "github.com/aws/aws-sdk-go-v2/service/directoryservice"
 	"github.com/aws/aws-sdk-go-v2/service/docdbelastic"
 	ec2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -108,6 +111,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/comprehendmedical"
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/aws/aws-sdk-go/service/connectcampaigns"
+	"github.com/aws/aws-sdk-go/service/connectcases"
 	"github.com/aws/aws-sdk-go/service/connectcontactlens"
 	"github.com/aws/aws-sdk-go/service/connectparticipant"
 	"github.com/aws/aws-sdk-go/service/connectwisdomservice"
@@ -212,7 +217,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/lookoutforvision"
 	"github.com/aws/aws-sdk-go/service/lookoutmetrics"
 	"github.com/aws/aws-sdk-go/service/machinelearning"
-	"github.com/aws/aws-sdk-go/service/macie"
 	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/aws/aws-sdk-go/service/managedblockchain"
 	"github.com/aws/aws-sdk-go/service/managedgrafana"
@@ -258,6 +262,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/pricing"
 	"github.com/aws/aws-sdk-go/service/prometheusservice"
 	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/aws/aws-sdk-go/service/qconnect"
 	"github.com/aws/aws-sdk-go/service/qldb"
 	"github.com/aws/aws-sdk-go/service/qldbsession"
 	"github.com/aws/aws-sdk-go/service/quicksight"
@@ -332,27 +337,32 @@ import (
 )
 
 type AWSClient struct {
-	AccountID               string
-	DefaultTagsConfig       *tftags.DefaultConfig
-	DNSSuffix               string
-	IgnoreTagsConfig        *tftags.IgnoreConfig
-	MediaConvertAccountConn *mediaconvert.MediaConvert
-	Partition               string
-	Region                  string
-	ReverseDNSPrefix        string
-	ServicePackages         map[string]ServicePackage
-	Session                 *session.Session
-	TerraformVersion        string
+	AccountID                string
+	ConnectDefaultInstanceID string
+	DefaultTagsConfig        *tftags.DefaultConfig
+	DNSSuffix                string
+	IgnoreTagsConfig         *tftags.IgnoreConfig
+	MediaConvertAccountConn  *mediaconvert.MediaConvert
+	Partition                string
+	Region                   string
+	ReverseDNSPrefix         string
+	ServicePackages          map[string]ServicePackage
+	Session                  *session.Session
+	TerraformVersion         string
 
 	httpClient *http.Client
-
-	dsClient        lazyClient[*directoryservice_sdkv2.Client]
-	ec2Client       lazyClient[*ec2_sdkv2.Client]
-	lambdaClient    lazyClient[*lambda_sdkv2.Client]
-	logsClient      lazyClient[*cloudwatchlogs_sdkv2.Client]
-	rdsClient       lazyClient[*rds_sdkv2.Client]
-	s3controlClient lazyClient[*s3control_sdkv2.Client]
-	ssmClient       lazyClient[*ssm_sdkv2.Client]
+	memoCache  memoCache
+
+	connectClient          lazyClient[*connect_sdkv2.Client]
+	connectcasesClient     lazyClient[*connectcases_sdkv2.Client]
+	customerprofilesClient lazyClient[*customerprofiles_sdkv2.Client]
+	dsClient               lazyClient[*directoryservice_sdkv2.Client]
+	ec2Client              lazyClient[*ec2_sdkv2.Client]
+	lambdaClient           lazyClient[*lambda_sdkv2.Client]
+	logsClient             lazyClient[*cloudwatchlogs_sdkv2.Client]
+	rdsClient              lazyClient[*rds_sdkv2.Client]
+	s3controlClient        lazyClient[*s3control_sdkv2.Client]
+	ssmClient              lazyClient[*ssm_sdkv2.Client]
 
 	acmClient                        *acm.Client
 	acmpcaConn                       *acmpca.ACMPCA
@@ -423,6 +433,8 @@ type AWSClient struct {
 	computeoptimizerClient           *computeoptimizer.Client
 	configserviceConn                *configservice.ConfigService
 	connectConn                      *connect.Connect
+	connectcampaignsConn             *connectcampaigns.ConnectCampaigns
+	connectcasesConn                 *connectcases.ConnectCases
 	connectcontactlensConn           *connectcontactlens.ConnectContactLens
 	connectparticipantConn           *connectparticipant.ConnectParticipant
 	controltowerConn                 *controltower.ControlTower
@@ -539,7 +551,6 @@ type AWSClient struct {
 	mturkConn                        *mturk.MTurk
 	mwaaConn                         *mwaa.MWAA
 	machinelearningConn              *machinelearning.MachineLearning
-	macieConn                        *macie.Macie
 	macie2Conn                       *macie2.Macie2
 	managedblockchainConn            *managedblockchain.ManagedBlockchain
 	marketplacecatalogConn           *marketplacecatalog.MarketplaceCatalog
@@ -584,6 +595,7 @@ type AWSClient struct {
 	pollyConn                        *polly.Polly
 	pricingConn                      *pricing.Pricing
 	protonConn                       *proton.Proton
+	qconnectConn                     *qconnect.QConnect
 	qldbConn                         *qldb.QLDB
 	qldbsessionConn                  *qldbsession.QLDBSession
 	quicksightConn                   *quicksight.QuickSight
@@ -950,6 +962,26 @@ func (client *AWSClient) ConnectConn() *connect.Connect {
 	return client.connectConn
 }
 
+// ConnectClient returns the AWS SDK for Go v2 client.
+// Use it for the APIs and fields that ConnectConn, the AWS SDK for Go v1 client, does not expose.
+func (client *AWSClient) ConnectClient() *connect_sdkv2.Client {
+	return client.connectClient.Client()
+}
+
+func (client *AWSClient) ConnectCampaignsConn() *connectcampaigns.ConnectCampaigns {
+	return client.connectcampaignsConn
+}
+
+func (client *AWSClient) ConnectCasesConn() *connectcases.ConnectCases {
+	return client.connectcasesConn
+}
+
+// ConnectCasesClient returns the AWS SDK for Go v2 client.
+// Use it for the APIs and fields that ConnectCasesConn, the AWS SDK for Go v1 client, does not expose.
+func (client *AWSClient) ConnectCasesClient() *connectcases_sdkv2.Client {
+	return client.connectcasesClient.Client()
+}
+
 func (client *AWSClient) ConnectContactLensConn() *connectcontactlens.ConnectContactLens {
 	return client.connectcontactlensConn
 }
@@ -966,6 +998,12 @@ func (client *AWSClient) CustomerProfilesConn() *customerprofiles.CustomerProfil
 	return client.customerprofilesConn
 }
 
+// CustomerProfilesClient returns the AWS SDK for Go v2 client.
+// Use it for the APIs and fields that CustomerProfilesConn, the AWS SDK for Go v1 client, does not expose.
+func (client *AWSClient) CustomerProfilesClient() *customerprofiles_sdkv2.Client {
+	return client.customerprofilesClient.Client()
+}
+
 func (client *AWSClient) DAXConn() *dax.DAX {
 	return client.daxConn
 }
@@ -986,6 +1024,8 @@ func (client *AWSClient) DSConn() *directoryservice.DirectoryService {
 	return client.dsConn
 }
 
+// DSClient returns the AWS SDK for Go v2 client.
+// Use it for the APIs and fields that DSConn, the AWS SDK for Go v1 client, does not expose.
 func (client *AWSClient) DSClient() *directoryservice_sdkv2.Client {
 	return client.dsClient.Client()
 }
@@ -1054,6 +1094,8 @@ func (client *AWSClient) EC2Conn() *ec2.EC2 {
 	return client.ec2Conn
 }
 
+// EC2Client returns the AWS SDK for Go v2 client.
+// Use it for the APIs and fields that EC2Conn, the AWS SDK for Go v1 client, does not expose.
 func (client *AWSClient) EC2Client() *ec2_sdkv2.Client {
 	return client.ec2Client.Client()
 }
@@ -1362,6 +1404,8 @@ func (client *AWSClient) LambdaConn() *lambda.Lambda {
 	return client.lambdaConn
 }
 
+// LambdaClient returns the AWS SDK for Go v2 client.
+// Use it for the APIs and fields that LambdaConn, the AWS SDK for Go v1 client, does not expose.
 func (client *AWSClient) LambdaClient() *lambda_sdkv2.Client {
 	return client.lambdaClient.Client()
 }
@@ -1398,6 +1442,8 @@ func (client *AWSClient) LogsConn() *cloudwatchlogs.CloudWatchLogs {
 	return client.logsConn
 }
 
+// LogsClient returns the AWS SDK for Go v2 client.
+// Use it for the APIs and fields that LogsConn, the AWS SDK for Go v1 client, does not expose.
 func (client *AWSClient) LogsClient() *cloudwatchlogs_sdkv2.Client {
 	return client.logsClient.Client()
 }
@@ -1430,10 +1476,6 @@ func (client *AWSClient) MachineLearningConn() *machinelearning.MachineLearning
 	return client.machinelearningConn
 }
 
-func (client *AWSClient) MacieConn() *macie.Macie {
-	return client.macieConn
-}
-
 func (client *AWSClient) Macie2Conn() *macie2.Macie2 {
 	return client.macie2Conn
 }
@@ -1610,6 +1652,10 @@ func (client *AWSClient) ProtonConn() *proton.Proton {
 	return client.protonConn
 }
 
+func (client *AWSClient) QConnectConn() *qconnect.QConnect {
+	return client.qconnectConn
+}
+
 func (client *AWSClient) QLDBConn() *qldb.QLDB {
 	return client.qldbConn
 }
@@ -1634,6 +1680,8 @@ func (client *AWSClient) RDSConn() *rds.RDS {
 	return client.rdsConn
 }
 
+// RDSClient returns the AWS SDK for Go v2 client.
+// Use it for the APIs and fields that RDSConn, the AWS SDK for Go v1 client, does not expose.
 func (client *AWSClient) RDSClient() *rds_sdkv2.Client {
 	return client.rdsClient.Client()
 }
@@ -1718,6 +1766,8 @@ func (client *AWSClient) S3ControlConn() *s3control.S3Control {
 	return client.s3controlConn
 }
 
+// S3ControlClient returns the AWS SDK for Go v2 client.
+// Use it for the APIs and fields that S3ControlConn, the AWS SDK for Go v1 client, does not expose.
 func (client *AWSClient) S3ControlClient() *s3control_sdkv2.Client {
 	return client.s3controlClient.Client()
 }
@@ -1754,6 +1804,8 @@ func (client *AWSClient) SSMConn() *ssm.SSM {
 	return client.ssmConn
 }
 
+// SSMClient returns the AWS SDK for Go v2 client.
+// Use it for the APIs and fields that SSMConn, the AWS SDK for Go v1 client, does not expose.
 func (client *AWSClient) SSMClient() *ssm_sdkv2.Client {
 	return client.ssmClient.Client()
 }
@@ -3,9 +3,12 @@ package conns
 import (
 	"context"
 	"log"
+	"os"
+	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	connect_sdkv2 "github.com/aws/aws-sdk-go-v2/service/connect"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -45,11 +48,20 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	// ConnectRequestsPerSecondEnvVar is the environment variable that limits the
+	// number of requests per second made to each Amazon Connect API operation.
+	ConnectRequestsPerSecondEnvVar = "TF_AWS_CONNECT_REQUESTS_PER_SECOND"
+)
+
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
 	AssumeRole                     *awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	ConnectDefaultInstanceID       string
+	ConnectMaxAttempts             int
+	ConnectRetryMode               string
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
@@ -185,6 +197,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	client.AccountID = accountID
+	client.ConnectDefaultInstanceID = c.ConnectDefaultInstanceID
 	client.DefaultTagsConfig = c.DefaultTagsConfig
 	client.DNSSuffix = DNSSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
@@ -535,6 +548,49 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		}
 	})
 
+	// Refreshing many Connect resources is throttled heavily on List* operations, so the
+	// retry behavior of the Connect clients can be configured separately from max_retries.
+	connectRetry, err := c.connectRetryConfig()
+
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	if connectRetry.isSet() {
+		// Change the retryers of the existing clients so that their handlers and endpoints are kept.
+		connectRetry.applyV1(client.connectConn)
+
+		newConnectClient := client.connectClient.initf
+		client.connectClient.init(&cfg, func() *connect_sdkv2.Client {
+			return connect_sdkv2.New(newConnectClient().Options(), connectRetry.apply)
+		})
+	}
+
+	// Amazon Connect enforces low per-API request quotas that large configurations
+	// can easily exhaust. Optionally pace requests to each Connect API client-side.
+	if v := os.Getenv(ConnectRequestsPerSecondEnvVar); v != "" {
+		requestsPerSecond, err := strconv.ParseFloat(v, 64)
+
+		if err != nil || requestsPerSecond <= 0 {
+			return nil, diag.Errorf("%s (%s) must be a positive number", ConnectRequestsPerSecondEnvVar, v)
+		}
+
+		limiter := newRequestLimiter(requestsPerSecond)
+
+		client.connectConn.Handlers.Sign.PushFront(func(r *request.Request) {
+			if err := limiter.Wait(r.Context(), r.Operation.Name); err != nil {
+				r.Error = err
+			}
+		})
+
+		newConnectClient := client.connectClient.initf
+		client.connectClient.init(&cfg, func() *connect_sdkv2.Client {
+			return connect_sdkv2.New(newConnectClient().Options(), func(o *connect_sdkv2.Options) {
+				o.APIOptions = append(o.APIOptions, limiter.addToStack)
+			})
+		})
+	}
+
 	// AWS SDK for Go v2 custom API clients.
 
 	client.route53domainsClient = route53domains.NewFromConfig(cfg, func(o *route53domains.Options) {
@@ -0,0 +1,99 @@
+package conns
+
+import (
+	"context"
+	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	connect_sdkv2 "github.com/aws/aws-sdk-go-v2/service/connect"
+)
+
+func TestConfigureProviderConnectEndpoints(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	for _, k := range []string{"AWS_PROFILE", "AWS_CONFIG_FILE", "AWS_SHARED_CREDENTIALS_FILE", "AWS_USE_FIPS_ENDPOINT", "AWS_USE_DUALSTACK_ENDPOINT", ConnectMaxAttemptsEnvVar, ConnectRetryModeEnvVar, ConnectRequestsPerSecondEnvVar} {
+		t.Setenv(k, "")
+	}
+
+	testCases := map[string]struct {
+		connectMaxAttempts   int
+		region               string
+		useDualStackEndpoint bool
+		useFIPSEndpoint      bool
+		expectedV1Endpoint   string
+		expectedV2Endpoint   string
+	}{
+		"default": {
+			region:             "us-west-2",
+			expectedV1Endpoint: "https://connect.us-west-2.amazonaws.com",
+			expectedV2Endpoint: "https://connect.us-west-2.amazonaws.com",
+		},
+		"FIPS": {
+			region:             "us-west-2",
+			useFIPSEndpoint:    true,
+			expectedV1Endpoint: "https://connect-fips.us-west-2.amazonaws.com",
+			expectedV2Endpoint: "https://connect-fips.us-west-2.amazonaws.com",
+		},
+		"FIPS GovCloud": {
+			region:             "us-gov-west-1",
+			useFIPSEndpoint:    true,
+			expectedV1Endpoint: "https://connect.us-gov-west-1.amazonaws.com",
+			expectedV2Endpoint: "https://connect.us-gov-west-1.amazonaws.com",
+		},
+		"FIPS with Connect retries": {
+			connectMaxAttempts: 10,
+			region:             "us-west-2",
+			useFIPSEndpoint:    true,
+			expectedV1Endpoint: "https://connect-fips.us-west-2.amazonaws.com",
+			expectedV2Endpoint: "https://connect-fips.us-west-2.amazonaws.com",
+		},
+		"dual-stack": {
+			region:               "us-west-2",
+			useDualStackEndpoint: true,
+			expectedV1Endpoint:   "https://connect.us-west-2.api.aws",
+			expectedV2Endpoint:   "https://connect.us-west-2.api.aws",
+		},
+	}
+
+	for name, testCase := range testCases { //nolint:paralleltest // uses t.Setenv
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			c := &Config{
+				AccessKey:               "StaticAccessKey",
+				ConnectMaxAttempts:      testCase.connectMaxAttempts,
+				Region:                  testCase.region,
+				SecretKey:               "StaticSecretKey",
+				SkipCredsValidation:     true,
+				SkipRegionValidation:    true,
+				SkipRequestingAccountId: true,
+				UseDualStackEndpoint:    testCase.useDualStackEndpoint,
+				UseFIPSEndpoint:         testCase.useFIPSEndpoint,
+			}
+
+			client, diags := c.ConfigureProvider(ctx, &AWSClient{})
+
+			if diags.HasError() {
+				t.Fatalf("configuring provider: %v", diags)
+			}
+
+			if got, want := client.ConnectConn().Endpoint, testCase.expectedV1Endpoint; got != want {
+				t.Errorf("ConnectConn endpoint = %q, want %q", got, want)
+			}
+
+			options := client.ConnectClient().Options()
+			endpoint, err := options.EndpointResolverV2.ResolveEndpoint(ctx, connect_sdkv2.EndpointParameters{
+				Region:       aws_sdkv2.String(options.Region),
+				UseDualStack: aws_sdkv2.Bool(options.EndpointOptions.UseDualStackEndpoint == aws_sdkv2.DualStackEndpointStateEnabled),
+				UseFIPS:      aws_sdkv2.Bool(options.EndpointOptions.UseFIPSEndpoint == aws_sdkv2.FIPSEndpointStateEnabled),
+			})
+
+			if err != nil {
+				t.Fatalf("resolving ConnectClient endpoint: %s", err)
+			}
+
+			if got, want := endpoint.URI.String(), testCase.expectedV2Endpoint; got != want {
+				t.Errorf("ConnectClient endpoint = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package conns
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	connect_sdkv2 "github.com/aws/aws-sdk-go-v2/service/connect"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/service/connect"
+)
+
+const (
+	// ConnectMaxAttemptsEnvVar is the environment variable that sets the maximum number of
+	// attempts, including the first, made for each Amazon Connect API request.
+	ConnectMaxAttemptsEnvVar = "TF_AWS_CONNECT_MAX_ATTEMPTS"
+
+	// ConnectRetryModeEnvVar is the environment variable that selects the retry mode,
+	// "standard" or "adaptive", of the Amazon Connect API client.
+	ConnectRetryModeEnvVar = "TF_AWS_CONNECT_RETRY_MODE"
+)
+
+// connectRetryConfig is the retry configuration of the Amazon Connect API clients.
+// Zero values leave the provider-wide retry configuration in place.
+type connectRetryConfig struct {
+	maxAttempts int
+	mode        aws_sdkv2.RetryMode
+}
+
+// connectRetryConfig returns the retry configuration of the Amazon Connect API clients.
+// The connect_max_attempts and connect_retry_mode provider arguments take precedence over the environment variables.
+func (c *Config) connectRetryConfig() (connectRetryConfig, error) {
+	var config connectRetryConfig
+
+	if c.ConnectMaxAttempts > 0 {
+		config.maxAttempts = c.ConnectMaxAttempts
+	} else if v := os.Getenv(ConnectMaxAttemptsEnvVar); v != "" {
+		maxAttempts, err := strconv.Atoi(v)
+
+		if err != nil || maxAttempts <= 0 {
+			return config, fmt.Errorf("%s (%s) must be a positive integer", ConnectMaxAttemptsEnvVar, v)
+		}
+
+		config.maxAttempts = maxAttempts
+	}
+
+	v, source := c.ConnectRetryMode, "connect_retry_mode"
+
+	if v == "" {
+		v, source = os.Getenv(ConnectRetryModeEnvVar), ConnectRetryModeEnvVar
+	}
+
+	if v != "" {
+		mode, err := aws_sdkv2.ParseRetryMode(v)
+
+		if err != nil {
+			return config, fmt.Errorf("%s (%s) must be %q or %q", source, v, aws_sdkv2.RetryModeStandard, aws_sdkv2.RetryModeAdaptive)
+		}
+
+		config.mode = mode
+	}
+
+	return config, nil
+}
+
+func (c connectRetryConfig) isSet() bool {
+	return c.maxAttempts > 0 || c.mode != ""
+}
+
+// apply configures the retryer of an AWS SDK for Go v2 Connect client.
+// Adaptive mode replaces the provider-wide retryer, as it also rate limits requests after throttling errors.
+// The maximum is set through RetryMaxAttempts, which the client applies over the retryer's own maximum.
+func (c connectRetryConfig) apply(o *connect_sdkv2.Options) {
+	if c.mode == aws_sdkv2.RetryModeAdaptive {
+		o.Retryer = retry.NewAdaptiveMode()
+	}
+
+	if c.maxAttempts > 0 {
+		o.RetryMaxAttempts = c.maxAttempts
+	}
+}
+
+// applyV1 sets the maximum number of retries of an AWS SDK for Go v1 Connect client's existing retryer.
+// The AWS SDK for Go v1 has no adaptive retry mode.
+func (c connectRetryConfig) applyV1(conn *connect.Connect) {
+	if c.maxAttempts <= 0 {
+		return
+	}
+
+	maxRetries := c.maxAttempts - 1
+
+	if retryer, ok := conn.Retryer.(client.DefaultRetryer); ok {
+		retryer.NumMaxRetries = maxRetries
+		conn.Retryer = retryer
+	} else {
+		conn.Retryer = client.DefaultRetryer{NumMaxRetries: maxRetries}
+	}
+
+	conn.Config.MaxRetries = aws.Int(maxRetries)
+}
@@ -0,0 +1,131 @@
+package conns
+
+import (
+	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	connect_sdkv2 "github.com/aws/aws-sdk-go-v2/service/connect"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/connect"
+)
+
+func TestConnectRetryConfig(t *testing.T) {
+	testCases := map[string]struct {
+		config      Config
+		maxAttempts string
+		mode        string
+		expected    connectRetryConfig
+		expectError bool
+	}{
+		"unset": {},
+		"max attempts": {
+			maxAttempts: "10",
+			expected:    connectRetryConfig{maxAttempts: 10},
+		},
+		"adaptive": {
+			maxAttempts: "8",
+			mode:        "adaptive",
+			expected:    connectRetryConfig{maxAttempts: 8, mode: aws_sdkv2.RetryModeAdaptive},
+		},
+		"provider arguments": {
+			config:   Config{ConnectMaxAttempts: 6, ConnectRetryMode: "adaptive"},
+			expected: connectRetryConfig{maxAttempts: 6, mode: aws_sdkv2.RetryModeAdaptive},
+		},
+		"provider arguments override environment": {
+			config:      Config{ConnectMaxAttempts: 6, ConnectRetryMode: "standard"},
+			maxAttempts: "10",
+			mode:        "adaptive",
+			expected:    connectRetryConfig{maxAttempts: 6, mode: aws_sdkv2.RetryModeStandard},
+		},
+		"invalid max attempts": {
+			maxAttempts: "0",
+			expectError: true,
+		},
+		"invalid mode": {
+			mode:        "legacy",
+			expectError: true,
+		},
+		"invalid provider argument mode": {
+			config:      Config{ConnectRetryMode: "legacy"},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(ConnectMaxAttemptsEnvVar, testCase.maxAttempts)
+			t.Setenv(ConnectRetryModeEnvVar, testCase.mode)
+
+			got, err := testCase.config.connectRetryConfig()
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %+v, expected %+v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestConnectRetryConfigApply(t *testing.T) {
+	t.Parallel()
+
+	// The provider-wide maximum set by max_retries.
+	options := connect_sdkv2.Options{RetryMaxAttempts: 25}
+
+	conn := connect_sdkv2.New(options, connectRetryConfig{maxAttempts: 7, mode: aws_sdkv2.RetryModeAdaptive}.apply)
+
+	if got := conn.Options().Retryer.MaxAttempts(); got != 7 {
+		t.Errorf("adaptive mode MaxAttempts is %d, expected 7", got)
+	}
+
+	conn = connect_sdkv2.New(options, connectRetryConfig{maxAttempts: 5}.apply)
+
+	if got := conn.Options().Retryer.MaxAttempts(); got != 5 {
+		t.Errorf("standard mode MaxAttempts is %d, expected 5", got)
+	}
+
+	var o connect_sdkv2.Options
+
+	connectRetryConfig{mode: aws_sdkv2.RetryModeAdaptive}.apply(&o)
+
+	if _, ok := o.Retryer.(*retry.AdaptiveMode); !ok {
+		t.Errorf("Retryer is %T, expected *retry.AdaptiveMode", o.Retryer)
+	}
+}
+
+func TestConnectRetryConfigApplyV1(t *testing.T) {
+	t.Parallel()
+
+	sess := session.Must(session.NewSession(&aws.Config{
+		MaxRetries: aws.Int(25),
+		Region:     aws.String("us-west-2"), //lintignore:AWSAT003
+	}))
+	conn := connect.New(sess)
+	conn.Handlers.Retry.PushBackNamed(request.NamedHandler{Name: "test", Fn: func(*request.Request) {}})
+
+	connectRetryConfig{maxAttempts: 4}.applyV1(conn)
+
+	if got := conn.MaxRetries(); got != 3 {
+		t.Errorf("MaxRetries is %d, expected 3", got)
+	}
+
+	if conn.Handlers.Retry.Len() == 0 || !conn.Handlers.Retry.Swap("test", request.NamedHandler{Name: "test", Fn: func(*request.Request) {}}) {
+		t.Error("expected the client's retry handlers to be kept")
+	}
+}
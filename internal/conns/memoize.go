@@ -0,0 +1,97 @@
+package conns
+
+import (
+	"sync"
+	"time"
+)
+
+// memoTTL is how long a memoized result is used before it is looked up again.
+// It is long enough to collapse the repeated lookups made while planning or applying many resources,
+// and short enough that changes made outside Terraform are seen by a long-running provider process.
+const memoTTL = 1 * time.Minute
+
+// memoCache holds the results of API lookups that are repeated many times
+// during a single Terraform operation. The zero value is ready to use.
+type memoCache struct {
+	lock   sync.Mutex
+	values map[string]memoEntry
+	now    func() time.Time // For testing; defaults to time.Now.
+}
+
+type memoEntry struct {
+	value   any
+	expires time.Time
+}
+
+func (c *memoCache) timeNow() time.Time {
+	if c.now != nil {
+		return c.now()
+	}
+
+	return time.Now()
+}
+
+func (c *memoCache) get(key string) (any, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	e, ok := c.values[key]
+
+	if !ok {
+		return nil, false
+	}
+
+	if !c.timeNow().Before(e.expires) {
+		delete(c.values, key)
+		return nil, false
+	}
+
+	return e.value, true
+}
+
+func (c *memoCache) put(key string, v any) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.values == nil {
+		c.values = make(map[string]memoEntry)
+	}
+	c.values[key] = memoEntry{value: v, expires: c.timeNow().Add(memoTTL)}
+}
+
+func (c *memoCache) forget(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.values, key)
+}
+
+// Memoize returns the cached result for key if there is one, otherwise it calls f
+// and caches the result. Errors are never cached.
+// Keys are shared by all services, so they should be prefixed with the API operation name,
+// e.g. "connect.DescribeInstance/<instance-id>".
+//
+// A cached result is invalidated when it is older than memoTTL, or when Forget is called for its key.
+// Resources that modify what a key describes must call Forget so that later lookups in the same
+// operation see the change; the TTL only bounds staleness caused by changes made outside Terraform.
+func Memoize[T any](client *AWSClient, key string, f func() (T, error)) (T, error) {
+	if v, ok := client.memoCache.get(key); ok {
+		return v.(T), nil
+	}
+
+	v, err := f()
+
+	if err != nil {
+		return v, err
+	}
+
+	client.memoCache.put(key, v)
+
+	return v, nil
+}
+
+// Forget removes any cached result for key.
+// It must be called after the underlying resource is modified.
+func Forget(client *AWSClient, key string) {
+	client.memoCache.forget(key)
+}
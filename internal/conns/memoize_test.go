@@ -0,0 +1,105 @@
+package conns
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMemoize(t *testing.T) {
+	t.Parallel()
+
+	client := &AWSClient{}
+	calls := 0
+	f := func() (string, error) {
+		calls++
+		return "value", nil
+	}
+
+	for i := 0; i < 3; i++ {
+		v, err := Memoize(client, "key", f)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got, want := v, "value"; got != want {
+			t.Errorf("value = %q, want %q", got, want)
+		}
+	}
+
+	if got, want := calls, 1; got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+
+	Forget(client, "key")
+
+	if _, err := Memoize(client, "key", f); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := calls, 2; got != want {
+		t.Errorf("calls after Forget = %d, want %d", got, want)
+	}
+}
+
+func TestMemoizeErrorNotCached(t *testing.T) {
+	t.Parallel()
+
+	client := &AWSClient{}
+	calls := 0
+	f := func() (int, error) {
+		calls++
+		if calls == 1 {
+			return 0, errors.New("failed")
+		}
+		return 42, nil
+	}
+
+	if _, err := Memoize(client, "key", f); err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	v, err := Memoize(client, "key", f)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := v, 42; got != want {
+		t.Errorf("value = %d, want %d", got, want)
+	}
+}
+
+func TestMemoizeExpires(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	client := &AWSClient{}
+	client.memoCache.now = func() time.Time { return now }
+	calls := 0
+	f := func() (int, error) {
+		calls++
+		return calls, nil
+	}
+
+	if _, err := Memoize(client, "key", f); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	now = now.Add(memoTTL - time.Second)
+
+	if v, err := Memoize(client, "key", f); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if got, want := v, 1; got != want {
+		t.Errorf("value before expiry = %d, want %d", got, want)
+	}
+
+	now = now.Add(time.Second)
+
+	if v, err := Memoize(client, "key", f); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if got, want := v, 2; got != want {
+		t.Errorf("value after expiry = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,71 @@
+package conns
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// requestLimiter paces requests so that no more than a fixed number are started
+// per second for each key. Keys are independent of each other.
+type requestLimiter struct {
+	interval time.Duration
+
+	lock sync.Mutex
+	next map[string]time.Time
+}
+
+func newRequestLimiter(requestsPerSecond float64) *requestLimiter {
+	return &requestLimiter{
+		interval: time.Duration(float64(time.Second) / requestsPerSecond),
+		next:     make(map[string]time.Time),
+	}
+}
+
+// reserve returns how long the caller must wait before starting a request for the given key.
+func (l *requestLimiter) reserve(key string, now time.Time) time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	next, ok := l.next[key]
+	if !ok || next.Before(now) {
+		next = now
+	}
+	l.next[key] = next.Add(l.interval)
+
+	return next.Sub(now)
+}
+
+// Wait blocks until a request for the given key may be started or the context is done.
+func (l *requestLimiter) Wait(ctx context.Context, key string) error {
+	delay := l.reserve(key, time.Now())
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// addToStack adds the limiter to an AWS SDK for Go v2 middleware stack, keyed by operation name.
+// It runs before signing on every attempt, so that retries are paced as well.
+func (l *requestLimiter) addToStack(stack *middleware.Stack) error {
+	return stack.Finalize.Insert(middleware.FinalizeMiddlewareFunc("RequestLimiter", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		if err := l.Wait(ctx, awsmiddleware.GetOperationName(ctx)); err != nil {
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+		}
+
+		return next.HandleFinalize(ctx, in)
+	}), "Signing", middleware.Before)
+}
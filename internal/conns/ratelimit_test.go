@@ -0,0 +1,82 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	connect_sdkv2 "github.com/aws/aws-sdk-go-v2/service/connect"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestRequestLimiterReserve(t *testing.T) {
+	t.Parallel()
+
+	limiter := newRequestLimiter(2)
+	now := time.Now()
+
+	if got, want := limiter.reserve("CreateUser", now), time.Duration(0); got != want {
+		t.Errorf("first reservation = %s, want %s", got, want)
+	}
+	if got, want := limiter.reserve("CreateUser", now), 500*time.Millisecond; got != want {
+		t.Errorf("second reservation = %s, want %s", got, want)
+	}
+	if got, want := limiter.reserve("CreateUser", now), time.Second; got != want {
+		t.Errorf("third reservation = %s, want %s", got, want)
+	}
+	if got, want := limiter.reserve("CreateQueue", now), time.Duration(0); got != want {
+		t.Errorf("reservation for other key = %s, want %s", got, want)
+	}
+	if got, want := limiter.reserve("CreateUser", now.Add(5*time.Second)), time.Duration(0); got != want {
+		t.Errorf("reservation after idle period = %s, want %s", got, want)
+	}
+}
+
+func TestRequestLimiterWaitContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	limiter := newRequestLimiter(0.01)
+
+	if err := limiter.Wait(context.Background(), "CreateUser"); err != nil {
+		t.Fatalf("first wait: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := limiter.Wait(ctx, "CreateUser"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("second wait error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRequestLimiterAddToStack(t *testing.T) {
+	t.Parallel()
+
+	limiter := newRequestLimiter(1)
+	conn := connect_sdkv2.New(connect_sdkv2.Options{
+		Credentials: aws_sdkv2.AnonymousCredentials{},
+		HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+		}),
+		Region: "us-west-2", //lintignore:AWSAT003
+	}, func(o *connect_sdkv2.Options) {
+		o.APIOptions = append(o.APIOptions, limiter.addToStack)
+	})
+
+	if _, err := conn.ListQueues(context.Background(), &connect_sdkv2.ListQueuesInput{InstanceId: aws_sdkv2.String("test")}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := limiter.reserve("ListQueues", time.Now()); got <= 0 {
+		t.Errorf("ListQueues request was not paced, got delay %s", got)
+	}
+
+	if got := limiter.reserve("ListUsers", time.Now()); got != 0 {
+		t.Errorf("got delay %s for ListUsers, expected none", got)
+	}
+}
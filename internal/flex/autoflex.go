@@ -0,0 +1,484 @@
+package flex
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Reflection-based expanders and flatteners between Terraform Plugin SDK v2 values and AWS SDK for Go v1 API structures.
+//
+// Terraform attribute names are matched to API structure field names by converting the field name to snake case,
+// e.g. the field AfterContactWorkTimeLimit corresponds to the attribute after_contact_work_time_limit.
+// Supported field types are *string, *int64, *bool, *float64, pointers to structures,
+// slices of any of those, and maps with string keys and any of those as values.
+
+// An ExpandOption configures Expand, ExpandFirst and ExpandAll.
+type ExpandOption func(*expandOptions)
+
+type expandOptions struct {
+	omitEmptyStrings bool
+}
+
+// OmitEmptyStrings leaves string fields unset for empty values, for APIs that reject empty strings.
+func OmitEmptyStrings() ExpandOption {
+	return func(o *expandOptions) {
+		o.omitEmptyStrings = true
+	}
+}
+
+// Expand copies the values in tfMap into the matching fields of apiObject, which must be a pointer to a structure.
+// Keys with no matching field are ignored. Nested blocks may be lists or sets of maps.
+func Expand(tfMap map[string]interface{}, apiObject any, optFns ...ExpandOption) error {
+	var opts expandOptions
+
+	for _, optFn := range optFns {
+		optFn(&opts)
+	}
+
+	return expand(tfMap, apiObject, &opts)
+}
+
+func expand(tfMap map[string]interface{}, apiObject any, opts *expandOptions) error {
+	v := reflect.ValueOf(apiObject)
+
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("expand target must be a non-nil pointer to a structure, got %T", apiObject)
+	}
+
+	v = v.Elem()
+	fields := fieldIndexesByAttributeName(v.Type())
+
+	for key, raw := range tfMap {
+		i, ok := fields[key]
+
+		if !ok {
+			continue
+		}
+
+		if err := expandValue(raw, v.Field(i), opts); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// ExpandFirst expands the first element of a single-item block into a new API structure.
+// It returns nil if the block is empty.
+func ExpandFirst[T any](tfList []interface{}, optFns ...ExpandOption) (*T, error) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil, nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return nil, fmt.Errorf("expected map[string]interface{}, got %T", tfList[0])
+	}
+
+	apiObject := new(T)
+
+	if err := Expand(tfMap, apiObject, optFns...); err != nil {
+		return nil, err
+	}
+
+	return apiObject, nil
+}
+
+// ExpandAll expands each element of a block into a new API structure.
+func ExpandAll[T any](tfList []interface{}, optFns ...ExpandOption) ([]*T, error) {
+	var apiObjects []*T
+
+	for _, tfMapRaw := range tfList {
+		if tfMapRaw == nil {
+			continue
+		}
+
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			return nil, fmt.Errorf("expected map[string]interface{}, got %T", tfMapRaw)
+		}
+
+		apiObject := new(T)
+
+		if err := Expand(tfMap, apiObject, optFns...); err != nil {
+			return nil, err
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+// Flatten returns the values of the fields of apiObject that match an attribute in the block's schema.
+// Nil fields are omitted. apiObject must be a structure or a pointer to one; a nil pointer returns nil.
+func Flatten(apiObject any, tfResource *schema.Resource) (map[string]interface{}, error) {
+	v := reflect.ValueOf(apiObject)
+
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil, nil
+		}
+
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("flatten source must be a structure, got %T", apiObject)
+	}
+
+	fields := fieldIndexesByAttributeName(v.Type())
+	tfMap := map[string]interface{}{}
+
+	for key, tfSchema := range tfResource.Schema {
+		i, ok := fields[key]
+
+		if !ok {
+			continue
+		}
+
+		value, err := flattenValue(v.Field(i), tfSchema)
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+
+		if value != nil {
+			tfMap[key] = value
+		}
+	}
+
+	return tfMap, nil
+}
+
+// FlattenFirst flattens apiObject into a single-item block. It returns an empty block if apiObject is nil.
+func FlattenFirst(apiObject any, tfResource *schema.Resource) ([]interface{}, error) {
+	tfMap, err := Flatten(apiObject, tfResource)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if tfMap == nil {
+		return []interface{}{}, nil
+	}
+
+	return []interface{}{tfMap}, nil
+}
+
+// FlattenAll flattens each of apiObjects into an element of a block.
+func FlattenAll[T any](apiObjects []*T, tfResource *schema.Resource) ([]interface{}, error) {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap, err := Flatten(apiObject, tfResource)
+
+		if err != nil {
+			return nil, err
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList, nil
+}
+
+func expandValue(raw interface{}, to reflect.Value, opts *expandOptions) error {
+	if raw == nil {
+		return nil
+	}
+
+	switch to.Kind() {
+	case reflect.Pointer:
+		elemType := to.Type().Elem()
+		p := reflect.New(elemType)
+
+		switch elemType.Kind() {
+		case reflect.String:
+			v, ok := raw.(string)
+			if !ok {
+				return unexpectedTypeError(elemType, raw)
+			}
+			if v == "" && opts.omitEmptyStrings {
+				return nil
+			}
+			p.Elem().SetString(v)
+
+		case reflect.Int64:
+			v, ok := raw.(int)
+			if !ok {
+				return unexpectedTypeError(elemType, raw)
+			}
+			p.Elem().SetInt(int64(v))
+
+		case reflect.Bool:
+			v, ok := raw.(bool)
+			if !ok {
+				return unexpectedTypeError(elemType, raw)
+			}
+			p.Elem().SetBool(v)
+
+		case reflect.Float64:
+			v, ok := raw.(float64)
+			if !ok {
+				return unexpectedTypeError(elemType, raw)
+			}
+			p.Elem().SetFloat(v)
+
+		case reflect.Struct:
+			tfMap, ok := raw.(map[string]interface{})
+			if !ok {
+				// A nested block is a list or set holding at most one map.
+				tfList, ok := asList(raw)
+				if !ok {
+					return unexpectedTypeError(elemType, raw)
+				}
+				if len(tfList) == 0 || tfList[0] == nil {
+					return nil
+				}
+				if tfMap, ok = tfList[0].(map[string]interface{}); !ok {
+					return unexpectedTypeError(elemType, tfList[0])
+				}
+			}
+			if err := expand(tfMap, p.Interface(), opts); err != nil {
+				return err
+			}
+
+		default:
+			return fmt.Errorf("unsupported field type %s", to.Type())
+		}
+
+		to.Set(p)
+
+	case reflect.Slice:
+		tfList, ok := asList(raw)
+		if !ok {
+			return unexpectedTypeError(to.Type(), raw)
+		}
+
+		s := reflect.MakeSlice(to.Type(), 0, len(tfList))
+
+		for _, v := range tfList {
+			elem := reflect.New(to.Type().Elem()).Elem()
+
+			if err := expandValue(v, elem, opts); err != nil {
+				return err
+			}
+
+			if elem.IsNil() {
+				continue
+			}
+
+			s = reflect.Append(s, elem)
+		}
+
+		if s.Len() > 0 {
+			to.Set(s)
+		}
+
+	case reflect.Map:
+		if to.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("unsupported field type %s", to.Type())
+		}
+
+		tfMap, ok := raw.(map[string]interface{})
+		if !ok {
+			return unexpectedTypeError(to.Type(), raw)
+		}
+
+		m := reflect.MakeMapWithSize(to.Type(), len(tfMap))
+
+		for k, v := range tfMap {
+			elem := reflect.New(to.Type().Elem()).Elem()
+
+			if err := expandValue(v, elem, opts); err != nil {
+				return fmt.Errorf("%s: %w", k, err)
+			}
+
+			if elem.IsNil() {
+				continue
+			}
+
+			m.SetMapIndex(reflect.ValueOf(k).Convert(to.Type().Key()), elem)
+		}
+
+		if m.Len() > 0 {
+			to.Set(m)
+		}
+
+	default:
+		return fmt.Errorf("unsupported field type %s", to.Type())
+	}
+
+	return nil
+}
+
+func flattenValue(from reflect.Value, tfSchema *schema.Schema) (interface{}, error) {
+	switch from.Kind() {
+	case reflect.Pointer:
+		if from.IsNil() {
+			return nil, nil
+		}
+
+		elem := from.Elem()
+
+		switch elem.Kind() {
+		case reflect.String:
+			return elem.String(), nil
+		case reflect.Int64:
+			return elem.Int(), nil
+		case reflect.Bool:
+			return elem.Bool(), nil
+		case reflect.Float64:
+			return elem.Float(), nil
+		case reflect.Struct:
+			tfResource, ok := tfSchema.Elem.(*schema.Resource)
+			if !ok {
+				return nil, fmt.Errorf("field type %s requires a nested block", from.Type())
+			}
+
+			tfMap, err := Flatten(from.Interface(), tfResource)
+			if err != nil {
+				return nil, err
+			}
+
+			// A single nested structure is represented as a block with one element.
+			if tfSchema.Type == schema.TypeList || tfSchema.Type == schema.TypeSet {
+				return []interface{}{tfMap}, nil
+			}
+
+			return tfMap, nil
+		}
+
+	case reflect.Slice:
+		if from.Len() == 0 {
+			return nil, nil
+		}
+
+		var elemSchema *schema.Schema
+
+		switch v := tfSchema.Elem.(type) {
+		case *schema.Schema:
+			elemSchema = v
+		case *schema.Resource:
+			elemSchema = &schema.Schema{Elem: v}
+		default:
+			return nil, fmt.Errorf("field type %s requires an element schema", from.Type())
+		}
+
+		tfList := make([]interface{}, 0, from.Len())
+
+		for i := 0; i < from.Len(); i++ {
+			v, err := flattenValue(from.Index(i), elemSchema)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if v != nil {
+				tfList = append(tfList, v)
+			}
+		}
+
+		return tfList, nil
+
+	case reflect.Map:
+		if from.Type().Key().Kind() != reflect.String {
+			break
+		}
+
+		if from.Len() == 0 {
+			return nil, nil
+		}
+
+		var elemSchema *schema.Schema
+
+		if v, ok := tfSchema.Elem.(*schema.Schema); ok {
+			elemSchema = v
+		} else {
+			elemSchema = &schema.Schema{Type: schema.TypeString}
+		}
+
+		tfMap := make(map[string]interface{}, from.Len())
+
+		for iter := from.MapRange(); iter.Next(); {
+			v, err := flattenValue(iter.Value(), elemSchema)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if v != nil {
+				tfMap[iter.Key().String()] = v
+			}
+		}
+
+		return tfMap, nil
+	}
+
+	return nil, fmt.Errorf("unsupported field type %s", from.Type())
+}
+
+// fieldIndexesByAttributeName returns the indexes of the exported fields of a structure type keyed by attribute name.
+func fieldIndexesByAttributeName(t reflect.Type) map[string]int {
+	fields := make(map[string]int, t.NumField())
+
+	for i := 0; i < t.NumField(); i++ {
+		if f := t.Field(i); f.IsExported() {
+			fields[attributeName(f.Name)] = i
+		}
+	}
+
+	return fields
+}
+
+// attributeName converts a Go field name to a Terraform attribute name,
+// e.g. "OutboundCallerIdNumberId" to "outbound_caller_id_number_id" and "S3Config" to "s3_config".
+func attributeName(fieldName string) string {
+	var b strings.Builder
+	runes := []rune(fieldName)
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				prev := runes[i-1]
+				nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+				if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+					b.WriteRune('_')
+				}
+			}
+
+			b.WriteRune(unicode.ToLower(r))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+func asList(raw interface{}) ([]interface{}, bool) {
+	switch v := raw.(type) {
+	case []interface{}:
+		return v, true
+	case *schema.Set:
+		return v.List(), true
+	}
+
+	return nil, false
+}
+
+func unexpectedTypeError(want reflect.Type, got interface{}) error {
+	return fmt.Errorf("expected value for %s, got %T", want, got)
+}
@@ -0,0 +1,266 @@
+package flex
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type testAutoFlexTimeSlice struct {
+	_ struct{} `type:"structure"`
+
+	Hours   *int64
+	Minutes *int64
+}
+
+type testAutoFlexConfig struct {
+	_ struct{} `type:"structure"`
+
+	AutoAccept      *bool
+	Day             *string
+	DeskPhoneNumber *string
+	EndTime         *testAutoFlexTimeSlice
+	KmsKeyId        *string
+	Labels          map[string]*string
+	S3Config        *string
+	SecurityGroups  []*string
+	Slots           []*testAutoFlexTimeSlice
+	Weight          *float64
+}
+
+func testAutoFlexTimeSliceResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"hours":   {Type: schema.TypeInt, Required: true},
+			"minutes": {Type: schema.TypeInt, Required: true},
+		},
+	}
+}
+
+func testAutoFlexConfigResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"auto_accept":       {Type: schema.TypeBool, Optional: true},
+			"day":               {Type: schema.TypeString, Optional: true},
+			"desk_phone_number": {Type: schema.TypeString, Optional: true},
+			"end_time":          {Type: schema.TypeList, Optional: true, MaxItems: 1, Elem: testAutoFlexTimeSliceResource()},
+			"kms_key_id":        {Type: schema.TypeString, Optional: true},
+			"labels":            {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"s3_config":         {Type: schema.TypeString, Optional: true},
+			"security_groups":   {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"slots":             {Type: schema.TypeList, Optional: true, Elem: testAutoFlexTimeSliceResource()},
+			"weight":            {Type: schema.TypeFloat, Optional: true},
+		},
+	}
+}
+
+func TestAttributeName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"Arn":                       "arn",
+		"ARN":                       "arn",
+		"AfterContactWorkTimeLimit": "after_contact_work_time_limit",
+		"KmsKeyId":                  "kms_key_id",
+		"OutboundCallerIdNumberId":  "outbound_caller_id_number_id",
+		"S3Config":                  "s3_config",
+		"URLPath":                   "url_path",
+	}
+
+	for fieldName, want := range testCases {
+		if got := attributeName(fieldName); got != want {
+			t.Errorf("attributeName(%q) = %q, want %q", fieldName, got, want)
+		}
+	}
+}
+
+func TestExpand(t *testing.T) {
+	t.Parallel()
+
+	tfMap := map[string]interface{}{
+		"auto_accept":       false,
+		"day":               "MONDAY",
+		"desk_phone_number": "",
+		"end_time": []interface{}{map[string]interface{}{
+			"hours":   0,
+			"minutes": 30,
+		}},
+		"kms_key_id":      "key",
+		"labels":          map[string]interface{}{"a": "b"},
+		"security_groups": schema.NewSet(schema.HashString, []interface{}{"sg-1"}),
+		"slots": []interface{}{
+			map[string]interface{}{"hours": 1, "minutes": 2},
+			nil,
+		},
+		"unknown": "ignored",
+		"weight":  1.5,
+	}
+
+	got := &testAutoFlexConfig{}
+	if err := Expand(tfMap, got); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := &testAutoFlexConfig{
+		AutoAccept:      aws.Bool(false),
+		Day:             aws.String("MONDAY"),
+		DeskPhoneNumber: aws.String(""),
+		EndTime: &testAutoFlexTimeSlice{
+			Hours:   aws.Int64(0),
+			Minutes: aws.Int64(30),
+		},
+		KmsKeyId:       aws.String("key"),
+		Labels:         map[string]*string{"a": aws.String("b")},
+		SecurityGroups: []*string{aws.String("sg-1")},
+		Slots: []*testAutoFlexTimeSlice{
+			{Hours: aws.Int64(1), Minutes: aws.Int64(2)},
+		},
+		Weight: aws.Float64(1.5),
+	}
+
+	if diff := cmp.Diff(got, want, cmp.AllowUnexported(testAutoFlexConfig{}, testAutoFlexTimeSlice{})); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestExpandOmitEmptyStrings(t *testing.T) {
+	t.Parallel()
+
+	tfMap := map[string]interface{}{
+		"day":               "MONDAY",
+		"desk_phone_number": "",
+		"end_time": []interface{}{map[string]interface{}{
+			"hours":   0,
+			"minutes": 30,
+		}},
+		"labels": map[string]interface{}{"a": ""},
+	}
+
+	got := &testAutoFlexConfig{}
+	if err := Expand(tfMap, got, OmitEmptyStrings()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := &testAutoFlexConfig{
+		Day: aws.String("MONDAY"),
+		EndTime: &testAutoFlexTimeSlice{
+			Hours:   aws.Int64(0),
+			Minutes: aws.Int64(30),
+		},
+	}
+
+	if diff := cmp.Diff(got, want, cmp.AllowUnexported(testAutoFlexConfig{}, testAutoFlexTimeSlice{})); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestExpandTypeMismatch(t *testing.T) {
+	t.Parallel()
+
+	if err := Expand(map[string]interface{}{"day": 1}, &testAutoFlexConfig{}); err == nil {
+		t.Error("expected error, got none")
+	}
+
+	if err := Expand(map[string]interface{}{}, testAutoFlexConfig{}); err == nil {
+		t.Error("expected error for non-pointer target, got none")
+	}
+}
+
+func TestExpandFirst(t *testing.T) {
+	t.Parallel()
+
+	got, err := ExpandFirst[testAutoFlexTimeSlice](nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != nil {
+		t.Errorf("expected nil for empty block, got %v", got)
+	}
+
+	got, err = ExpandFirst[testAutoFlexTimeSlice]([]interface{}{map[string]interface{}{"hours": 9, "minutes": 0}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := &testAutoFlexTimeSlice{Hours: aws.Int64(9), Minutes: aws.Int64(0)}
+
+	if diff := cmp.Diff(got, want, cmp.AllowUnexported(testAutoFlexTimeSlice{})); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	t.Parallel()
+
+	apiObject := &testAutoFlexConfig{
+		AutoAccept: aws.Bool(true),
+		Day:        aws.String("MONDAY"),
+		EndTime: &testAutoFlexTimeSlice{
+			Hours:   aws.Int64(17),
+			Minutes: aws.Int64(0),
+		},
+		Labels:         map[string]*string{"a": aws.String("b")},
+		SecurityGroups: []*string{aws.String("sg-1")},
+		Slots: []*testAutoFlexTimeSlice{
+			{Hours: aws.Int64(1), Minutes: aws.Int64(2)},
+		},
+	}
+
+	got, err := Flatten(apiObject, testAutoFlexConfigResource())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]interface{}{
+		"auto_accept": true,
+		"day":         "MONDAY",
+		"end_time": []interface{}{map[string]interface{}{
+			"hours":   int64(17),
+			"minutes": int64(0),
+		}},
+		"labels":          map[string]interface{}{"a": "b"},
+		"security_groups": []interface{}{"sg-1"},
+		"slots": []interface{}{map[string]interface{}{
+			"hours":   int64(1),
+			"minutes": int64(2),
+		}},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestFlattenIgnoresFieldsNotInSchema(t *testing.T) {
+	t.Parallel()
+
+	got, err := Flatten(&testAutoFlexConfig{Day: aws.String("MONDAY"), Weight: aws.Float64(2)}, &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"day": {Type: schema.TypeString, Optional: true},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]interface{}{"day": "MONDAY"}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestFlattenFirstNil(t *testing.T) {
+	t.Parallel()
+
+	got, err := FlattenFirst((*testAutoFlexTimeSlice)(nil), testAutoFlexTimeSliceResource())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(got, []interface{}{}); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
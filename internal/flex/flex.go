@@ -286,3 +286,53 @@ func ResourceIdPartCount(id string) int {
 	idParts := strings.Split(id, ResourceIdSeparator)
 	return len(idParts)
 }
+
+// DiffSlices compares the old (o) and new (n) elements of an association, matching elements by key.
+// It returns the new elements whose key is not in o (to associate), the old elements whose key is not in n
+// (to disassociate) and the new elements whose key is in both but which equal reports as changed (to update in place).
+// A nil equal treats elements with the same key as unchanged. Elements are returned in their original order.
+func DiffSlices[T any, K comparable](o, n []T, key func(T) K, equal func(T, T) bool) (add, remove, update []T) {
+	oldByKey := make(map[K]T, len(o))
+	for _, v := range o {
+		oldByKey[key(v)] = v
+	}
+
+	newKeys := make(map[K]struct{}, len(n))
+	for _, v := range n {
+		k := key(v)
+		newKeys[k] = struct{}{}
+
+		ov, ok := oldByKey[k]
+		switch {
+		case !ok:
+			add = append(add, v)
+		case equal != nil && !equal(ov, v):
+			update = append(update, v)
+		}
+	}
+
+	for _, v := range o {
+		if _, ok := newKeys[key(v)]; !ok {
+			remove = append(remove, v)
+		}
+	}
+
+	return add, remove, update
+}
+
+// DiffStringSets returns the strings to add and to remove to go from the old to the new set,
+// e.g. the IDs to associate and disassociate. Nil sets, as returned by GetChange for an unset attribute, are empty.
+func DiffStringSets(o, n interface{}) (add, remove []*string) {
+	var os, ns []interface{}
+
+	if v, ok := o.(*schema.Set); ok && v != nil {
+		os = v.List()
+	}
+	if v, ok := n.(*schema.Set); ok && v != nil {
+		ns = v.List()
+	}
+
+	a, r, _ := DiffSlices(os, ns, func(v interface{}) string { return v.(string) }, nil)
+
+	return ExpandStringList(a), ExpandStringList(r)
+}
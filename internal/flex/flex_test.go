@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestExpandStringList(t *testing.T) {
@@ -241,3 +242,58 @@ func TestFlattenTimeStringList(t *testing.T) {
 		t.Errorf("expanded = %v, want = %v", got, want)
 	}
 }
+
+func TestDiffSlices(t *testing.T) {
+	t.Parallel()
+
+	type association struct {
+		id       string
+		priority int
+	}
+
+	key := func(v association) string { return v.id }
+	equal := func(a, b association) bool { return a == b }
+
+	old := []association{{"a", 1}, {"b", 1}, {"c", 1}}
+	n := []association{{"b", 2}, {"c", 1}, {"d", 1}}
+
+	add, remove, update := DiffSlices(old, n, key, equal)
+
+	if want := []association{{"d", 1}}; !cmp.Equal(add, want, cmp.AllowUnexported(association{})) {
+		t.Errorf("add = %v, want = %v", add, want)
+	}
+	if want := []association{{"a", 1}}; !cmp.Equal(remove, want, cmp.AllowUnexported(association{})) {
+		t.Errorf("remove = %v, want = %v", remove, want)
+	}
+	if want := []association{{"b", 2}}; !cmp.Equal(update, want, cmp.AllowUnexported(association{})) {
+		t.Errorf("update = %v, want = %v", update, want)
+	}
+
+	_, _, update = DiffSlices(old, n, key, nil)
+
+	if len(update) != 0 {
+		t.Errorf("update = %v, want none without equal", update)
+	}
+}
+
+func TestDiffStringSets(t *testing.T) {
+	t.Parallel()
+
+	o := schema.NewSet(schema.HashString, []interface{}{"a", "b"})
+	n := schema.NewSet(schema.HashString, []interface{}{"b", "c"})
+
+	add, remove := DiffStringSets(o, n)
+
+	if want := []*string{aws.String("c")}; !cmp.Equal(add, want) {
+		t.Errorf("add = %v, want = %v", aws.StringValueSlice(add), aws.StringValueSlice(want))
+	}
+	if want := []*string{aws.String("a")}; !cmp.Equal(remove, want) {
+		t.Errorf("remove = %v, want = %v", aws.StringValueSlice(remove), aws.StringValueSlice(want))
+	}
+
+	add, remove = DiffStringSets(nil, n)
+
+	if len(add) != 2 || len(remove) != 0 {
+		t.Errorf("from nil: add = %v, remove = %v", aws.StringValueSlice(add), aws.StringValueSlice(remove))
+	}
+}
@@ -12,7 +12,16 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// sdkV2BaseEndpoint lists the services whose AWS SDK for Go v2 client module sets a custom endpoint with
+// the BaseEndpoint client option, deprecating EndpointResolver. It can be removed once all modules are upgraded.
+var sdkV2BaseEndpoint = map[string]bool{
+	"connect":          true,
+	"connectcases":     true,
+	"customerprofiles": true,
+}
+
 type ServiceDatum struct {
+	BaseEndpoint        bool
 	SDKVersion          string
 	GoV1Package         string
 	GoV2Package         string
@@ -74,6 +83,7 @@ func main() {
 				GoV2Package:       l[names.ColGoV2Package],
 				ClientTypeName:    "Client",
 				ProviderPackage:   l[names.ColProviderPackageCorrect],
+				BaseEndpoint:      sdkV2BaseEndpoint[l[names.ColGoV2Package]],
 			}
 			if l[names.ColClientSDKV1] != "" {
 				// Use `sdkv2` instead of `v2` to prevent collisions with e.g., `elbv2`.
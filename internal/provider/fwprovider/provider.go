@@ -48,6 +48,18 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"connect_default_instance_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The identifier of the Amazon Connect instance used by Connect resources\nand data sources that do not set instance_id.",
+			},
+			"connect_max_attempts": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of attempts, including the first, made for each\nAmazon Connect API request. Overrides max_retries for Amazon Connect.",
+			},
+			"connect_retry_mode": schema.StringAttribute{
+				Optional:    true,
+				Description: "The retry mode, standard or adaptive, of the Amazon Connect API client.\nAdaptive mode also slows down requests after throttling errors.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
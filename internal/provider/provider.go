@@ -45,6 +45,26 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"connect_default_instance_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The identifier of the Amazon Connect instance used by Connect resources\n" +
+					"and data sources that do not set instance_id.",
+			},
+			"connect_max_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description: "The maximum number of attempts, including the first, made for each\n" +
+					"Amazon Connect API request. Overrides max_retries for Amazon Connect.",
+			},
+			"connect_retry_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"standard", "adaptive"}, false),
+				Description: "The retry mode, standard or adaptive, of the Amazon Connect API client.\n" +
+					"Adaptive mode also slows down requests after throttling errors.",
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -413,6 +433,9 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		ConnectDefaultInstanceID:       d.Get("connect_default_instance_id").(string),
+		ConnectMaxAttempts:             d.Get("connect_max_attempts").(int),
+		ConnectRetryMode:               d.Get("connect_retry_mode").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -56,8 +56,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/computeoptimizer"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/configservice"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/connectcampaigns"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/connectcases"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/controltower"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cur"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/customerprofiles"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dataexchange"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/datapipeline"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/datasync"
@@ -150,6 +153,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pipes"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pricing"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/qconnect"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ram"
@@ -200,10 +204,12 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/voiceid"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/vpclattice"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/waf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/wisdom"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/worklink"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/xray"
@@ -262,8 +268,11 @@ func servicePackages(context.Context) []conns.ServicePackage {
 		computeoptimizer.ServicePackage,
 		configservice.ServicePackage,
 		connect.ServicePackage,
+		connectcampaigns.ServicePackage,
+		connectcases.ServicePackage,
 		controltower.ServicePackage,
 		cur.ServicePackage,
+		customerprofiles.ServicePackage,
 		dataexchange.ServicePackage,
 		datapipeline.ServicePackage,
 		datasync.ServicePackage,
@@ -356,6 +365,7 @@ func servicePackages(context.Context) []conns.ServicePackage {
 		pinpoint.ServicePackage,
 		pipes.ServicePackage,
 		pricing.ServicePackage,
+		qconnect.ServicePackage,
 		qldb.ServicePackage,
 		quicksight.ServicePackage,
 		ram.ServicePackage,
@@ -406,10 +416,12 @@ func servicePackages(context.Context) []conns.ServicePackage {
 		timestreamwrite.ServicePackage,
 		transcribe.ServicePackage,
 		transfer.ServicePackage,
+		voiceid.ServicePackage,
 		vpclattice.ServicePackage,
 		waf.ServicePackage,
 		wafregional.ServicePackage,
 		wafv2.ServicePackage,
+		wisdom.ServicePackage,
 		worklink.ServicePackage,
 		workspaces.ServicePackage,
 		xray.ServicePackage,
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -41,9 +42,55 @@ func ResourceDataIntegration() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 1000),
 			},
+			"file_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filters": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"values": {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 255),
+										},
+									},
+								},
+							},
+						},
+						"folders": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 10,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 200),
+							},
+						},
+					},
+				},
+			},
 			"kms_key": {
 				Type:         schema.TypeString,
 				Required:     true,
+				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 255),
 			},
 			"name": {
@@ -64,13 +111,13 @@ func ResourceDataIntegration() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"first_execution_from": {
 							Type:         schema.TypeString,
-							Required:     true,
+							Optional:     true,
 							ForceNew:     true,
 							ValidateFunc: validation.StringLenBetween(1, 255),
 						},
 						"object": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
 							ForceNew: true,
 							ValidateFunc: validation.All(
 								validation.StringLenBetween(1, 255),
@@ -92,7 +139,7 @@ func ResourceDataIntegration() *schema.Resource {
 				ForceNew: true,
 				ValidateFunc: validation.All(
 					validation.StringLenBetween(1, 1000),
-					validation.StringMatch(regexp.MustCompile(`^\w+\:\/\/\w+\/[\w/!@#+=.-]+$`), "should be a valid source uri"),
+					validation.StringMatch(regexp.MustCompile(`^\w+\:\/\/[\w.-]+[\w/!@#+=.-]+$`), "should be a valid source uri"),
 				),
 			},
 			names.AttrTags:    tftags.TagsSchema(),
@@ -120,6 +167,10 @@ func resourceDataIntegrationCreate(ctx context.Context, d *schema.ResourceData,
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("file_configuration"); ok {
+		input.FileConfiguration = expandFileConfiguration(v.([]interface{}))
+	}
+
 	output, err := conn.CreateDataIntegrationWithContext(ctx, input)
 
 	if err != nil {
@@ -150,6 +201,9 @@ func resourceDataIntegrationRead(ctx context.Context, d *schema.ResourceData, me
 
 	d.Set("arn", output.Arn)
 	d.Set("description", output.Description)
+	if err := d.Set("file_configuration", flattenFileConfiguration(output.FileConfiguration)); err != nil {
+		return diag.Errorf("setting file_configuration: %s", err)
+	}
 	d.Set("kms_key", output.KmsKey)
 	d.Set("name", output.Name)
 	if err := d.Set("schedule_config", flattenScheduleConfig(output.ScheduleConfiguration)); err != nil {
@@ -187,6 +241,10 @@ func resourceDataIntegrationDelete(ctx context.Context, d *schema.ResourceData,
 		DataIntegrationIdentifier: aws.String(d.Id()),
 	})
 
+	if tfawserr.ErrCodeEquals(err, appintegrationsservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
 	if err != nil {
 		return diag.Errorf("deleting AppIntegrations Data Integration (%s): %s", d.Id(), err)
 	}
@@ -205,11 +263,17 @@ func expandScheduleConfig(scheduleConfig []interface{}) *appintegrationsservice.
 	}
 
 	result := &appintegrationsservice.ScheduleConfiguration{
-		FirstExecutionFrom: aws.String(tfMap["first_execution_from"].(string)),
-		Object:             aws.String(tfMap["object"].(string)),
 		ScheduleExpression: aws.String(tfMap["schedule_expression"].(string)),
 	}
 
+	if v, ok := tfMap["first_execution_from"].(string); ok && v != "" {
+		result.FirstExecutionFrom = aws.String(v)
+	}
+
+	if v, ok := tfMap["object"].(string); ok && v != "" {
+		result.Object = aws.String(v)
+	}
+
 	return result
 }
 
@@ -226,3 +290,56 @@ func flattenScheduleConfig(scheduleConfig *appintegrationsservice.ScheduleConfig
 
 	return []interface{}{values}
 }
+
+func expandFileConfiguration(fileConfiguration []interface{}) *appintegrationsservice.FileConfiguration {
+	if len(fileConfiguration) == 0 || fileConfiguration[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := fileConfiguration[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	result := &appintegrationsservice.FileConfiguration{
+		Folders: flex.ExpandStringSet(tfMap["folders"].(*schema.Set)),
+	}
+
+	if v, ok := tfMap["filters"].(*schema.Set); ok && v.Len() > 0 {
+		filters := make(map[string][]*string, v.Len())
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			filters[tfMap["name"].(string)] = flex.ExpandStringSet(tfMap["values"].(*schema.Set))
+		}
+
+		result.Filters = filters
+	}
+
+	return result
+}
+
+func flattenFileConfiguration(fileConfiguration *appintegrationsservice.FileConfiguration) []interface{} {
+	if fileConfiguration == nil {
+		return []interface{}{}
+	}
+
+	filters := make([]interface{}, 0, len(fileConfiguration.Filters))
+	for k, v := range fileConfiguration.Filters {
+		filters = append(filters, map[string]interface{}{
+			"name":   k,
+			"values": aws.StringValueSlice(v),
+		})
+	}
+
+	values := map[string]interface{}{
+		"filters": filters,
+		"folders": aws.StringValueSlice(fileConfiguration.Folders),
+	}
+
+	return []interface{}{values}
+}
@@ -221,6 +221,43 @@ func TestAccAppIntegrationsDataIntegration_updateTags(t *testing.T) {
 	})
 }
 
+func TestAccAppIntegrationsDataIntegration_fileConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataIntegration appintegrationsservice.GetDataIntegrationOutput
+
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_appintegrations_data_integration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appintegrationsservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataIntegrationConfig_fileConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "file_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "file_configuration.0.filters.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "file_configuration.0.folders.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "file_configuration.0.folders.*", fmt.Sprintf("s3://%s/articles", rName)),
+					resource.TestCheckResourceAttr(resourceName, "schedule_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_config.0.first_execution_from", ""),
+					resource.TestCheckResourceAttr(resourceName, "schedule_config.0.object", ""),
+					resource.TestCheckResourceAttr(resourceName, "schedule_config.0.schedule_expression", "rate(1 hour)"),
+					resource.TestCheckResourceAttr(resourceName, "source_uri", fmt.Sprintf("s3://%s", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckDataIntegrationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).AppIntegrationsConn()
@@ -368,3 +405,27 @@ resource "aws_appintegrations_data_integration" "test" {
 }
 `, rName, description, sourceUri, firstExecutionFrom))
 }
+
+func testAccDataIntegrationConfig_fileConfiguration(rName string) string {
+	return acctest.ConfigCompose(
+		testAccDataIntegrationBaseConfig(),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_appintegrations_data_integration" "test" {
+  name       = %[1]q
+  kms_key    = aws_kms_key.test.arn
+  source_uri = "s3://${aws_s3_bucket.test.id}"
+
+  file_configuration {
+    folders = ["s3://${aws_s3_bucket.test.id}/articles"]
+  }
+
+  schedule_config {
+    schedule_expression = "rate(1 hour)"
+  }
+}
+`, rName))
+}
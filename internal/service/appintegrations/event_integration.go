@@ -57,7 +57,7 @@ func ResourceEventIntegration() *schema.Resource {
 							Type:         schema.TypeString,
 							Required:     true,
 							ForceNew:     true,
-							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^aws\.partner\/.*$`), "should be not be more than 255 alphanumeric, forward slashes, dots, underscores, or hyphen characters"),
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^aws\.partner\/.*$`), "should start with aws.partner/"),
 						},
 					},
 				},
@@ -173,6 +173,10 @@ func resourceEventIntegrationDelete(ctx context.Context, d *schema.ResourceData,
 		Name: aws.String(name),
 	})
 
+	if tfawserr.ErrCodeEquals(err, appintegrationsservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error deleting EventIntegration (%s): %w", d.Id(), err))
 	}
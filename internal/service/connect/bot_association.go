@@ -24,10 +24,12 @@ func ResourceBotAssociation() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: defaultInstanceIDCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -114,7 +116,7 @@ func resourceBotAssociationCreate(ctx context.Context, d *schema.ResourceData, m
 	lbaId := BotV1AssociationCreateResourceID(instanceId, aws.StringValue(input.LexBot.Name), aws.StringValue(input.LexBot.LexRegion))
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating Connect Bot Association (%s): %w", lbaId, err))
+		return diagFromErr(fmt.Errorf("error creating Connect Bot Association (%s): %w", lbaId, err))
 	}
 
 	d.SetId(lbaId)
@@ -128,7 +130,7 @@ func resourceBotAssociationRead(ctx context.Context, d *schema.ResourceData, met
 	instanceId, name, region, err := BotV1AssociationParseResourceID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	lexBot, err := FindBotAssociationV1ByNameAndRegionWithContext(ctx, conn, instanceId, name, region)
@@ -140,16 +142,16 @@ func resourceBotAssociationRead(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error reading Connect Bot Association (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error reading Connect Bot Association (%s): %w", d.Id(), err))
 	}
 
 	if lexBot == nil {
-		return diag.FromErr(fmt.Errorf("error reading Connect Bot Association (%s): empty output", d.Id()))
+		return diagFromErr(fmt.Errorf("error reading Connect Bot Association (%s): empty output", d.Id()))
 	}
 
 	d.Set("instance_id", instanceId)
 	if err := d.Set("lex_bot", flattenLexBot(lexBot)); err != nil {
-		return diag.FromErr(fmt.Errorf("error setting lex_bot: %w", err))
+		return diagFromErr(fmt.Errorf("error setting lex_bot: %w", err))
 	}
 
 	return nil
@@ -161,7 +163,7 @@ func resourceBotAssociationDelete(ctx context.Context, d *schema.ResourceData, m
 	instanceID, name, region, err := BotV1AssociationParseResourceID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	lexBot := &connect.LexBot{
@@ -181,7 +183,7 @@ func resourceBotAssociationDelete(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting Connect Bot Association (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error deleting Connect Bot Association (%s): %w", d.Id(), err))
 	}
 
 	return nil
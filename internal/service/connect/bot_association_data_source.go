@@ -18,7 +18,8 @@ func DataSourceBotAssociation() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 			"lex_bot": {
 				Type:     schema.TypeList,
@@ -44,6 +45,10 @@ func DataSourceBotAssociation() *schema.Resource {
 }
 
 func dataSourceBotAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setDefaultInstanceID(d, meta); err != nil {
+		return diagFromErr(err)
+	}
+
 	conn := meta.(*conns.AWSClient).ConnectConn()
 
 	instanceID := d.Get("instance_id").(string)
@@ -57,18 +62,18 @@ func dataSourceBotAssociationRead(ctx context.Context, d *schema.ResourceData, m
 
 	lexBot, err := FindBotAssociationV1ByNameAndRegionWithContext(ctx, conn, instanceID, name, region)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error finding Connect Bot Association (%s,%s): %w", instanceID, name, err))
+		return diagFromErr(fmt.Errorf("error finding Connect Bot Association (%s,%s): %w", instanceID, name, err))
 	}
 
 	if lexBot == nil {
-		return diag.FromErr(fmt.Errorf("error finding Connect Bot Association (%s,%s) : not found", instanceID, name))
+		return diagFromErr(fmt.Errorf("error finding Connect Bot Association (%s,%s) : not found", instanceID, name))
 	}
 
 	d.SetId(meta.(*conns.AWSClient).Region)
 
 	d.Set("instance_id", instanceID)
 	if err := d.Set("lex_bot", flattenLexBot(lexBot)); err != nil {
-		return diag.FromErr(fmt.Errorf("error setting lex_bot: %w", err))
+		return diagFromErr(fmt.Errorf("error setting lex_bot: %w", err))
 	}
 
 	return nil
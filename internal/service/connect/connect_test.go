@@ -20,8 +20,10 @@ func TestAccConnect_serial(t *testing.T) {
 			"basic":           testAccContactFlow_basic,
 			"disappears":      testAccContactFlow_disappears,
 			"filename":        testAccContactFlow_filename,
+			"state":           testAccContactFlow_state,
 			"dataSource_id":   testAccContactFlowDataSource_contactFlowID,
 			"dataSource_name": testAccContactFlowDataSource_name,
+			"dataSource_type": testAccContactFlowDataSource_nameAndType,
 		},
 		"ContactFlowModule": {
 			"basic":           testAccContactFlowModule_basic,
@@ -30,6 +32,16 @@ func TestAccConnect_serial(t *testing.T) {
 			"dataSource_id":   testAccContactFlowModuleDataSource_contactFlowModuleID,
 			"dataSource_name": testAccContactFlowModuleDataSource_name,
 		},
+		"EvaluationForm": {
+			"basic":            testAccEvaluationForm_basic,
+			"disappears":       testAccEvaluationForm_disappears,
+			"versions":         testAccEvaluationForm_versions,
+			"dataSource_id":    testAccEvaluationFormDataSource_evaluationFormID,
+			"dataSource_title": testAccEvaluationFormDataSource_title,
+		},
+		"EvaluationForms": {
+			"dataSource_basic": testAccEvaluationFormsDataSource_basic,
+		},
 		"HoursOfOperation": {
 			"basic":           testAccHoursOfOperation_basic,
 			"disappears":      testAccHoursOfOperation_disappears,
@@ -38,15 +50,22 @@ func TestAccConnect_serial(t *testing.T) {
 			"dataSource_id":   testAccHoursOfOperationDataSource_hoursOfOperationID,
 			"dataSource_name": testAccHoursOfOperationDataSource_name,
 		},
+		"ImportedPhoneNumber": {
+			"basic": testAccImportedPhoneNumber_basic,
+		},
 		"Instance": {
-			"basic":            testAccInstance_basic,
-			"directory":        testAccInstance_directory,
-			"saml":             testAccInstance_saml,
-			"dataSource_basic": testAccInstanceDataSource_basic,
+			"basic":                 testAccInstance_basic,
+			"deletionProtection":    testAccInstance_deletionProtection,
+			"directory":             testAccInstance_directory,
+			"saml":                  testAccInstance_saml,
+			"tags":                  testAccInstance_tags,
+			"dataSource_basic":      testAccInstanceDataSource_basic,
+			"dataSource_attributes": testAccInstanceAttributesDataSource_basic,
 		},
 		"InstanceStorageConfig": {
 			"basic":                                     testAccInstanceStorageConfig_basic,
 			"disappears":                                testAccInstanceStorageConfig_disappears,
+			"import":                                    testAccInstanceStorageConfig_import,
 			"KinesisFirehoseConfig_FirehoseARN":         testAccInstanceStorageConfig_KinesisFirehoseConfig_FirehoseARN,
 			"KinesisStreamConfig_StreamARN":             testAccInstanceStorageConfig_KinesisStreamConfig_StreamARN,
 			"KinesisVideoStreamConfig_EncryptionConfig": testAccInstanceStorageConfig_KinesisVideoStreamConfig_EncryptionConfig,
@@ -60,31 +79,48 @@ func TestAccConnect_serial(t *testing.T) {
 			"dataSource_KinesisVideoStreamConfig":       testAccInstanceStorageConfigDataSource_KinesisVideoStreamConfig,
 			"dataSource_S3Config":                       testAccInstanceStorageConfigDataSource_S3Config,
 		},
+		"IntegrationAssociation": {
+			"basic":      testAccIntegrationAssociation_basic,
+			"disappears": testAccIntegrationAssociation_disappears,
+		},
 		"LambdaFunctionAssociation": {
 			"basic":            testAccLambdaFunctionAssociation_basic,
 			"disappears":       testAccLambdaFunctionAssociation_disappears,
 			"dataSource_basic": testAccLambdaFunctionAssociationDataSource_basic,
 		},
 		"PhoneNumber": {
-			"basic":       testAccPhoneNumber_basic,
-			"disappears":  testAccPhoneNumber_disappears,
-			"tags":        testAccPhoneNumber_tags,
-			"description": testAccPhoneNumber_description,
-			"prefix":      testAccPhoneNumber_prefix,
-			"targetARN":   testAccPhoneNumber_targetARN,
+			"basic":            testAccPhoneNumber_basic,
+			"disappears":       testAccPhoneNumber_disappears,
+			"tags":             testAccPhoneNumber_tags,
+			"description":      testAccPhoneNumber_description,
+			"prefix":           testAccPhoneNumber_prefix,
+			"targetARN":        testAccPhoneNumber_targetARN,
+			"dataSource_basic": testAccPhoneNumberDataSource_basic,
+		},
+		"PredefinedAttribute": {
+			"basic":            testAccPredefinedAttribute_basic,
+			"disappears":       testAccPredefinedAttribute_disappears,
+			"dataSource_basic": testAccPredefinedAttributesDataSource_basic,
 		},
 		"Prompt": {
+			"basic":           testAccPrompt_basic,
+			"disappears":      testAccPrompt_disappears,
+			"sourceFileOnly":  testAccPrompt_sourceFileOnly,
 			"dataSource_name": testAccPromptDataSource_name,
+			"dataSource_id":   testAccPromptDataSource_promptID,
 		},
 		"Queue": {
 			"basic":                testAccQueue_basic,
 			"disappears":           testAccQueue_disappears,
+			"forceDetach":          testAccQueue_forceDetach,
 			"tags":                 testAccQueue_updateTags,
 			"hoursOfOperationId":   testAccQueue_updateHoursOfOperationId,
 			"maxContacts":          testAccQueue_updateMaxContacts,
 			"outboundCallerConfig": testAccQueue_updateOutboundCallerConfig,
 			"status":               testAccQueue_updateStatus,
 			"quickConnectIds":      testAccQueue_updateQuickConnectIds,
+			"quickConnectIdsBatch": testAccQueue_updateQuickConnectIdsBatched,
+			"defaultInstanceID":    testAccQueue_defaultInstanceID,
 			"dataSource_id":        testAccQueueDataSource_queueID,
 			"dataSource_name":      testAccQueueDataSource_name,
 		},
@@ -95,25 +131,58 @@ func TestAccConnect_serial(t *testing.T) {
 			"dataSource_id":   testAccQuickConnectDataSource_id,
 			"dataSource_name": testAccQuickConnectDataSource_name,
 		},
+		"QuickConnects": {
+			"dataSource_basic": testAccQuickConnectsDataSource_basic,
+		},
 		"RoutingProfile": {
 			"basic":                        testAccRoutingProfile_basic,
 			"disappears":                   testAccRoutingProfile_disappears,
 			"tags":                         testAccRoutingProfile_updateTags,
 			"concurrency":                  testAccRoutingProfile_updateConcurrency,
+			"agentAvailabilityTimer":       testAccRoutingProfile_updateAgentAvailabilityTimer,
 			"defaultOutboundQueue":         testAccRoutingProfile_updateDefaultOutboundQueue,
 			"queues":                       testAccRoutingProfile_updateQueues,
+			"queueConfigsDrift":            testAccRoutingProfile_queueConfigsDrift,
 			"createQueueBatchAssociations": testAccRoutingProfile_createQueueConfigsBatchedAssociateDisassociate,
 			"updateQueueBatchAssociations": testAccRoutingProfile_updateQueueConfigsBatchedAssociateDisassociate,
 			"dataSource_id":                testAccRoutingProfileDataSource_routingProfileID,
 			"dataSource_name":              testAccRoutingProfileDataSource_name,
 		},
+		"Rule": {
+			"basic":             testAccRule_basic,
+			"disappears":        testAccRule_disappears,
+			"unsupportedAction": testAccRule_unsupportedAction,
+		},
+		"Rules": {
+			"dataSource_basic": testAccRulesDataSource_basic,
+		},
 		"SecurityProfile": {
-			"basic":           testAccSecurityProfile_basic,
-			"disappears":      testAccSecurityProfile_disappears,
-			"tags":            testAccSecurityProfile_updateTags,
-			"permissions":     testAccSecurityProfile_updatePermissions,
-			"dataSource_id":   testAccSecurityProfileDataSource_securityProfileID,
-			"dataSource_name": testAccSecurityProfileDataSource_name,
+			"basic":                        testAccSecurityProfile_basic,
+			"disappears":                   testAccSecurityProfile_disappears,
+			"tags":                         testAccSecurityProfile_updateTags,
+			"permissions":                  testAccSecurityProfile_updatePermissions,
+			"hierarchyRestrictedResources": testAccSecurityProfile_hierarchyRestrictedResources,
+			"applications":                 testAccSecurityProfile_applications,
+			"dataSource_id":                testAccSecurityProfileDataSource_securityProfileID,
+			"dataSource_name":              testAccSecurityProfileDataSource_name,
+			"dataSource_permissions":       testAccSecurityProfilePermissionsDataSource_basic,
+		},
+		"TaskTemplate": {
+			"basic":           testAccTaskTemplate_basic,
+			"disappears":      testAccTaskTemplate_disappears,
+			"updateFields":    testAccTaskTemplate_updateFields,
+			"dataSource_id":   testAccTaskTemplateDataSource_taskTemplateID,
+			"dataSource_name": testAccTaskTemplateDataSource_name,
+		},
+		"TaskTemplates": {
+			"dataSource_basic": testAccTaskTemplatesDataSource_basic,
+		},
+		"TrafficDistribution": {
+			"basic": testAccTrafficDistribution_basic,
+		},
+		"TrafficDistributionGroup": {
+			"dataSource_id":   testAccTrafficDistributionGroupDataSource_trafficDistributionGroupID,
+			"dataSource_name": testAccTrafficDistributionGroupDataSource_name,
 		},
 		"User": {
 			"basic":              testAccUser_basic,
@@ -124,6 +193,9 @@ func TestAccConnect_serial(t *testing.T) {
 			"phoneConfig":        testAccUser_updatePhoneConfig,
 			"routingProfileId":   testAccUser_updateRoutingProfileId,
 			"securityProfileIds": testAccUser_updateSecurityProfileIds,
+			"names":              testAccUser_names,
+			"replace":            testAccUser_replace,
+			"passwordRequired":   testAccUser_passwordRequired,
 			"dataSource_id":      testAccUserDataSource_userID,
 			"dataSource_name":    testAccUserDataSource_name,
 		},
@@ -135,10 +207,17 @@ func TestAccConnect_serial(t *testing.T) {
 			"dataSource_id":   testAccUserHierarchyGroupDataSource_hierarchyGroupID,
 			"dataSource_name": testAccUserHierarchyGroupDataSource_name,
 		},
+		"UserHierarchyGroups": {
+			"dataSource_basic": testAccUserHierarchyGroupsDataSource_basic,
+		},
 		"UserHierarchyStructure": {
-			"basic":         testAccUserHierarchyStructure_basic,
-			"disappears":    testAccUserHierarchyStructure_disappears,
-			"dataSource_id": testAccUserHierarchyStructureDataSource_instanceID,
+			"basic":                 testAccUserHierarchyStructure_basic,
+			"disappears":            testAccUserHierarchyStructure_disappears,
+			"removeLevelWithGroups": testAccUserHierarchyStructure_removeLevelWithGroups,
+			"dataSource_id":         testAccUserHierarchyStructureDataSource_instanceID,
+		},
+		"Views": {
+			"dataSource_basic": testAccViewsDataSource_basic,
 		},
 		"Vocabulary": {
 			"basic":           testAccVocabulary_basic,
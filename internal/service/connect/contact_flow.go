@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -32,9 +33,17 @@ func ResourceContactFlow() *schema.Resource {
 		UpdateWithoutTimeout: resourceContactFlowUpdate,
 		DeleteWithoutTimeout: resourceContactFlowDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// force_delete is not returned by the API.
+				d.Set("force_delete", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			defaultInstanceIDCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -69,14 +78,26 @@ func ResourceContactFlow() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"content"},
 			},
+			"force_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"instance_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(connect.ContactFlowState_Values(), false), // Valid values: ACTIVE | ARCHIVED
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"type": {
@@ -116,7 +137,7 @@ func resourceContactFlowCreate(ctx context.Context, d *schema.ResourceData, meta
 		defer conns.GlobalMutexKV.Unlock(contactFlowMutexKey)
 		file, err := resourceContactFlowLoadFileContent(filename)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("unable to load %q: %w", filename, err))
+			return diagFromErr(fmt.Errorf("unable to load %q: %w", filename, err))
 		}
 		input.Content = aws.String(file)
 	} else if v, ok := d.GetOk("content"); ok {
@@ -126,15 +147,22 @@ func resourceContactFlowCreate(ctx context.Context, d *schema.ResourceData, meta
 	output, err := conn.CreateContactFlowWithContext(ctx, input)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating Connect Contact Flow (%s): %w", name, err))
+		return diagFromErr(fmt.Errorf("error creating Connect Contact Flow (%s): %w", name, err))
 	}
 
 	if output == nil {
-		return diag.FromErr(fmt.Errorf("error creating Connect Contact Flow (%s): empty output", name))
+		return diagFromErr(fmt.Errorf("error creating Connect Contact Flow (%s): empty output", name))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(output.ContactFlowId)))
 
+	// contact flows are always created in the ACTIVE state
+	if v, ok := d.GetOk("state"); ok && v.(string) == connect.ContactFlowStateArchived {
+		if err := updateContactFlowState(ctx, conn, instanceID, aws.StringValue(output.ContactFlowId), v.(string)); err != nil {
+			return diagFromErr(fmt.Errorf("error archiving Connect Contact Flow (%s): %w", d.Id(), err))
+		}
+	}
+
 	return resourceContactFlowRead(ctx, d, meta)
 }
 
@@ -144,7 +172,7 @@ func resourceContactFlowRead(ctx context.Context, d *schema.ResourceData, meta i
 	instanceID, contactFlowID, err := ContactFlowParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	resp, err := conn.DescribeContactFlowWithContext(ctx, &connect.DescribeContactFlowInput{
@@ -159,11 +187,11 @@ func resourceContactFlowRead(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Contact Flow (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error getting Connect Contact Flow (%s): %w", d.Id(), err))
 	}
 
 	if resp == nil || resp.ContactFlow == nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Contact Flow (%s): empty response", d.Id()))
+		return diagFromErr(fmt.Errorf("error getting Connect Contact Flow (%s): empty response", d.Id()))
 	}
 
 	d.Set("arn", resp.ContactFlow.Arn)
@@ -173,6 +201,7 @@ func resourceContactFlowRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("description", resp.ContactFlow.Description)
 	d.Set("type", resp.ContactFlow.Type)
 	d.Set("content", resp.ContactFlow.Content)
+	d.Set("state", resp.ContactFlow.State)
 
 	SetTagsOut(ctx, resp.ContactFlow.Tags)
 
@@ -185,7 +214,14 @@ func resourceContactFlowUpdate(ctx context.Context, d *schema.ResourceData, meta
 	instanceID, contactFlowID, err := ContactFlowParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
+	}
+
+	// an archived contact flow must be unarchived before any other changes are applied to it
+	if d.HasChange("state") && d.Get("state").(string) == connect.ContactFlowStateActive {
+		if err := updateContactFlowState(ctx, conn, instanceID, contactFlowID, connect.ContactFlowStateActive); err != nil {
+			return diagFromErr(fmt.Errorf("error unarchiving Connect Contact Flow (%s): %w", d.Id(), err))
+		}
 	}
 
 	if d.HasChanges("name", "description") {
@@ -199,7 +235,7 @@ func resourceContactFlowUpdate(ctx context.Context, d *schema.ResourceData, meta
 		_, updateMetadataInputErr := conn.UpdateContactFlowNameWithContext(ctx, updateMetadataInput)
 
 		if updateMetadataInputErr != nil {
-			return diag.FromErr(fmt.Errorf("error updating Connect Contact Flow (%s): %w", d.Id(), updateMetadataInputErr))
+			return diagFromErr(fmt.Errorf("error updating Connect Contact Flow (%s): %w", d.Id(), updateMetadataInputErr))
 		}
 	}
 
@@ -218,7 +254,7 @@ func resourceContactFlowUpdate(ctx context.Context, d *schema.ResourceData, meta
 			defer conns.GlobalMutexKV.Unlock(contactFlowMutexKey)
 			file, err := resourceContactFlowLoadFileContent(filename)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("unable to load %q: %w", filename, err))
+				return diagFromErr(fmt.Errorf("unable to load %q: %w", filename, err))
 			}
 			updateContentInput.Content = aws.String(file)
 		} else if v, ok := d.GetOk("content"); ok {
@@ -228,7 +264,13 @@ func resourceContactFlowUpdate(ctx context.Context, d *schema.ResourceData, meta
 		_, updateContentInputErr := conn.UpdateContactFlowContentWithContext(ctx, updateContentInput)
 
 		if updateContentInputErr != nil {
-			return diag.FromErr(fmt.Errorf("error updating Connect Contact Flow content (%s): %w", d.Id(), updateContentInputErr))
+			return diagFromErr(fmt.Errorf("error updating Connect Contact Flow content (%s): %w", d.Id(), updateContentInputErr))
+		}
+	}
+
+	if d.HasChange("state") && d.Get("state").(string) == connect.ContactFlowStateArchived {
+		if err := updateContactFlowState(ctx, conn, instanceID, contactFlowID, connect.ContactFlowStateArchived); err != nil {
+			return diagFromErr(fmt.Errorf("error archiving Connect Contact Flow (%s): %w", d.Id(), err))
 		}
 	}
 
@@ -241,7 +283,7 @@ func resourceContactFlowDelete(ctx context.Context, d *schema.ResourceData, meta
 	instanceID, contactFlowID, err := ContactFlowParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	log.Printf("[DEBUG] Deleting Connect Contact Flow : %s", contactFlowID)
@@ -253,13 +295,41 @@ func resourceContactFlowDelete(ctx context.Context, d *schema.ResourceData, meta
 
 	_, deleteContactFlowErr := conn.DeleteContactFlowWithContext(ctx, input)
 
+	if tfawserr.ErrCodeEquals(deleteContactFlowErr, connect.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	// A contact flow that is still referenced (e.g. by a phone number or another flow) cannot be deleted.
+	// Unless force_delete is set, archive it instead so that it no longer appears as an active flow.
+	if tfawserr.ErrCodeEquals(deleteContactFlowErr, connect.ErrCodeResourceInUseException) && !d.Get("force_delete").(bool) {
+		log.Printf("[WARN] Unable to delete Connect Contact Flow (%s), archiving instead: %s", d.Id(), deleteContactFlowErr)
+
+		if err := updateContactFlowState(ctx, conn, instanceID, contactFlowID, connect.ContactFlowStateArchived); err != nil {
+			return diagFromErr(fmt.Errorf("error archiving Connect Contact Flow (%s): %w", d.Id(), err))
+		}
+
+		return nil
+	}
+
 	if deleteContactFlowErr != nil {
-		return diag.FromErr(fmt.Errorf("error deleting Connect Contact Flow (%s): %w", d.Id(), deleteContactFlowErr))
+		return diagFromErr(fmt.Errorf("error deleting Connect Contact Flow (%s): %w", d.Id(), deleteContactFlowErr))
 	}
 
 	return nil
 }
 
+func updateContactFlowState(ctx context.Context, conn *connect.Connect, instanceID, contactFlowID, state string) error {
+	input := &connect.UpdateContactFlowMetadataInput{
+		ContactFlowId:    aws.String(contactFlowID),
+		ContactFlowState: aws.String(state),
+		InstanceId:       aws.String(instanceID),
+	}
+
+	_, err := conn.UpdateContactFlowMetadataWithContext(ctx, input)
+
+	return err
+}
+
 func ContactFlowParseID(id string) (string, string, error) {
 	parts := strings.SplitN(id, ":", 2)
 
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
@@ -37,7 +38,8 @@ func DataSourceContactFlow() *schema.Resource {
 			},
 			"instance_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -45,64 +47,67 @@ func DataSourceContactFlow() *schema.Resource {
 				Computed:     true,
 				ExactlyOneOf: []string{"name", "contact_flow_id"},
 			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tags": tftags.TagsSchemaComputed(),
 			"type": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(connect.ContactFlowType_Values(), false),
 			},
 		},
 	}
 }
 
 func dataSourceContactFlowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setDefaultInstanceID(d, meta); err != nil {
+		return diagFromErr(err)
+	}
+
 	conn := meta.(*conns.AWSClient).ConnectConn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	instanceID := d.Get("instance_id").(string)
 
-	input := &connect.DescribeContactFlowInput{
-		InstanceId: aws.String(instanceID),
-	}
+	var contactFlowID string
 
 	if v, ok := d.GetOk("contact_flow_id"); ok {
-		input.ContactFlowId = aws.String(v.(string))
+		contactFlowID = v.(string)
 	} else if v, ok := d.GetOk("name"); ok {
 		name := v.(string)
-		contactFlowSummary, err := dataSourceGetContactFlowSummaryByName(ctx, conn, instanceID, name)
+		contactFlowSummary, err := dataSourceGetContactFlowSummaryByName(ctx, conn, instanceID, name, d.Get("type").(string))
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error finding Connect Contact Flow Summary by name (%s): %w", name, err))
+			return diagFromErr(fmt.Errorf("error finding Connect Contact Flow Summary by name (%s): %w", name, err))
 		}
 
 		if contactFlowSummary == nil {
-			return diag.FromErr(fmt.Errorf("error finding Connect Contact Flow Summary by name (%s): not found", name))
+			return diagFromErr(fmt.Errorf("error finding Connect Contact Flow Summary by name (%s): not found", name))
 		}
 
-		input.ContactFlowId = contactFlowSummary.Id
+		contactFlowID = aws.StringValue(contactFlowSummary.Id)
 	}
 
-	resp, err := conn.DescribeContactFlowWithContext(ctx, input)
+	contactFlow, err := FindContactFlowByID(ctx, conn, instanceID, contactFlowID)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Contact Flow: %w", err))
+		return diagFromErr(fmt.Errorf("error getting Connect Contact Flow (%s): %w", contactFlowID, err))
 	}
 
-	if resp == nil || resp.ContactFlow == nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Contact Flow: empty response"))
-	}
-
-	contactFlow := resp.ContactFlow
-
 	d.Set("arn", contactFlow.Arn)
 	d.Set("instance_id", instanceID)
 	d.Set("contact_flow_id", contactFlow.Id)
 	d.Set("name", contactFlow.Name)
 	d.Set("description", contactFlow.Description)
 	d.Set("content", contactFlow.Content)
+	d.Set("state", contactFlow.State)
 	d.Set("type", contactFlow.Type)
 
 	if err := d.Set("tags", KeyValueTags(ctx, contactFlow.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
-		return diag.FromErr(fmt.Errorf("error setting tags: %s", err))
+		return diagFromErr(fmt.Errorf("error setting tags: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(contactFlow.Id)))
@@ -110,7 +115,9 @@ func dataSourceContactFlowRead(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
-func dataSourceGetContactFlowSummaryByName(ctx context.Context, conn *connect.Connect, instanceID, name string) (*connect.ContactFlowSummary, error) {
+// dataSourceGetContactFlowSummaryByName returns the contact flow with the specified name.
+// If contactFlowType is not empty, only contact flows of that type are considered.
+func dataSourceGetContactFlowSummaryByName(ctx context.Context, conn *connect.Connect, instanceID, name, contactFlowType string) (*connect.ContactFlowSummary, error) {
 	var result *connect.ContactFlowSummary
 
 	input := &connect.ListContactFlowsInput{
@@ -118,6 +125,10 @@ func dataSourceGetContactFlowSummaryByName(ctx context.Context, conn *connect.Co
 		MaxResults: aws.Int64(ListContactFlowsMaxResults),
 	}
 
+	if contactFlowType != "" {
+		input.ContactFlowTypes = aws.StringSlice([]string{contactFlowType})
+	}
+
 	err := conn.ListContactFlowsPagesWithContext(ctx, input, func(page *connect.ListContactFlowsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
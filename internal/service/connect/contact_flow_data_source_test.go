@@ -31,6 +31,7 @@ func testAccContactFlowDataSource_contactFlowID(t *testing.T) {
 					resource.TestCheckResourceAttrPair(datasourceName, "name", resourceName, "name"),
 					resource.TestCheckResourceAttrPair(datasourceName, "description", resourceName, "description"),
 					resource.TestCheckResourceAttrPair(datasourceName, "content", resourceName, "content"),
+					resource.TestCheckResourceAttrPair(datasourceName, "state", resourceName, "state"),
 					resource.TestCheckResourceAttrPair(datasourceName, "type", resourceName, "type"),
 					resource.TestCheckResourceAttrPair(datasourceName, "tags.%", resourceName, "tags.%"),
 				),
@@ -61,6 +62,7 @@ func testAccContactFlowDataSource_name(t *testing.T) {
 					resource.TestCheckResourceAttrPair(datasourceName, "name", resourceName, "name"),
 					resource.TestCheckResourceAttrPair(datasourceName, "description", resourceName, "description"),
 					resource.TestCheckResourceAttrPair(datasourceName, "content", resourceName, "content"),
+					resource.TestCheckResourceAttrPair(datasourceName, "state", resourceName, "state"),
 					resource.TestCheckResourceAttrPair(datasourceName, "type", resourceName, "type"),
 					resource.TestCheckResourceAttrPair(datasourceName, "tags.%", resourceName, "tags.%"),
 				),
@@ -69,6 +71,32 @@ func testAccContactFlowDataSource_name(t *testing.T) {
 	})
 }
 
+func testAccContactFlowDataSource_nameAndType(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_contact_flow.test"
+	datasourceName := "data.aws_connect_contact_flow.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactFlowDataSourceConfig_nameAndType(rName, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "contact_flow_id", resourceName, "contact_flow_id"),
+					resource.TestCheckResourceAttr(datasourceName, "state", connect.ContactFlowStateActive),
+					resource.TestCheckResourceAttr(datasourceName, "type", connect.ContactFlowTypeContactFlow),
+				),
+			},
+		},
+	})
+}
+
 func testAccContactFlowBaseDataSourceConfig(rName, rName2 string) string {
 	return fmt.Sprintf(`
 resource "aws_connect_instance" "test" {
@@ -110,3 +138,13 @@ data "aws_connect_contact_flow" "test" {
 }
 `)
 }
+
+func testAccContactFlowDataSourceConfig_nameAndType(rName, rName2 string) string {
+	return fmt.Sprintf(testAccContactFlowBaseDataSourceConfig(rName, rName2) + `
+data "aws_connect_contact_flow" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = aws_connect_contact_flow.test.name
+  type        = "CONTACT_FLOW"
+}
+`)
+}
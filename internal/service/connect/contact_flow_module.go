@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -34,7 +35,10 @@ func ResourceContactFlowModule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			defaultInstanceIDCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -72,7 +76,8 @@ func ResourceContactFlowModule() *schema.Resource {
 			},
 			"instance_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -110,7 +115,7 @@ func resourceContactFlowModuleCreate(ctx context.Context, d *schema.ResourceData
 		defer conns.GlobalMutexKV.Unlock(contactFlowModuleMutexKey)
 		file, err := resourceContactFlowModuleLoadFileContent(filename)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("unable to load %q: %w", filename, err))
+			return diagFromErr(fmt.Errorf("unable to load %q: %w", filename, err))
 		}
 		input.Content = aws.String(file)
 	} else if v, ok := d.GetOk("content"); ok {
@@ -120,11 +125,11 @@ func resourceContactFlowModuleCreate(ctx context.Context, d *schema.ResourceData
 	output, err := conn.CreateContactFlowModuleWithContext(ctx, input)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating Connect Contact Flow Module (%s): %w", name, err))
+		return diagFromErr(fmt.Errorf("error creating Connect Contact Flow Module (%s): %w", name, err))
 	}
 
 	if output == nil {
-		return diag.FromErr(fmt.Errorf("error creating Connect Contact Flow Module (%s): empty output", name))
+		return diagFromErr(fmt.Errorf("error creating Connect Contact Flow Module (%s): empty output", name))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(output.Id)))
@@ -138,7 +143,7 @@ func resourceContactFlowModuleRead(ctx context.Context, d *schema.ResourceData,
 	instanceID, contactFlowModuleID, err := ContactFlowModuleParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	resp, err := conn.DescribeContactFlowModuleWithContext(ctx, &connect.DescribeContactFlowModuleInput{
@@ -153,11 +158,11 @@ func resourceContactFlowModuleRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Contact Flow Module (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error getting Connect Contact Flow Module (%s): %w", d.Id(), err))
 	}
 
 	if resp == nil || resp.ContactFlowModule == nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Contact Flow Module (%s): empty response", d.Id()))
+		return diagFromErr(fmt.Errorf("error getting Connect Contact Flow Module (%s): empty response", d.Id()))
 	}
 
 	d.Set("arn", resp.ContactFlowModule.Arn)
@@ -178,7 +183,7 @@ func resourceContactFlowModuleUpdate(ctx context.Context, d *schema.ResourceData
 	instanceID, contactFlowModuleID, err := ContactFlowModuleParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if d.HasChanges("name", "description") {
@@ -192,7 +197,7 @@ func resourceContactFlowModuleUpdate(ctx context.Context, d *schema.ResourceData
 		_, updateMetadataInputErr := conn.UpdateContactFlowModuleMetadataWithContext(ctx, updateMetadataInput)
 
 		if updateMetadataInputErr != nil {
-			return diag.FromErr(fmt.Errorf("error updating Connect Contact Flow Module (%s): %w", d.Id(), updateMetadataInputErr))
+			return diagFromErr(fmt.Errorf("error updating Connect Contact Flow Module (%s): %w", d.Id(), updateMetadataInputErr))
 		}
 	}
 
@@ -211,7 +216,7 @@ func resourceContactFlowModuleUpdate(ctx context.Context, d *schema.ResourceData
 			defer conns.GlobalMutexKV.Unlock(contactFlowModuleMutexKey)
 			file, err := resourceContactFlowModuleLoadFileContent(filename)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("unable to load %q: %w", filename, err))
+				return diagFromErr(fmt.Errorf("unable to load %q: %w", filename, err))
 			}
 			updateContentInput.Content = aws.String(file)
 		} else if v, ok := d.GetOk("content"); ok {
@@ -221,7 +226,7 @@ func resourceContactFlowModuleUpdate(ctx context.Context, d *schema.ResourceData
 		_, updateContentInputErr := conn.UpdateContactFlowModuleContentWithContext(ctx, updateContentInput)
 
 		if updateContentInputErr != nil {
-			return diag.FromErr(fmt.Errorf("error updating Connect Contact Flow Module content (%s): %w", d.Id(), updateContentInputErr))
+			return diagFromErr(fmt.Errorf("error updating Connect Contact Flow Module content (%s): %w", d.Id(), updateContentInputErr))
 		}
 	}
 
@@ -233,7 +238,7 @@ func resourceContactFlowModuleDelete(ctx context.Context, d *schema.ResourceData
 
 	instanceID, contactFlowModuleID, err := ContactFlowModuleParseID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	log.Printf("[DEBUG] Deleting Connect Contact Flow Module : %s", contactFlowModuleID)
 	input := &connect.DeleteContactFlowModuleInput{
@@ -243,7 +248,7 @@ func resourceContactFlowModuleDelete(ctx context.Context, d *schema.ResourceData
 
 	_, deleteContactFlowModuleErr := conn.DeleteContactFlowModuleWithContext(ctx, input)
 	if deleteContactFlowModuleErr != nil {
-		return diag.FromErr(fmt.Errorf("error deleting Connect Contact Flow Module (%s): %w", d.Id(), deleteContactFlowModuleErr))
+		return diagFromErr(fmt.Errorf("error deleting Connect Contact Flow Module (%s): %w", d.Id(), deleteContactFlowModuleErr))
 	}
 	return nil
 }
@@ -37,7 +37,8 @@ func DataSourceContactFlowModule() *schema.Resource {
 			},
 			"instance_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -59,6 +60,10 @@ func DataSourceContactFlowModule() *schema.Resource {
 }
 
 func dataSourceContactFlowModuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setDefaultInstanceID(d, meta); err != nil {
+		return diagFromErr(err)
+	}
+
 	conn := meta.(*conns.AWSClient).ConnectConn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -75,11 +80,11 @@ func dataSourceContactFlowModuleRead(ctx context.Context, d *schema.ResourceData
 		contactFlowModuleSummary, err := dataSourceGetContactFlowModuleSummaryByName(ctx, conn, instanceID, name)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error finding Connect Contact Flow Module Summary by name (%s): %w", name, err))
+			return diagFromErr(fmt.Errorf("error finding Connect Contact Flow Module Summary by name (%s): %w", name, err))
 		}
 
 		if contactFlowModuleSummary == nil {
-			return diag.FromErr(fmt.Errorf("error finding Connect Contact Flow Module Summary by name (%s): not found", name))
+			return diagFromErr(fmt.Errorf("error finding Connect Contact Flow Module Summary by name (%s): not found", name))
 		}
 
 		input.ContactFlowModuleId = contactFlowModuleSummary.Id
@@ -88,11 +93,11 @@ func dataSourceContactFlowModuleRead(ctx context.Context, d *schema.ResourceData
 	resp, err := conn.DescribeContactFlowModuleWithContext(ctx, input)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Contact Flow Module: %w", err))
+		return diagFromErr(fmt.Errorf("error getting Connect Contact Flow Module: %w", err))
 	}
 
 	if resp == nil || resp.ContactFlowModule == nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Contact Flow Module: empty response"))
+		return diagFromErr(fmt.Errorf("error getting Connect Contact Flow Module: empty response"))
 	}
 
 	contactFlowModule := resp.ContactFlowModule
@@ -106,7 +111,7 @@ func dataSourceContactFlowModuleRead(ctx context.Context, d *schema.ResourceData
 	d.Set("status", contactFlowModule.Status)
 
 	if err := d.Set("tags", KeyValueTags(ctx, contactFlowModule.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
-		return diag.FromErr(fmt.Errorf("error setting tags: %s", err))
+		return diagFromErr(fmt.Errorf("error setting tags: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(contactFlowModule.Id)))
@@ -7,13 +7,13 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func testAccContactFlowModule_basic(t *testing.T) {
@@ -188,14 +188,9 @@ func testAccCheckContactFlowModuleDestroy(ctx context.Context) resource.TestChec
 				return err
 			}
 
-			params := &connect.DescribeContactFlowModuleInput{
-				ContactFlowModuleId: aws.String(contactFlowModuleID),
-				InstanceId:          aws.String(instanceID),
-			}
-
-			_, err = conn.DescribeContactFlowModuleWithContext(ctx, params)
+			_, err = tfconnect.FindContactFlowModuleByID(ctx, conn, instanceID, contactFlowModuleID)
 
-			if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+			if tfresource.NotFound(err) {
 				continue
 			}
 
@@ -7,13 +7,13 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func testAccContactFlow_basic(t *testing.T) {
@@ -39,6 +39,7 @@ func testAccContactFlow_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "name"),
 					resource.TestCheckResourceAttrSet(resourceName, "description"),
 					resource.TestCheckResourceAttrSet(resourceName, "content"),
+					resource.TestCheckResourceAttr(resourceName, "state", connect.ContactFlowStateActive),
 					resource.TestCheckResourceAttr(resourceName, "type", connect.ContactFlowTypeContactFlow),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
 				),
@@ -118,6 +119,43 @@ func testAccContactFlow_filename(t *testing.T) {
 	})
 }
 
+func testAccContactFlow_state(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.DescribeContactFlowOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_contact_flow.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContactFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactFlowConfig_state(rName, rName2, connect.ContactFlowStateArchived),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "force_delete", "false"),
+					resource.TestCheckResourceAttr(resourceName, "state", connect.ContactFlowStateArchived),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContactFlowConfig_state(rName, rName2, connect.ContactFlowStateActive),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckContactFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "state", connect.ContactFlowStateActive),
+				),
+			},
+		},
+	})
+}
+
 func testAccContactFlow_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v connect.DescribeContactFlowOutput
@@ -193,14 +231,9 @@ func testAccCheckContactFlowDestroy(ctx context.Context) resource.TestCheckFunc
 				return err
 			}
 
-			params := &connect.DescribeContactFlowInput{
-				ContactFlowId: aws.String(contactFlowID),
-				InstanceId:    aws.String(instanceID),
-			}
-
-			_, err = conn.DescribeContactFlowWithContext(ctx, params)
+			_, err = tfconnect.FindContactFlowByID(ctx, conn, instanceID, contactFlowID)
 
-			if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+			if tfresource.NotFound(err) {
 				continue
 			}
 
@@ -285,3 +318,31 @@ resource "aws_connect_contact_flow" "test" {
 }
 `, rName2, label, filepath))
 }
+
+func testAccContactFlowConfig_state(rName, rName2, state string) string {
+	return acctest.ConfigCompose(
+		testAccContactFlowConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_contact_flow" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  description = "Test Contact Flow State"
+  type        = "CONTACT_FLOW"
+  state       = %[2]q
+  content     = <<JSON
+    {
+		"Version": "2019-10-30",
+		"StartAction": "12345678-1234-1234-1234-123456789012",
+		"Actions": [
+			{
+				"Identifier": "12345678-1234-1234-1234-123456789012",
+				"Type": "DisconnectParticipant",
+				"Transitions": {},
+				"Parameters": {}
+			}
+		]
+    }
+    JSON
+}
+`, rName2, state))
+}
@@ -14,12 +14,21 @@ const (
 	ListContactFlowModulesMaxResults = 60
 	// MaxResults Valid Range: Minimum value of 1. Maximum value of 25
 	ListBotsMaxResults = 25
+	// ListEvaluationFormsMaxResults Valid Range: Minimum value of 1. Maximum value of 100.
+	// https://docs.aws.amazon.com/connect/latest/APIReference/API_ListEvaluationForms.html
+	ListEvaluationFormsMaxResults = 60
 	// MaxResults Valid Range: Minimum value of 1. Maximum value of 1000
 	// https://docs.aws.amazon.com/connect/latest/APIReference/API_ListHoursOfOperations.html
 	ListHoursOfOperationsMaxResults = 60
+	// ListIntegrationAssociationsMaxResults Valid Range: Minimum value of 1. Maximum value of 100.
+	// https://docs.aws.amazon.com/connect/latest/APIReference/API_ListIntegrationAssociations.html
+	ListIntegrationAssociationsMaxResults = 60
 	// ListLambdaFunctionsMaxResults Valid Range: Minimum value of 1. Maximum value of 25.
 	//https://docs.aws.amazon.com/connect/latest/APIReference/API_ListLambdaFunctions.html
 	ListLambdaFunctionsMaxResults = 25
+	// ListPhoneNumbersV2MaxResults Valid Range: Minimum value of 1. Maximum value of 1000.
+	// https://docs.aws.amazon.com/connect/latest/APIReference/API_ListPhoneNumbersV2.html
+	ListPhoneNumbersV2MaxResults = 60
 	// MaxResults Valid Range: Minimum value of 1. Maximum value of 1000
 	// https://docs.aws.amazon.com/connect/latest/APIReference/API_ListPrompts.html
 	ListPromptsMaxResults = 60
@@ -38,18 +47,36 @@ const (
 	// ListRoutingProfilesMaxResults Valid Range: Minimum value of 1. Maximum value of 1000.
 	// https://docs.aws.amazon.com/connect/latest/APIReference/API_ListRoutingProfiles.html
 	ListRoutingProfilesMaxResults = 60
+	// ListRulesMaxResults Valid Range: Minimum value of 1. Maximum value of 200.
+	// https://docs.aws.amazon.com/connect/latest/APIReference/API_ListRules.html
+	ListRulesMaxResults = 60
+	// ListSecurityProfileApplicationsMaxResults Valid Range: Minimum value of 1. Maximum value of 1000.
+	// https://docs.aws.amazon.com/connect/latest/APIReference/API_ListSecurityProfileApplications.html
+	ListSecurityProfileApplicationsMaxResults = 60
 	// ListSecurityProfilePermissionsMaxResults Valid Range: Minimum value of 1. Maximum value of 1000.
 	// https://docs.aws.amazon.com/connect/latest/APIReference/API_ListSecurityProfilePermissions.html
 	ListSecurityProfilePermissionsMaxResults = 60
 	// ListSecurityProfilesMaxResults Valid Range: Minimum value of 1. Maximum value of 1000.
 	// https://docs.aws.amazon.com/connect/latest/APIReference/API_ListSecurityProfiles.html
 	ListSecurityProfilesMaxResults = 60
+	// ListTaskTemplatesMaxResults Valid Range: Minimum value of 1. Maximum value of 100.
+	// https://docs.aws.amazon.com/connect/latest/APIReference/API_ListTaskTemplates.html
+	ListTaskTemplatesMaxResults = 60
+	// ListTrafficDistributionGroupsMaxResults Valid Range: Minimum value of 1. Maximum value of 10.
+	// https://docs.aws.amazon.com/connect/latest/APIReference/API_ListTrafficDistributionGroups.html
+	ListTrafficDistributionGroupsMaxResults = 10
 	// ListUsersMaxResults Valid Range: Minimum value of 1. Maximum value of 1000.
 	// https://docs.aws.amazon.com/connect/latest/APIReference/API_ListUsers.html
 	ListUsersMaxResults = 60
 	// ListUserHierarchyGroupsMaxResults Valid Range: Minimum value of 1. Maximum value of 1000.
 	// https://docs.aws.amazon.com/connect/latest/APIReference/API_ListUserHierarchyGroups.html
 	ListUserHierarchyGroupsMaxResults = 60
+	// ListViewsMaxResults Valid Range: Minimum value of 1. Maximum value of 100.
+	// https://docs.aws.amazon.com/connect/latest/APIReference/API_ListViews.html
+	ListViewsMaxResults = 60
+	// SearchPredefinedAttributesMaxResults Valid Range: Minimum value of 1. Maximum value of 100.
+	// https://docs.aws.amazon.com/connect/latest/APIReference/API_SearchPredefinedAttributes.html
+	SearchPredefinedAttributesMaxResults = 60
 	// SearchVocabulariesMaxResults Valid Range: Minimum value of 1. Maximum value of 100.
 	// https://docs.aws.amazon.com/connect/latest/APIReference/API_SearchVocabularies.html#connect-SearchVocabularies-request-MaxResults
 	SearchVocabulariesMaxResults = 60
@@ -61,10 +88,17 @@ func InstanceAttributeMapping() map[string]string {
 		connect.InstanceAttributeTypeContactflowLogs:       "contact_flow_logs_enabled",
 		connect.InstanceAttributeTypeContactLens:           "contact_lens_enabled",
 		connect.InstanceAttributeTypeEarlyMedia:            "early_media_enabled",
+		connect.InstanceAttributeTypeHighVolumeOutbound:    "high_volume_outbound_enabled",
 		connect.InstanceAttributeTypeInboundCalls:          "inbound_calls_enabled",
 		connect.InstanceAttributeTypeMultiPartyConference:  "multi_party_conference_enabled",
 		connect.InstanceAttributeTypeOutboundCalls:         "outbound_calls_enabled",
-		// Pre-release feature requiring allow-list from AWS. Removing all functionality until feature is GA
-		//connect.InstanceAttributeTypeUseCustomTtsVoices:    "use_custom_tts_voices_enabled",
+	}
+}
+
+// PreReleaseInstanceAttributeMapping returns the pre-release instance attributes. Reading or updating
+// them returns an AccessDeniedException unless the account is allow-listed by AWS.
+func PreReleaseInstanceAttributeMapping() map[string]string {
+	return map[string]string{
+		connect.InstanceAttributeTypeUseCustomTtsVoices: "use_custom_tts_voices_enabled",
 	}
 }
@@ -0,0 +1,23 @@
+package connect_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func init() {
+	acctest.RegisterServiceErrorCheckFunc(connect.EndpointsID, testAccErrorCheckSkip)
+}
+
+// skips tests that have error messages indicating the service or feature is unavailable in the region
+func testAccErrorCheckSkip(t *testing.T) resource.ErrorCheckFunc {
+	return acctest.ErrorCheckSkipMessagesContaining(t,
+		"is not available in this region",
+		"is not available in this Region",
+		// Amazon Connect has no endpoint in the region.
+		"lookup connect.",
+	)
+}
@@ -1,7 +1,83 @@
 package connect
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
 // Error code constants missing from AWS Go SDK:
 // https://docs.aws.amazon.com/sdk-for-go/api/service/connect/#pkg-constants
 const (
 	ErrCodeAccessDeniedException = "AccessDeniedException"
 )
+
+// diagFromErr is diag.FromErr, with the AWS error code and request ID of any
+// wrapped Amazon Connect API error in the diagnostic's detail.
+func diagFromErr(err error) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		errs.NewErrorDiagnostic(err.Error(), awsErrorDetail(err)),
+	}
+}
+
+// diagErrorf is diag.Errorf, with the AWS error code and request ID of the
+// first error argument that carries them in the diagnostic's detail.
+func diagErrorf(format string, a ...any) diag.Diagnostics {
+	var detail string
+
+	for _, v := range a {
+		if err, ok := v.(error); ok {
+			if detail = awsErrorDetail(err); detail != "" {
+				break
+			}
+		}
+	}
+
+	return diag.Diagnostics{
+		errs.NewErrorDiagnostic(fmt.Sprintf(format, a...), detail),
+	}
+}
+
+// awsErrorDetail returns the AWS error code and request ID of an AWS SDK for Go v1 or v2 API error
+// anywhere in err's chain, formatted for a diagnostic's detail, or "" if there are none.
+// The error strings returned by the two SDKs do not reliably include the request ID.
+func awsErrorDetail(err error) string {
+	var code, requestID string
+
+	if v, ok := errs.As[awserr.RequestFailure](err); ok {
+		code, requestID = v.Code(), v.RequestID()
+	} else if v, ok := errs.As[awserr.Error](err); ok {
+		code = v.Code()
+	}
+
+	if v, ok := errs.As[smithy.APIError](err); ok {
+		code = v.ErrorCode()
+	}
+
+	var respErr *awshttp.ResponseError
+	if errors.As(err, &respErr) {
+		requestID = respErr.ServiceRequestID()
+	}
+
+	var lines []string
+
+	if code != "" {
+		lines = append(lines, fmt.Sprintf("AWS error code: %s", code))
+	}
+
+	if requestID != "" {
+		lines = append(lines, fmt.Sprintf("AWS request ID: %s", requestID))
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,86 @@
+package connect
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestAWSErrorDetail(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected string
+	}{
+		"non-AWS error": {
+			err: errors.New("test"),
+		},
+		"SDK v1 error": {
+			err:      awserr.New(connect.ErrCodeInvalidParameterException, "test", nil),
+			expected: "AWS error code: InvalidParameterException",
+		},
+		"SDK v1 request failure": {
+			err:      fmt.Errorf("creating Connect Queue (test): %w", awserr.NewRequestFailure(awserr.New(connect.ErrCodeThrottlingException, "test", nil), http.StatusTooManyRequests, "req-1")),
+			expected: "AWS error code: ThrottlingException\nAWS request ID: req-1",
+		},
+		"SDK v2 error": {
+			err: fmt.Errorf("updating Connect Phone Number (test): %w", &smithy.OperationError{
+				ServiceID:     "Connect",
+				OperationName: "UpdatePhoneNumberMetadata",
+				Err: &awshttp.ResponseError{
+					ResponseError: &smithyhttp.ResponseError{
+						Response: &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusBadRequest}},
+						Err:      &types.InvalidParameterException{Message: aws_sdkv2.String("test")},
+					},
+					RequestID: "req-2",
+				},
+			}),
+			expected: "AWS error code: InvalidParameterException\nAWS request ID: req-2",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := awsErrorDetail(testCase.err); got != testCase.expected {
+				t.Errorf("got %q, expected %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestDiagErrorf(t *testing.T) {
+	t.Parallel()
+
+	err := awserr.NewRequestFailure(awserr.New(connect.ErrCodeThrottlingException, "Rate exceeded", nil), http.StatusTooManyRequests, "req-1")
+	diags := diagErrorf("reading Connect Queue (%s): %s", "test", err)
+
+	if got, expected := len(diags), 1; got != expected {
+		t.Fatalf("got %d diagnostics, expected %d", got, expected)
+	}
+
+	if got, expected := diags[0].Summary, fmt.Sprintf("reading Connect Queue (test): %s", err); got != expected {
+		t.Errorf("got summary %q, expected %q", got, expected)
+	}
+
+	if got, expected := diags[0].Detail, "AWS error code: ThrottlingException\nAWS request ID: req-1"; got != expected {
+		t.Errorf("got detail %q, expected %q", got, expected)
+	}
+
+	if diagFromErr(nil) != nil {
+		t.Error("expected no diagnostics for a nil error")
+	}
+}
@@ -0,0 +1,460 @@
+package connect
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_connect_evaluation_form", name="Evaluation Form")
+// @Tags(identifierAttribute="arn")
+func ResourceEvaluationForm() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEvaluationFormCreate,
+		ReadWithoutTimeout:   resourceEvaluationFormRead,
+		UpdateWithoutTimeout: resourceEvaluationFormUpdate,
+		DeleteWithoutTimeout: resourceEvaluationFormDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customdiff.Sequence(
+			defaultInstanceIDCustomizeDiff,
+			resourceEvaluationFormCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+		Schema: map[string]*schema.Schema{
+			// Whether the latest version of the evaluation form is the active version.
+			"activate": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"active_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// Whether changes to the content of the evaluation form are saved as a new version
+			// instead of updating the latest version, which is not possible once that version is locked.
+			"create_new_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"evaluation_form_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			// Sections can be nested in sections, so the items are specified in the JSON format of the API.
+			"items": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"latest_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"locked": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"scoring_strategy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(connect.EvaluationFormScoringMode_Values(), false),
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(connect.EvaluationFormScoringStatus_Values(), false),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"title": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+		},
+	}
+}
+
+func resourceEvaluationFormCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID := d.Get("instance_id").(string)
+	title := d.Get("title").(string)
+
+	items, err := expandEvaluationFormItems(d.Get("items").(string))
+
+	if err != nil {
+		return diagErrorf("creating Connect Evaluation Form (%s): %s", title, err)
+	}
+
+	input := &connect.CreateEvaluationFormInput{
+		InstanceId:      aws.String(instanceID),
+		Items:           items,
+		ScoringStrategy: expandEvaluationFormScoringStrategy(d.Get("scoring_strategy").([]interface{})),
+		Title:           aws.String(title),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateEvaluationFormWithContext(ctx, input)
+
+	if err != nil {
+		return diagErrorf("creating Connect Evaluation Form (%s): %s", title, err)
+	}
+
+	evaluationFormID := aws.StringValue(output.EvaluationFormId)
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, evaluationFormID))
+
+	// CreateEvaluationForm does not accept tags.
+	if tags := GetTagsIn(ctx); len(tags) > 0 {
+		if err := UpdateTags(ctx, conn, aws.StringValue(output.EvaluationFormArn), nil, tags); err != nil {
+			return diagErrorf("setting Connect Evaluation Form (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	// Evaluation forms are always created as a draft of version 1.
+	if d.Get("activate").(bool) {
+		if err := activateEvaluationForm(ctx, conn, instanceID, evaluationFormID, 1); err != nil {
+			return diagErrorf("activating Connect Evaluation Form (%s) version 1: %s", d.Id(), err)
+		}
+	}
+
+	return resourceEvaluationFormRead(ctx, d, meta)
+}
+
+func resourceEvaluationFormRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID, evaluationFormID, err := EvaluationFormParseID(d.Id())
+
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	// The summary is needed for the latest and active versions.
+	evaluationFormSummary, err := FindEvaluationFormSummaryByID(ctx, conn, instanceID, evaluationFormID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Evaluation Form (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diagErrorf("reading Connect Evaluation Form (%s): %s", d.Id(), err)
+	}
+
+	latestVersion := aws.Int64Value(evaluationFormSummary.LatestVersion)
+	activeVersion := aws.Int64Value(evaluationFormSummary.ActiveVersion)
+
+	evaluationForm, err := FindEvaluationFormByIDAndVersion(ctx, conn, instanceID, evaluationFormID, latestVersion)
+
+	if err != nil {
+		return diagErrorf("reading Connect Evaluation Form (%s) version %d: %s", d.Id(), latestVersion, err)
+	}
+
+	items, err := jsonutil.BuildJSON(evaluationForm.Items)
+
+	if err != nil {
+		return diagErrorf("encoding Connect Evaluation Form (%s) items: %s", d.Id(), err)
+	}
+
+	d.Set("activate", activeVersion != 0 && activeVersion == latestVersion)
+	d.Set("active_version", activeVersion)
+	d.Set("arn", evaluationForm.EvaluationFormArn)
+	d.Set("description", evaluationForm.Description)
+	d.Set("evaluation_form_id", evaluationForm.EvaluationFormId)
+	d.Set("instance_id", instanceID)
+	d.Set("items", string(items))
+	d.Set("latest_version", latestVersion)
+	d.Set("locked", evaluationForm.Locked)
+	if err := d.Set("scoring_strategy", flattenEvaluationFormScoringStrategy(evaluationForm.ScoringStrategy)); err != nil {
+		return diagErrorf("setting scoring_strategy: %s", err)
+	}
+	d.Set("status", evaluationForm.Status)
+	d.Set("title", evaluationForm.Title)
+
+	SetTagsOut(ctx, evaluationForm.Tags)
+
+	return nil
+}
+
+func resourceEvaluationFormUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID, evaluationFormID, err := EvaluationFormParseID(d.Id())
+
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	latestVersion := int64(d.Get("latest_version").(int))
+
+	if d.HasChanges("description", "items", "scoring_strategy", "title") {
+		items, err := expandEvaluationFormItems(d.Get("items").(string))
+
+		if err != nil {
+			return diagErrorf("updating Connect Evaluation Form (%s): %s", d.Id(), err)
+		}
+
+		input := &connect.UpdateEvaluationFormInput{
+			CreateNewVersion:      aws.Bool(d.Get("create_new_version").(bool)),
+			Description:           aws.String(d.Get("description").(string)),
+			EvaluationFormId:      aws.String(evaluationFormID),
+			EvaluationFormVersion: aws.Int64(latestVersion),
+			InstanceId:            aws.String(instanceID),
+			Items:                 items,
+			ScoringStrategy:       expandEvaluationFormScoringStrategy(d.Get("scoring_strategy").([]interface{})),
+			Title:                 aws.String(d.Get("title").(string)),
+		}
+
+		output, err := conn.UpdateEvaluationFormWithContext(ctx, input)
+
+		if err != nil {
+			return diagErrorf("updating Connect Evaluation Form (%s) version %d: %s", d.Id(), latestVersion, err)
+		}
+
+		latestVersion = aws.Int64Value(output.EvaluationFormVersion)
+	}
+
+	// Activating a version deactivates the previously active version.
+	activeVersion := int64(d.Get("active_version").(int))
+
+	if activate := d.Get("activate").(bool); activate && activeVersion != latestVersion {
+		if err := activateEvaluationForm(ctx, conn, instanceID, evaluationFormID, latestVersion); err != nil {
+			return diagErrorf("activating Connect Evaluation Form (%s) version %d: %s", d.Id(), latestVersion, err)
+		}
+	} else if !activate && activeVersion != 0 && activeVersion == latestVersion {
+		if err := deactivateEvaluationForm(ctx, conn, instanceID, evaluationFormID, activeVersion); err != nil {
+			return diagErrorf("deactivating Connect Evaluation Form (%s) version %d: %s", d.Id(), activeVersion, err)
+		}
+	}
+
+	return resourceEvaluationFormRead(ctx, d, meta)
+}
+
+func resourceEvaluationFormDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID, evaluationFormID, err := EvaluationFormParseID(d.Id())
+
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	// An active version cannot be deleted.
+	if v := int64(d.Get("active_version").(int)); v != 0 {
+		err := deactivateEvaluationForm(ctx, conn, instanceID, evaluationFormID, v)
+
+		if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return diagErrorf("deactivating Connect Evaluation Form (%s) version %d: %s", d.Id(), v, err)
+		}
+	}
+
+	// Without a version, all versions of the evaluation form are deleted.
+	log.Printf("[DEBUG] Deleting Connect Evaluation Form: %s", d.Id())
+	_, err = conn.DeleteEvaluationFormWithContext(ctx, &connect.DeleteEvaluationFormInput{
+		EvaluationFormId: aws.String(evaluationFormID),
+		InstanceId:       aws.String(instanceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diagErrorf("deleting Connect Evaluation Form (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceEvaluationFormCustomizeDiff marks the versions as unknown when they change on apply,
+// so that resources referencing them are updated in the same apply.
+func resourceEvaluationFormCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	newVersion := diff.Get("create_new_version").(bool) && diff.HasChanges("description", "items", "scoring_strategy", "title")
+
+	if newVersion {
+		if err := diff.SetNewComputed("latest_version"); err != nil {
+			return err
+		}
+	}
+
+	if diff.HasChange("activate") || (newVersion && diff.Get("activate").(bool)) {
+		if err := diff.SetNewComputed("active_version"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func activateEvaluationForm(ctx context.Context, conn *connect.Connect, instanceID, evaluationFormID string, version int64) error {
+	input := &connect.ActivateEvaluationFormInput{
+		EvaluationFormId:      aws.String(evaluationFormID),
+		EvaluationFormVersion: aws.Int64(version),
+		InstanceId:            aws.String(instanceID),
+	}
+
+	_, err := conn.ActivateEvaluationFormWithContext(ctx, input)
+
+	return err
+}
+
+func deactivateEvaluationForm(ctx context.Context, conn *connect.Connect, instanceID, evaluationFormID string, version int64) error {
+	input := &connect.DeactivateEvaluationFormInput{
+		EvaluationFormId:      aws.String(evaluationFormID),
+		EvaluationFormVersion: aws.Int64(version),
+		InstanceId:            aws.String(instanceID),
+	}
+
+	_, err := conn.DeactivateEvaluationFormWithContext(ctx, input)
+
+	return err
+}
+
+func FindEvaluationFormSummaryByID(ctx context.Context, conn *connect.Connect, instanceID, evaluationFormID string) (*connect.EvaluationFormSummary, error) {
+	var result *connect.EvaluationFormSummary
+
+	input := &connect.ListEvaluationFormsInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int64(ListEvaluationFormsMaxResults),
+	}
+
+	err := conn.ListEvaluationFormsPagesWithContext(ctx, input, func(page *connect.ListEvaluationFormsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.EvaluationFormSummaryList {
+			if v != nil && aws.StringValue(v.EvaluationFormId) == evaluationFormID {
+				result = v
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
+
+func EvaluationFormParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected instanceID:evaluationFormID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func expandEvaluationFormItems(rawItems string) ([]*connect.EvaluationFormItem, error) {
+	var items []*connect.EvaluationFormItem
+
+	if err := json.Unmarshal([]byte(rawItems), &items); err != nil {
+		return nil, fmt.Errorf("decoding items JSON: %w", err)
+	}
+
+	for i, v := range items {
+		if v == nil {
+			return nil, fmt.Errorf("invalid item supplied at index (%d)", i)
+		}
+	}
+
+	return items, nil
+}
+
+func expandEvaluationFormScoringStrategy(tfList []interface{}) *connect.EvaluationFormScoringStrategy {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &connect.EvaluationFormScoringStrategy{
+		Mode:   aws.String(tfMap["mode"].(string)),
+		Status: aws.String(tfMap["status"].(string)),
+	}
+}
@@ -0,0 +1,241 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_connect_evaluation_form")
+func DataSourceEvaluationForm() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEvaluationFormRead,
+		Schema: map[string]*schema.Schema{
+			"active_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"evaluation_form_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"evaluation_form_id", "title"},
+			},
+			"evaluation_form_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			// Sections can be nested in sections, so the items are exported in the JSON format of the API.
+			"items": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"latest_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"locked": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"scoring_strategy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"title": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"title", "evaluation_form_id"},
+			},
+		},
+	}
+}
+
+func dataSourceEvaluationFormRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setDefaultInstanceID(d, meta); err != nil {
+		return diagFromErr(err)
+	}
+
+	conn := meta.(*conns.AWSClient).ConnectConn()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	instanceID := d.Get("instance_id").(string)
+
+	// The summary is needed for the active version even if the evaluation form is specified by ID.
+	evaluationFormSummary, err := dataSourceGetEvaluationFormSummary(ctx, conn, instanceID, d.Get("evaluation_form_id").(string), d.Get("title").(string))
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error finding Connect Evaluation Form Summary: %w", err))
+	}
+
+	if evaluationFormSummary == nil {
+		return diagFromErr(fmt.Errorf("error finding Connect Evaluation Form Summary: not found"))
+	}
+
+	evaluationFormID := aws.StringValue(evaluationFormSummary.EvaluationFormId)
+	version := aws.Int64Value(evaluationFormSummary.ActiveVersion)
+
+	if v, ok := d.GetOk("evaluation_form_version"); ok {
+		version = int64(v.(int))
+	} else if version == 0 {
+		version = aws.Int64Value(evaluationFormSummary.LatestVersion)
+	}
+
+	evaluationForm, err := FindEvaluationFormByIDAndVersion(ctx, conn, instanceID, evaluationFormID, version)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error getting Connect Evaluation Form (%s) version %d: %w", evaluationFormID, version, err))
+	}
+
+	items, err := jsonutil.BuildJSON(evaluationForm.Items)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error encoding Connect Evaluation Form (%s) items: %w", evaluationFormID, err))
+	}
+
+	d.Set("active_version", evaluationFormSummary.ActiveVersion)
+	d.Set("arn", evaluationForm.EvaluationFormArn)
+	d.Set("description", evaluationForm.Description)
+	d.Set("evaluation_form_id", evaluationForm.EvaluationFormId)
+	d.Set("evaluation_form_version", evaluationForm.EvaluationFormVersion)
+	d.Set("instance_id", instanceID)
+	d.Set("items", string(items))
+	d.Set("latest_version", evaluationFormSummary.LatestVersion)
+	d.Set("locked", evaluationForm.Locked)
+	if err := d.Set("scoring_strategy", flattenEvaluationFormScoringStrategy(evaluationForm.ScoringStrategy)); err != nil {
+		return diagFromErr(fmt.Errorf("error setting scoring_strategy: %w", err))
+	}
+	d.Set("status", evaluationForm.Status)
+	d.Set("title", evaluationForm.Title)
+
+	if err := d.Set("tags", KeyValueTags(ctx, evaluationForm.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diagFromErr(fmt.Errorf("error setting tags: %s", err))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, evaluationFormID))
+
+	return nil
+}
+
+func FindEvaluationFormByIDAndVersion(ctx context.Context, conn *connect.Connect, instanceID, evaluationFormID string, version int64) (*connect.EvaluationForm, error) {
+	input := &connect.DescribeEvaluationFormInput{
+		EvaluationFormId: aws.String(evaluationFormID),
+		InstanceId:       aws.String(instanceID),
+	}
+
+	if version > 0 {
+		input.EvaluationFormVersion = aws.Int64(version)
+	}
+
+	output, err := conn.DescribeEvaluationFormWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EvaluationForm == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EvaluationForm, nil
+}
+
+// dataSourceGetEvaluationFormSummary returns the evaluation form with the specified ID or, if the ID is empty, title.
+func dataSourceGetEvaluationFormSummary(ctx context.Context, conn *connect.Connect, instanceID, evaluationFormID, title string) (*connect.EvaluationFormSummary, error) {
+	var result *connect.EvaluationFormSummary
+
+	input := &connect.ListEvaluationFormsInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int64(ListEvaluationFormsMaxResults),
+	}
+
+	err := conn.ListEvaluationFormsPagesWithContext(ctx, input, func(page *connect.ListEvaluationFormsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.EvaluationFormSummaryList {
+			if v == nil {
+				continue
+			}
+
+			if (evaluationFormID != "" && aws.StringValue(v.EvaluationFormId) == evaluationFormID) || (evaluationFormID == "" && aws.StringValue(v.Title) == title) {
+				result = v
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func flattenEvaluationFormScoringStrategy(apiObject *connect.EvaluationFormScoringStrategy) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"mode":   aws.StringValue(apiObject.Mode),
+		"status": aws.StringValue(apiObject.Status),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,89 @@
+package connect_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccEvaluationFormDataSource_evaluationFormID(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_connect_evaluation_form.test"
+	datasourceName := "data.aws_connect_evaluation_form.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvaluationFormDataSourceConfig_id(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "active_version", resourceName, "active_version"),
+					resource.TestCheckResourceAttrPair(datasourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(datasourceName, "evaluation_form_id", resourceName, "evaluation_form_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "evaluation_form_version", resourceName, "latest_version"),
+					resource.TestCheckResourceAttrPair(datasourceName, "instance_id", resourceName, "instance_id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "items"),
+					resource.TestCheckResourceAttrPair(datasourceName, "latest_version", resourceName, "latest_version"),
+					resource.TestCheckResourceAttrPair(datasourceName, "status", resourceName, "status"),
+					resource.TestCheckResourceAttrPair(datasourceName, "title", resourceName, "title"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEvaluationFormDataSource_title(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	datasourceName := "data.aws_connect_evaluation_form.test"
+	datasourceName2 := "data.aws_connect_evaluation_form.by_title"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvaluationFormDataSourceConfig_title(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName2, "id", datasourceName, "id"),
+					resource.TestCheckResourceAttrPair(datasourceName2, "active_version", datasourceName, "active_version"),
+					resource.TestCheckResourceAttrPair(datasourceName2, "arn", datasourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName2, "evaluation_form_id", datasourceName, "evaluation_form_id"),
+					resource.TestCheckResourceAttrPair(datasourceName2, "evaluation_form_version", datasourceName, "evaluation_form_version"),
+					resource.TestCheckResourceAttrPair(datasourceName2, "items", datasourceName, "items"),
+					resource.TestCheckResourceAttrPair(datasourceName2, "title", datasourceName, "title"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEvaluationFormDataSourceConfig_id(rName string) string {
+	return acctest.ConfigCompose(
+		testAccEvaluationFormConfig_basic(rName, "Question 1", false, true),
+		`
+data "aws_connect_evaluation_form" "test" {
+  instance_id        = aws_connect_evaluation_form.test.instance_id
+  evaluation_form_id = aws_connect_evaluation_form.test.evaluation_form_id
+}
+`)
+}
+
+func testAccEvaluationFormDataSourceConfig_title(rName string) string {
+	return acctest.ConfigCompose(
+		testAccEvaluationFormDataSourceConfig_id(rName),
+		`
+data "aws_connect_evaluation_form" "by_title" {
+  instance_id = data.aws_connect_evaluation_form.test.instance_id
+  title       = data.aws_connect_evaluation_form.test.title
+}
+`)
+}
@@ -0,0 +1,246 @@
+package connect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccEvaluationForm_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.EvaluationFormSummary
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_connect_evaluation_form.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEvaluationFormDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvaluationFormConfig_basic(rName, "Question 1", false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvaluationFormExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "activate", "false"),
+					resource.TestCheckResourceAttr(resourceName, "active_version", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "evaluation_form_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "items"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "locked", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", connect.EvaluationFormVersionStatusDraft),
+					resource.TestCheckResourceAttr(resourceName, "title", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"create_new_version",
+				},
+			},
+			{
+				Config: testAccEvaluationFormConfig_basic(rName, "Question 2", false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvaluationFormExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", connect.EvaluationFormVersionStatusDraft),
+				),
+			},
+		},
+	})
+}
+
+func testAccEvaluationForm_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.EvaluationFormSummary
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_connect_evaluation_form.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEvaluationFormDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvaluationFormConfig_basic(rName, "Question 1", false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvaluationFormExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfconnect.ResourceEvaluationForm(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccEvaluationForm_versions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.EvaluationFormSummary
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_connect_evaluation_form.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEvaluationFormDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvaluationFormConfig_basic(rName, "Question 1", true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvaluationFormExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "activate", "true"),
+					resource.TestCheckResourceAttr(resourceName, "active_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "locked", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", connect.EvaluationFormVersionStatusActive),
+				),
+			},
+			{
+				// A new version is created and activated.
+				Config: testAccEvaluationFormConfig_basic(rName, "Question 2", true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvaluationFormExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "active_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "status", connect.EvaluationFormVersionStatusActive),
+				),
+			},
+			{
+				// A new draft version is created, the previous version stays active.
+				Config: testAccEvaluationFormConfig_basic(rName, "Question 3", true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvaluationFormExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "activate", "false"),
+					resource.TestCheckResourceAttr(resourceName, "active_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "3"),
+					resource.TestCheckResourceAttr(resourceName, "status", connect.EvaluationFormVersionStatusDraft),
+				),
+			},
+			{
+				Config: testAccEvaluationFormConfig_basic(rName, "Question 3", true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvaluationFormExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "active_version", "3"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEvaluationFormExists(ctx context.Context, resourceName string, v *connect.EvaluationFormSummary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Connect Evaluation Form not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Connect Evaluation Form ID not set")
+		}
+
+		instanceID, evaluationFormID, err := tfconnect.EvaluationFormParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn()
+
+		output, err := tfconnect.FindEvaluationFormSummaryByID(ctx, conn, instanceID, evaluationFormID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckEvaluationFormDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_connect_evaluation_form" {
+				continue
+			}
+
+			instanceID, evaluationFormID, err := tfconnect.EvaluationFormParseID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn()
+
+			_, err = tfconnect.FindEvaluationFormSummaryByID(ctx, conn, instanceID, evaluationFormID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Evaluation Form %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccEvaluationFormConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+`, rName)
+}
+
+func testAccEvaluationFormConfig_basic(rName, questionTitle string, createNewVersion, activate bool) string {
+	return acctest.ConfigCompose(
+		testAccEvaluationFormConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_evaluation_form" "test" {
+  instance_id        = aws_connect_instance.test.id
+  title              = %[1]q
+  description        = "test"
+  create_new_version = %[3]t
+  activate           = %[4]t
+
+  items = jsonencode([{
+    Section = {
+      RefId = "s1"
+      Title = "Section 1"
+      Items = [{
+        Question = {
+          RefId        = "q1"
+          Title        = %[2]q
+          QuestionType = "TEXT"
+        }
+      }]
+    }
+  }])
+}
+`, rName, questionTitle, createNewVersion, activate))
+}
@@ -0,0 +1,134 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// @SDKDataSource("aws_connect_evaluation_forms")
+func DataSourceEvaluationForms() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEvaluationFormsRead,
+		Schema: map[string]*schema.Schema{
+			"evaluation_forms": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"active_version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_activated_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"latest_version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+		},
+	}
+}
+
+func dataSourceEvaluationFormsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setDefaultInstanceID(d, meta); err != nil {
+		return diagFromErr(err)
+	}
+
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID := d.Get("instance_id").(string)
+
+	input := &connect.ListEvaluationFormsInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int64(ListEvaluationFormsMaxResults),
+	}
+
+	var evaluationForms []*connect.EvaluationFormSummary
+
+	err := conn.ListEvaluationFormsPagesWithContext(ctx, input, func(page *connect.ListEvaluationFormsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		evaluationForms = append(evaluationForms, page.EvaluationFormSummaryList...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error listing Connect Evaluation Forms for Connect Instance (%s): %w", instanceID, err))
+	}
+
+	if err := d.Set("evaluation_forms", flattenEvaluationFormSummaries(evaluationForms)); err != nil {
+		return diagFromErr(fmt.Errorf("error setting evaluation_forms: %w", err))
+	}
+
+	d.SetId(instanceID)
+
+	return nil
+}
+
+func flattenEvaluationFormSummaries(apiObjects []*connect.EvaluationFormSummary) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"active_version": aws.Int64Value(apiObject.ActiveVersion),
+			"arn":            aws.StringValue(apiObject.EvaluationFormArn),
+			"id":             aws.StringValue(apiObject.EvaluationFormId),
+			"latest_version": aws.Int64Value(apiObject.LatestVersion),
+			"title":          aws.StringValue(apiObject.Title),
+		}
+
+		if v := apiObject.LastActivatedTime; v != nil {
+			tfMap["last_activated_time"] = v.Format(time.RFC3339)
+		}
+
+		if v := apiObject.LastModifiedTime; v != nil {
+			tfMap["last_modified_time"] = v.Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,51 @@
+package connect_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccEvaluationFormsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_connect_evaluation_form.test"
+	datasourceName := "data.aws_connect_evaluation_forms.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvaluationFormsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttr(datasourceName, "evaluation_forms.#", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "evaluation_forms.0.active_version", resourceName, "active_version"),
+					resource.TestCheckResourceAttrPair(datasourceName, "evaluation_forms.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "evaluation_forms.0.id", resourceName, "evaluation_form_id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "evaluation_forms.0.last_activated_time"),
+					resource.TestCheckResourceAttrSet(datasourceName, "evaluation_forms.0.last_modified_time"),
+					resource.TestCheckResourceAttrPair(datasourceName, "evaluation_forms.0.latest_version", resourceName, "latest_version"),
+					resource.TestCheckResourceAttrPair(datasourceName, "evaluation_forms.0.title", resourceName, "title"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEvaluationFormsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccEvaluationFormConfig_basic(rName, "Question 1", false, true),
+		`
+data "aws_connect_evaluation_forms" "test" {
+  instance_id = aws_connect_instance.test.id
+
+  depends_on = [aws_connect_evaluation_form.test]
+}
+`)
+}
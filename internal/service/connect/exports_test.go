@@ -0,0 +1,22 @@
+package connect
+
+// Exports for use in tests only.
+var (
+	ExpandConfigs                          = expandConfigs
+	ExpandIdentityInfo                     = expandIdentityInfo
+	ExpandOutboundCallerConfig             = expandOutboundCallerConfig
+	ExpandPhoneConfig                      = expandPhoneConfig
+	ExpandQuickConnectConfig               = expandQuickConnectConfig
+	ExpandRoutingProfileMediaConcurrencies = expandRoutingProfileMediaConcurrencies
+	ExpandStorageConfig                    = expandStorageConfig
+
+	FlattenConfigs                          = flattenConfigs
+	FlattenIdentityInfo                     = flattenIdentityInfo
+	FlattenOutboundCallerConfig             = flattenOutboundCallerConfig
+	FlattenPhoneConfig                      = flattenPhoneConfig
+	FlattenQuickConnectConfig               = flattenQuickConnectConfig
+	FlattenRoutingProfileMediaConcurrencies = flattenRoutingProfileMediaConcurrencies
+	FlattenStorageConfig                    = flattenStorageConfig
+
+	OmitDefaultCrossChannelBehaviors = omitDefaultCrossChannelBehaviors
+)
@@ -2,11 +2,14 @@ package connect
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -105,3 +108,702 @@ func FindLambdaFunctionAssociationByARNWithContext(ctx context.Context, conn *co
 
 	return result, nil
 }
+
+func FindIntegrationAssociationByID(ctx context.Context, conn *connect.Connect, instanceID, associationID string) (*connect.IntegrationAssociationSummary, error) {
+	var result *connect.IntegrationAssociationSummary
+
+	input := &connect.ListIntegrationAssociationsInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int64(ListIntegrationAssociationsMaxResults),
+	}
+
+	err := conn.ListIntegrationAssociationsPagesWithContext(ctx, input, func(page *connect.ListIntegrationAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.IntegrationAssociationSummaryList {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.IntegrationAssociationId) == associationID {
+				result = v
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
+
+func FindInstanceByID(ctx context.Context, conn *connect.Connect, id string) (*connect.Instance, error) {
+	input := &connect.DescribeInstanceInput{
+		InstanceId: aws.String(id),
+	}
+
+	output, err := conn.DescribeInstanceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Instance == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Instance, nil
+}
+
+// findInstanceByIDMemoized is FindInstanceByID with the result cached by conns.Memoize.
+// Use it only where a stale view of the instance is acceptable, e.g. when checking immutable attributes.
+func findInstanceByIDMemoized(ctx context.Context, client *conns.AWSClient, id string) (*connect.Instance, error) {
+	return conns.Memoize(client, instanceMemoKey(id), func() (*connect.Instance, error) {
+		return FindInstanceByID(ctx, client.ConnectConn(), id)
+	})
+}
+
+func instanceMemoKey(id string) string {
+	return "connect.DescribeInstance/" + id
+}
+
+func FindUserHierarchyStructureByInstanceID(ctx context.Context, conn *connect.Connect, instanceID string) (*connect.HierarchyStructure, error) {
+	input := &connect.DescribeUserHierarchyStructureInput{
+		InstanceId: aws.String(instanceID),
+	}
+
+	output, err := conn.DescribeUserHierarchyStructureWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.HierarchyStructure == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.HierarchyStructure, nil
+}
+
+// findUserHierarchyStructureByInstanceIDMemoized is FindUserHierarchyStructureByInstanceID with the result
+// cached by conns.Memoize. The cached value is discarded whenever aws_connect_user_hierarchy_structure
+// modifies the structure.
+func findUserHierarchyStructureByInstanceIDMemoized(ctx context.Context, client *conns.AWSClient, instanceID string) (*connect.HierarchyStructure, error) {
+	return conns.Memoize(client, userHierarchyStructureMemoKey(instanceID), func() (*connect.HierarchyStructure, error) {
+		return FindUserHierarchyStructureByInstanceID(ctx, client.ConnectConn(), instanceID)
+	})
+}
+
+func userHierarchyStructureMemoKey(instanceID string) string {
+	return "connect.DescribeUserHierarchyStructure/" + instanceID
+}
+
+func FindTaskTemplateByID(ctx context.Context, conn *connect.Connect, instanceID, taskTemplateID string) (*connect.GetTaskTemplateOutput, error) {
+	input := &connect.GetTaskTemplateInput{
+		InstanceId:     aws.String(instanceID),
+		TaskTemplateId: aws.String(taskTemplateID),
+	}
+
+	output, err := conn.GetTaskTemplateWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindVocabularyByID(ctx context.Context, conn *connect.Connect, instanceID, vocabularyID string) (*connect.Vocabulary, error) {
+	input := &connect.DescribeVocabularyInput{
+		InstanceId:   aws.String(instanceID),
+		VocabularyId: aws.String(vocabularyID),
+	}
+
+	output, err := conn.DescribeVocabularyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Vocabulary == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Vocabulary, nil
+}
+
+func FindContactFlowByID(ctx context.Context, conn *connect.Connect, instanceID, contactFlowID string) (*connect.ContactFlow, error) {
+	input := &connect.DescribeContactFlowInput{
+		ContactFlowId: aws.String(contactFlowID),
+		InstanceId:    aws.String(instanceID),
+	}
+
+	output, err := conn.DescribeContactFlowWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ContactFlow == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ContactFlow, nil
+}
+
+func FindContactFlowModuleByID(ctx context.Context, conn *connect.Connect, instanceID, contactFlowModuleID string) (*connect.ContactFlowModule, error) {
+	input := &connect.DescribeContactFlowModuleInput{
+		ContactFlowModuleId: aws.String(contactFlowModuleID),
+		InstanceId:          aws.String(instanceID),
+	}
+
+	output, err := conn.DescribeContactFlowModuleWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ContactFlowModule == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ContactFlowModule, nil
+}
+
+func FindHoursOfOperationByID(ctx context.Context, conn *connect.Connect, instanceID, hoursOfOperationID string) (*connect.HoursOfOperation, error) {
+	input := &connect.DescribeHoursOfOperationInput{
+		HoursOfOperationId: aws.String(hoursOfOperationID),
+		InstanceId:         aws.String(instanceID),
+	}
+
+	output, err := conn.DescribeHoursOfOperationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.HoursOfOperation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.HoursOfOperation, nil
+}
+
+func FindQueueByID(ctx context.Context, conn *connect.Connect, instanceID, queueID string) (*connect.Queue, error) {
+	input := &connect.DescribeQueueInput{
+		InstanceId: aws.String(instanceID),
+		QueueId:    aws.String(queueID),
+	}
+
+	output, err := conn.DescribeQueueWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Queue == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Queue, nil
+}
+
+func FindQuickConnectByID(ctx context.Context, conn *connect.Connect, instanceID, quickConnectID string) (*connect.QuickConnect, error) {
+	input := &connect.DescribeQuickConnectInput{
+		InstanceId:     aws.String(instanceID),
+		QuickConnectId: aws.String(quickConnectID),
+	}
+
+	output, err := conn.DescribeQuickConnectWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.QuickConnect == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.QuickConnect, nil
+}
+
+func FindRoutingProfileByID(ctx context.Context, conn *connect.Connect, instanceID, routingProfileID string) (*connect.RoutingProfile, error) {
+	input := &connect.DescribeRoutingProfileInput{
+		InstanceId:       aws.String(instanceID),
+		RoutingProfileId: aws.String(routingProfileID),
+	}
+
+	output, err := conn.DescribeRoutingProfileWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RoutingProfile == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RoutingProfile, nil
+}
+
+func FindSecurityProfileByID(ctx context.Context, conn *connect.Connect, instanceID, securityProfileID string) (*connect.SecurityProfile, error) {
+	input := &connect.DescribeSecurityProfileInput{
+		InstanceId:        aws.String(instanceID),
+		SecurityProfileId: aws.String(securityProfileID),
+	}
+
+	output, err := conn.DescribeSecurityProfileWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SecurityProfile == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SecurityProfile, nil
+}
+
+func FindUserByID(ctx context.Context, conn *connect.Connect, instanceID, userID string) (*connect.User, error) {
+	input := &connect.DescribeUserInput{
+		InstanceId: aws.String(instanceID),
+		UserId:     aws.String(userID),
+	}
+
+	output, err := conn.DescribeUserWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.User == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.User, nil
+}
+
+func FindInstanceStorageConfigByID(ctx context.Context, conn *connect.Connect, instanceID, associationID, resourceType string) (*connect.InstanceStorageConfig, error) {
+	input := &connect.DescribeInstanceStorageConfigInput{
+		AssociationId: aws.String(associationID),
+		InstanceId:    aws.String(instanceID),
+		ResourceType:  aws.String(resourceType),
+	}
+
+	output, err := conn.DescribeInstanceStorageConfigWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.StorageConfig == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.StorageConfig, nil
+}
+
+func FindPhoneNumberByID(ctx context.Context, conn *connect.Connect, phoneNumberID string) (*connect.ClaimedPhoneNumberSummary, error) {
+	input := &connect.DescribePhoneNumberInput{
+		PhoneNumberId: aws.String(phoneNumberID),
+	}
+
+	output, err := conn.DescribePhoneNumberWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ClaimedPhoneNumberSummary == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ClaimedPhoneNumberSummary, nil
+}
+
+func FindUserHierarchyGroupByID(ctx context.Context, conn *connect.Connect, instanceID, hierarchyGroupID string) (*connect.HierarchyGroup, error) {
+	input := &connect.DescribeUserHierarchyGroupInput{
+		HierarchyGroupId: aws.String(hierarchyGroupID),
+		InstanceId:       aws.String(instanceID),
+	}
+
+	output, err := conn.DescribeUserHierarchyGroupWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.HierarchyGroup == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.HierarchyGroup, nil
+}
+
+// findRoutingProfileIDByName returns the ID of the named routing profile using a cached index of the instance's routing profiles.
+func findRoutingProfileIDByName(ctx context.Context, client *conns.AWSClient, instanceID, name string) (string, error) {
+	return findByNameMemoized(client, "connect.ListRoutingProfiles/"+instanceID, name, func() (map[string]string, error) {
+		return findRoutingProfileIDsByName(ctx, client.ConnectConn(), instanceID)
+	})
+}
+
+func findRoutingProfileIDsByName(ctx context.Context, conn *connect.Connect, instanceID string) (map[string]string, error) {
+	input := &connect.ListRoutingProfilesInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int64(ListRoutingProfilesMaxResults),
+	}
+	ids := map[string]string{}
+
+	err := conn.ListRoutingProfilesPagesWithContext(ctx, input, func(page *connect.ListRoutingProfilesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.RoutingProfileSummaryList {
+			if v != nil {
+				ids[aws.StringValue(v.Name)] = aws.StringValue(v.Id)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// findSecurityProfileIDByName returns the ID of the named security profile using a cached index of the instance's security profiles.
+func findSecurityProfileIDByName(ctx context.Context, client *conns.AWSClient, instanceID, name string) (string, error) {
+	return findByNameMemoized(client, "connect.ListSecurityProfiles/"+instanceID, name, func() (map[string]string, error) {
+		return findSecurityProfileIDsByName(ctx, client.ConnectConn(), instanceID)
+	})
+}
+
+// findSecurityProfileIDsByNames returns the IDs of the named security profiles in the order of names.
+func findSecurityProfileIDsByNames(ctx context.Context, client *conns.AWSClient, instanceID string, names []string) ([]string, error) {
+	var ids []string
+
+	for _, name := range names {
+		id, err := findSecurityProfileIDByName(ctx, client, instanceID, name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func findSecurityProfileIDsByName(ctx context.Context, conn *connect.Connect, instanceID string) (map[string]string, error) {
+	input := &connect.ListSecurityProfilesInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int64(ListSecurityProfilesMaxResults),
+	}
+	ids := map[string]string{}
+
+	err := conn.ListSecurityProfilesPagesWithContext(ctx, input, func(page *connect.ListSecurityProfilesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SecurityProfileSummaryList {
+			if v != nil {
+				ids[aws.StringValue(v.Name)] = aws.StringValue(v.Id)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// findUserHierarchyGroupIDByPath returns the ID of the hierarchy group with the specified path,
+// the names of the group and its ancestors from level one down separated by "/", e.g. "Europe/Sales/Team 1".
+// The index of group names is cached by conns.Memoize. If no group has the path, the index is rebuilt once
+// in case a group was created or renamed after the index was cached.
+func findUserHierarchyGroupIDByPath(ctx context.Context, client *conns.AWSClient, instanceID, path string) (string, error) {
+	conn := client.ConnectConn()
+	levels := strings.Split(path, userHierarchyGroupPathSeparator)
+	key := userHierarchyGroupsMemoKey(instanceID)
+
+	for _, refresh := range []bool{false, true} {
+		if refresh {
+			conns.Forget(client, key)
+		}
+
+		index, err := conns.Memoize(client, key, func() (map[string][]string, error) {
+			return findUserHierarchyGroupIDsByName(ctx, conn, instanceID)
+		})
+
+		if err != nil {
+			return "", err
+		}
+
+		// Group names are only unique within a level, so check the path of every group with the same name.
+		for _, id := range index[levels[len(levels)-1]] {
+			group, err := FindUserHierarchyGroupByID(ctx, conn, instanceID, id)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return "", err
+			}
+
+			if userHierarchyGroupPath(group.HierarchyPath) == path {
+				return id, nil
+			}
+		}
+	}
+
+	return "", &retry.NotFoundError{
+		Message: fmt.Sprintf("no hierarchy group with path %q", path),
+	}
+}
+
+func userHierarchyGroupsMemoKey(instanceID string) string {
+	return "connect.ListUserHierarchyGroups/" + instanceID
+}
+
+func findUserHierarchyGroupIDsByName(ctx context.Context, conn *connect.Connect, instanceID string) (map[string][]string, error) {
+	input := &connect.ListUserHierarchyGroupsInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int64(ListUserHierarchyGroupsMaxResults),
+	}
+	ids := map[string][]string{}
+
+	err := conn.ListUserHierarchyGroupsPagesWithContext(ctx, input, func(page *connect.ListUserHierarchyGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.UserHierarchyGroupSummaryList {
+			if v != nil {
+				name := aws.StringValue(v.Name)
+				ids[name] = append(ids[name], aws.StringValue(v.Id))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func findUserHierarchyGroupsByInstanceID(ctx context.Context, conn *connect.Connect, instanceID string) ([]*connect.HierarchyGroup, error) {
+	ids, err := findUserHierarchyGroupIDsByName(ctx, conn, instanceID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []*connect.HierarchyGroup
+
+	// The group summaries do not include the level, so each group is described.
+	for _, v := range ids {
+		for _, id := range v {
+			group, err := FindUserHierarchyGroupByID(ctx, conn, instanceID, id)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			groups = append(groups, group)
+		}
+	}
+
+	return groups, nil
+}
+
+// findByNameMemoized looks up name in a cached name index built by find.
+// If name is not in the index, the index is rebuilt once in case the named resource was created after it was cached.
+func findByNameMemoized[T any](client *conns.AWSClient, key, name string, find func() (map[string]T, error)) (T, error) {
+	var zero T
+
+	for _, refresh := range []bool{false, true} {
+		if refresh {
+			conns.Forget(client, key)
+		}
+
+		index, err := conns.Memoize(client, key, find)
+
+		if err != nil {
+			return zero, err
+		}
+
+		if v, ok := index[name]; ok {
+			return v, nil
+		}
+	}
+
+	return zero, &retry.NotFoundError{
+		Message: fmt.Sprintf("%q not found", name),
+	}
+}
@@ -0,0 +1,305 @@
+package connect_test
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+)
+
+var updateGolden = flag.Bool("update-golden", false, "rewrite the expanded API object fixtures in test-fixtures/flex")
+
+// TestExpandFlattenRoundTrip expands each configuration block, compares the API object with a golden JSON
+// fixture and checks that flattening the API object returns the original block, so that a field dropped
+// by either direction fails the test.
+func TestExpandFlattenRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tfList    []interface{}
+		roundTrip func(*testing.T, []interface{}, string)
+	}{
+		"hours_of_operation_config": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"day": connect.HoursOfOperationDaysMonday,
+					"end_time": []interface{}{
+						map[string]interface{}{"hours": 17, "minutes": 30},
+					},
+					"start_time": []interface{}{
+						map[string]interface{}{"hours": 9, "minutes": 0},
+					},
+				},
+				map[string]interface{}{
+					"day": connect.HoursOfOperationDaysTuesday,
+					"end_time": []interface{}{
+						map[string]interface{}{"hours": 23, "minutes": 59},
+					},
+					"start_time": []interface{}{
+						map[string]interface{}{"hours": 0, "minutes": 0},
+					},
+				},
+			},
+			roundTrip: testRoundTrip(tfconnect.ExpandConfigs, tfconnect.FlattenConfigs),
+		},
+		"instance_storage_config_kinesis_video_stream": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"kinesis_video_stream_config": []interface{}{
+						map[string]interface{}{
+							"encryption_config": []interface{}{
+								map[string]interface{}{
+									"encryption_type": connect.EncryptionTypeKms,
+									"key_id":          "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", //lintignore:AWSAT003,AWSAT005
+								},
+							},
+							"prefix":                 "test-prefix",
+							"retention_period_hours": 24,
+						},
+					},
+					"storage_type": connect.StorageTypeKinesisVideoStream,
+				},
+			},
+			roundTrip: testRoundTrip(withoutError(tfconnect.ExpandStorageConfig), withoutError(tfconnect.FlattenStorageConfig)),
+		},
+		"instance_storage_config_s3": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"s3_config": []interface{}{
+						map[string]interface{}{
+							"bucket_name":   "test-bucket",
+							"bucket_prefix": "test-prefix",
+							"encryption_config": []interface{}{
+								map[string]interface{}{
+									"encryption_type": connect.EncryptionTypeKms,
+									"key_id":          "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", //lintignore:AWSAT003,AWSAT005
+								},
+							},
+						},
+					},
+					"storage_type": connect.StorageTypeS3,
+				},
+			},
+			roundTrip: testRoundTrip(withoutError(tfconnect.ExpandStorageConfig), withoutError(tfconnect.FlattenStorageConfig)),
+		},
+		"queue_outbound_caller_config": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"outbound_caller_id_name":      "test",
+					"outbound_caller_id_number_id": "12345678-1234-1234-1234-123456789012",
+					"outbound_flow_id":             "87654321-4321-4321-4321-210987654321",
+				},
+			},
+			roundTrip: testRoundTrip(tfconnect.ExpandOutboundCallerConfig, tfconnect.FlattenOutboundCallerConfig),
+		},
+		"quick_connect_config": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"queue_config": []interface{}{
+						map[string]interface{}{
+							"contact_flow_id": "12345678-1234-1234-1234-123456789012",
+							"queue_id":        "87654321-4321-4321-4321-210987654321",
+						},
+					},
+					"quick_connect_type": connect.QuickConnectTypeQueue,
+				},
+			},
+			roundTrip: testRoundTrip(tfconnect.ExpandQuickConnectConfig, tfconnect.FlattenQuickConnectConfig),
+		},
+		"routing_profile_media_concurrencies": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"channel":     connect.ChannelVoice,
+					"concurrency": 1,
+				},
+				map[string]interface{}{
+					"channel":     connect.ChannelChat,
+					"concurrency": 3,
+					"cross_channel_behavior": []interface{}{
+						map[string]interface{}{"behavior_type": connect.BehaviorTypeRouteAnyChannel},
+					},
+				},
+			},
+			roundTrip: testRoundTrip(withoutError(tfconnect.ExpandRoutingProfileMediaConcurrencies), withoutError(tfconnect.FlattenRoutingProfileMediaConcurrencies)),
+		},
+		"user_identity_info": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"email":      "test@example.com",
+					"first_name": "Test",
+					"last_name":  "User",
+				},
+			},
+			roundTrip: testRoundTrip(tfconnect.ExpandIdentityInfo, tfconnect.FlattenIdentityInfo),
+		},
+		"user_phone_config": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"after_contact_work_time_limit": 60,
+					"auto_accept":                   true,
+					"desk_phone_number":             "+112345678912",
+					"phone_type":                    connect.PhoneTypeDeskPhone,
+				},
+			},
+			roundTrip: testRoundTrip(tfconnect.ExpandPhoneConfig, tfconnect.FlattenPhoneConfig),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testCase.roundTrip(t, testCase.tfList, filepath.Join("test-fixtures", "flex", name+".json"))
+		})
+	}
+}
+
+func TestExpandQuickConnectConfig(t *testing.T) {
+	t.Parallel()
+
+	queueConfig := []interface{}{
+		map[string]interface{}{
+			"contact_flow_id": "12345678-1234-1234-1234-123456789012",
+			"queue_id":        "87654321-4321-4321-4321-210987654321",
+		},
+	}
+	userConfig := []interface{}{
+		map[string]interface{}{
+			"contact_flow_id": "12345678-1234-1234-1234-123456789012",
+			"user_id":         "87654321-4321-4321-4321-210987654321",
+		},
+	}
+
+	apiObject, err := tfconnect.ExpandQuickConnectConfig([]interface{}{
+		map[string]interface{}{
+			"phone_config":       []interface{}{},
+			"queue_config":       queueConfig,
+			"quick_connect_type": connect.QuickConnectTypeQueue,
+			"user_config":        userConfig,
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if apiObject.QueueConfig == nil {
+		t.Error("expected queue_config to be expanded")
+	}
+
+	if apiObject.PhoneConfig != nil || apiObject.UserConfig != nil {
+		t.Errorf("expected only queue_config to be expanded, got %s", apiObject)
+	}
+
+	_, err = tfconnect.ExpandQuickConnectConfig([]interface{}{
+		map[string]interface{}{
+			"phone_config":       []interface{}{},
+			"queue_config":       queueConfig,
+			"quick_connect_type": connect.QuickConnectTypeUser,
+			"user_config":        []interface{}{},
+		},
+	})
+
+	if err == nil {
+		t.Error("expected error for missing user_config, got none")
+	}
+}
+
+func TestOmitDefaultCrossChannelBehaviors(t *testing.T) {
+	t.Parallel()
+
+	crossChannelBehavior := func(behaviorType string) []interface{} {
+		return []interface{}{map[string]interface{}{"behavior_type": behaviorType}}
+	}
+
+	tfList := tfconnect.OmitDefaultCrossChannelBehaviors(
+		[]interface{}{
+			map[string]interface{}{"channel": connect.ChannelVoice, "cross_channel_behavior": crossChannelBehavior(connect.BehaviorTypeRouteCurrentChannelOnly)},
+			map[string]interface{}{"channel": connect.ChannelChat, "cross_channel_behavior": crossChannelBehavior(connect.BehaviorTypeRouteCurrentChannelOnly)},
+			map[string]interface{}{"channel": connect.ChannelTask, "cross_channel_behavior": crossChannelBehavior(connect.BehaviorTypeRouteAnyChannel)},
+		},
+		[]interface{}{
+			map[string]interface{}{"channel": connect.ChannelVoice, "cross_channel_behavior": []interface{}{}},
+			map[string]interface{}{"channel": connect.ChannelChat, "cross_channel_behavior": crossChannelBehavior(connect.BehaviorTypeRouteCurrentChannelOnly)},
+		},
+	)
+
+	for _, v := range tfList {
+		tfMap := v.(map[string]interface{})
+		_, ok := tfMap["cross_channel_behavior"]
+
+		if want := tfMap["channel"] != connect.ChannelVoice; ok != want {
+			t.Errorf("channel %s: cross_channel_behavior kept = %t, want %t", tfMap["channel"], ok, want)
+		}
+	}
+}
+
+// testRoundTrip returns a test of an expand and flatten function pair.
+func testRoundTrip[T any](expand func([]interface{}) (T, error), flatten func(T) ([]interface{}, error)) func(*testing.T, []interface{}, string) {
+	return func(t *testing.T, tfList []interface{}, golden string) {
+		apiObject, err := expand(tfList)
+
+		if err != nil {
+			t.Fatalf("expanding: %s", err)
+		}
+
+		got, err := json.MarshalIndent(apiObject, "", "  ")
+
+		if err != nil {
+			t.Fatalf("marshaling API object: %s", err)
+		}
+
+		got = append(got, '\n')
+
+		if *updateGolden {
+			if err := os.WriteFile(golden, got, 0644); err != nil { //nolint:gosec // Test fixture
+				t.Fatalf("writing %s: %s", golden, err)
+			}
+		}
+
+		expected, err := os.ReadFile(golden)
+
+		if err != nil {
+			t.Fatalf("reading %s: %s", golden, err)
+		}
+
+		if string(got) != string(expected) {
+			t.Errorf("expanded API object does not match %s\ngot:\n%s\nexpected:\n%s", golden, got, expected)
+		}
+
+		flattened, err := flatten(apiObject)
+
+		if err != nil {
+			t.Fatalf("flattening: %s", err)
+		}
+
+		// Compare JSON encodings so that, for example, int and int64 attribute values are equal.
+		if got, expected := mustMarshalJSON(t, flattened), mustMarshalJSON(t, tfList); got != expected {
+			t.Errorf("flattened block does not match the original\ngot:      %s\nexpected: %s", got, expected)
+		}
+	}
+}
+
+func withoutError[T, U any](f func(T) U) func(T) (U, error) {
+	return func(v T) (U, error) {
+		return f(v), nil
+	}
+}
+
+func mustMarshalJSON(t *testing.T, v any) string {
+	t.Helper()
+
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		t.Fatalf("marshaling: %s", err)
+	}
+
+	return string(b)
+}
@@ -1,4 +1,4 @@
-//go:generate go run ../../generate/tags/main.go -ServiceTagsMap -UpdateTags
+//go:generate go run ../../generate/tags/main.go -ServiceTagsMap -ListTags -UpdateTags -CreateTags
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package connect
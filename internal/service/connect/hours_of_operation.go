@@ -11,11 +11,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -33,7 +36,14 @@ func ResourceHoursOfOperation() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(hoursOfOperationDeletedTimeout),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			defaultInstanceIDCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -44,49 +54,8 @@ func ResourceHoursOfOperation() *schema.Resource {
 				Type:     schema.TypeSet,
 				Required: true,
 				MinItems: 0,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"day": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice(connect.HoursOfOperationDays_Values(), false),
-						},
-						"end_time": {
-							Type:     schema.TypeList,
-							MaxItems: 1,
-							Required: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"hours": {
-										Type:     schema.TypeInt,
-										Required: true,
-									},
-									"minutes": {
-										Type:     schema.TypeInt,
-										Required: true,
-									},
-								},
-							},
-						},
-						"start_time": {
-							Type:     schema.TypeList,
-							MaxItems: 1,
-							Required: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"hours": {
-										Type:     schema.TypeInt,
-										Required: true,
-									},
-									"minutes": {
-										Type:     schema.TypeInt,
-										Required: true,
-									},
-								},
-							},
-						},
-					},
-				},
+				MaxItems: 100,
+				Elem:     hoursOfOperationConfigSchema(),
 				Set: func(v interface{}) int {
 					var buf bytes.Buffer
 					m := v.(map[string]interface{})
@@ -107,7 +76,8 @@ func ResourceHoursOfOperation() *schema.Resource {
 			},
 			"instance_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -117,8 +87,9 @@ func ResourceHoursOfOperation() *schema.Resource {
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"time_zone": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidTimeZone,
 			},
 		},
 	}
@@ -129,7 +100,12 @@ func resourceHoursOfOperationCreate(ctx context.Context, d *schema.ResourceData,
 
 	instanceID := d.Get("instance_id").(string)
 	name := d.Get("name").(string)
-	config := expandConfigs(d.Get("config").(*schema.Set).List())
+	config, err := expandConfigs(d.Get("config").(*schema.Set).List())
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("expanding config: %w", err))
+	}
+
 	input := &connect.CreateHoursOfOperationInput{
 		Config:     config,
 		InstanceId: aws.String(instanceID),
@@ -146,11 +122,11 @@ func resourceHoursOfOperationCreate(ctx context.Context, d *schema.ResourceData,
 	output, err := conn.CreateHoursOfOperationWithContext(ctx, input)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating Connect Hours of Operation (%s): %w", name, err))
+		return diagFromErr(fmt.Errorf("error creating Connect Hours of Operation (%s): %w", name, err))
 	}
 
 	if output == nil {
-		return diag.FromErr(fmt.Errorf("error creating Connect Hours of Operation (%s): empty output", name))
+		return diagFromErr(fmt.Errorf("error creating Connect Hours of Operation (%s): empty output", name))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(output.HoursOfOperationId)))
@@ -164,7 +140,7 @@ func resourceHoursOfOperationRead(ctx context.Context, d *schema.ResourceData, m
 	instanceID, hoursOfOperationID, err := HoursOfOperationParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	resp, err := conn.DescribeHoursOfOperationWithContext(ctx, &connect.DescribeHoursOfOperationInput{
@@ -179,15 +155,21 @@ func resourceHoursOfOperationRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Hours of Operation (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error getting Connect Hours of Operation (%s): %w", d.Id(), err))
 	}
 
 	if resp == nil || resp.HoursOfOperation == nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Hours of Operation (%s): empty response", d.Id()))
+		return diagFromErr(fmt.Errorf("error getting Connect Hours of Operation (%s): empty response", d.Id()))
 	}
 
-	if err := d.Set("config", flattenConfigs(resp.HoursOfOperation.Config)); err != nil {
-		return diag.FromErr(err)
+	config, err := flattenConfigs(resp.HoursOfOperation.Config)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("flattening config: %w", err))
+	}
+
+	if err := d.Set("config", config); err != nil {
+		return diagFromErr(err)
 	}
 
 	d.Set("arn", resp.HoursOfOperation.HoursOfOperationArn)
@@ -208,12 +190,18 @@ func resourceHoursOfOperationUpdate(ctx context.Context, d *schema.ResourceData,
 	instanceID, hoursOfOperationID, err := HoursOfOperationParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if d.HasChanges("config", "description", "name", "time_zone") {
+		config, err := expandConfigs(d.Get("config").(*schema.Set).List())
+
+		if err != nil {
+			return diagFromErr(fmt.Errorf("expanding config: %w", err))
+		}
+
 		_, err = conn.UpdateHoursOfOperationWithContext(ctx, &connect.UpdateHoursOfOperationInput{
-			Config:             expandConfigs(d.Get("config").(*schema.Set).List()),
+			Config:             config,
 			Description:        aws.String(d.Get("description").(string)),
 			HoursOfOperationId: aws.String(hoursOfOperationID),
 			InstanceId:         aws.String(instanceID),
@@ -221,7 +209,7 @@ func resourceHoursOfOperationUpdate(ctx context.Context, d *schema.ResourceData,
 			TimeZone:           aws.String(d.Get("time_zone").(string)),
 		})
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("updating HoursOfOperation (%s): %w", d.Id(), err))
+			return diagFromErr(fmt.Errorf("updating HoursOfOperation (%s): %w", d.Id(), err))
 		}
 	}
 
@@ -234,75 +222,70 @@ func resourceHoursOfOperationDelete(ctx context.Context, d *schema.ResourceData,
 	instanceID, hoursOfOperationID, err := HoursOfOperationParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
-	_, err = conn.DeleteHoursOfOperationWithContext(ctx, &connect.DeleteHoursOfOperationInput{
-		HoursOfOperationId: aws.String(hoursOfOperationID),
-		InstanceId:         aws.String(instanceID),
+	_, err = tfresource.RetryWhenConflict(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteHoursOfOperationWithContext(ctx, &connect.DeleteHoursOfOperationInput{
+			HoursOfOperationId: aws.String(hoursOfOperationID),
+			InstanceId:         aws.String(instanceID),
+		})
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting HoursOfOperation (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error deleting HoursOfOperation (%s): %w", d.Id(), err))
 	}
 
 	return nil
 }
 
-func expandConfigs(configs []interface{}) []*connect.HoursOfOperationConfig {
-	if len(configs) == 0 {
-		return nil
+func hoursOfOperationConfigSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"day": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(connect.HoursOfOperationDays_Values(), false),
+			},
+			"end_time": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Required: true,
+				Elem:     hoursOfOperationTimeSliceSchema(),
+			},
+			"start_time": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Required: true,
+				Elem:     hoursOfOperationTimeSliceSchema(),
+			},
+		},
 	}
+}
 
-	hoursOfOperationConfigs := []*connect.HoursOfOperationConfig{}
-	for _, config := range configs {
-		data := config.(map[string]interface{})
-		hoursOfOperationConfig := &connect.HoursOfOperationConfig{
-			Day: aws.String(data["day"].(string)),
-		}
-
-		tet := data["end_time"].([]interface{})
-		vet := tet[0].(map[string]interface{})
-		et := connect.HoursOfOperationTimeSlice{
-			Hours:   aws.Int64(int64(vet["hours"].(int))),
-			Minutes: aws.Int64(int64(vet["minutes"].(int))),
-		}
-		hoursOfOperationConfig.EndTime = &et
-
-		tst := data["start_time"].([]interface{})
-		vst := tst[0].(map[string]interface{})
-		st := connect.HoursOfOperationTimeSlice{
-			Hours:   aws.Int64(int64(vst["hours"].(int))),
-			Minutes: aws.Int64(int64(vst["minutes"].(int))),
-		}
-		hoursOfOperationConfig.StartTime = &st
-
-		hoursOfOperationConfigs = append(hoursOfOperationConfigs, hoursOfOperationConfig)
+func hoursOfOperationTimeSliceSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"hours": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 23),
+			},
+			"minutes": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 59),
+			},
+		},
 	}
-
-	return hoursOfOperationConfigs
 }
 
-func flattenConfigs(configs []*connect.HoursOfOperationConfig) []interface{} {
-	configsList := []interface{}{}
-	for _, config := range configs {
-		values := map[string]interface{}{}
-		values["day"] = aws.StringValue(config.Day)
-
-		et := map[string]interface{}{
-			"hours":   aws.Int64Value(config.EndTime.Hours),
-			"minutes": aws.Int64Value(config.EndTime.Minutes),
-		}
-		values["end_time"] = []interface{}{et}
+func expandConfigs(tfList []interface{}) ([]*connect.HoursOfOperationConfig, error) {
+	return flex.ExpandAll[connect.HoursOfOperationConfig](tfList)
+}
 
-		st := map[string]interface{}{
-			"hours":   aws.Int64Value(config.StartTime.Hours),
-			"minutes": aws.Int64Value(config.StartTime.Minutes),
-		}
-		values["start_time"] = []interface{}{st}
-		configsList = append(configsList, values)
-	}
-	return configsList
+func flattenConfigs(apiObjects []*connect.HoursOfOperationConfig) ([]interface{}, error) {
+	return flex.FlattenAll(apiObjects, hoursOfOperationConfigSchema())
 }
 
 func HoursOfOperationParseID(id string) (string, string, error) {
@@ -87,7 +87,8 @@ func DataSourceHoursOfOperation() *schema.Resource {
 			},
 			"instance_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -105,44 +106,40 @@ func DataSourceHoursOfOperation() *schema.Resource {
 }
 
 func dataSourceHoursOfOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setDefaultInstanceID(d, meta); err != nil {
+		return diagFromErr(err)
+	}
+
 	conn := meta.(*conns.AWSClient).ConnectConn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	instanceID := d.Get("instance_id").(string)
 
-	input := &connect.DescribeHoursOfOperationInput{
-		InstanceId: aws.String(instanceID),
-	}
+	var hoursOfOperationID string
 
 	if v, ok := d.GetOk("hours_of_operation_id"); ok {
-		input.HoursOfOperationId = aws.String(v.(string))
+		hoursOfOperationID = v.(string)
 	} else if v, ok := d.GetOk("name"); ok {
 		name := v.(string)
 		hoursOfOperationSummary, err := dataSourceGetHoursOfOperationSummaryByName(ctx, conn, instanceID, name)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error finding Connect Hours of Operation Summary by name (%s): %w", name, err))
+			return diagFromErr(fmt.Errorf("error finding Connect Hours of Operation Summary by name (%s): %w", name, err))
 		}
 
 		if hoursOfOperationSummary == nil {
-			return diag.FromErr(fmt.Errorf("error finding Connect Hours of Operation Summary by name (%s): not found", name))
+			return diagFromErr(fmt.Errorf("error finding Connect Hours of Operation Summary by name (%s): not found", name))
 		}
 
-		input.HoursOfOperationId = hoursOfOperationSummary.Id
+		hoursOfOperationID = aws.StringValue(hoursOfOperationSummary.Id)
 	}
 
-	resp, err := conn.DescribeHoursOfOperationWithContext(ctx, input)
+	hoursOfOperation, err := FindHoursOfOperationByID(ctx, conn, instanceID, hoursOfOperationID)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Hours of Operation: %w", err))
-	}
-
-	if resp == nil || resp.HoursOfOperation == nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Hours of Operation: empty response"))
+		return diagFromErr(fmt.Errorf("error getting Connect Hours of Operation (%s): %w", hoursOfOperationID, err))
 	}
 
-	hoursOfOperation := resp.HoursOfOperation
-
 	d.Set("arn", hoursOfOperation.HoursOfOperationArn)
 	d.Set("hours_of_operation_id", hoursOfOperation.HoursOfOperationId)
 	d.Set("instance_id", instanceID)
@@ -150,12 +147,18 @@ func dataSourceHoursOfOperationRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("name", hoursOfOperation.Name)
 	d.Set("time_zone", hoursOfOperation.TimeZone)
 
-	if err := d.Set("config", flattenConfigs(hoursOfOperation.Config)); err != nil {
-		return diag.FromErr(fmt.Errorf("error setting config: %s", err))
+	config, err := flattenConfigs(hoursOfOperation.Config)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error flattening config: %s", err))
+	}
+
+	if err := d.Set("config", config); err != nil {
+		return diagFromErr(fmt.Errorf("error setting config: %s", err))
 	}
 
 	if err := d.Set("tags", KeyValueTags(ctx, hoursOfOperation.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
-		return diag.FromErr(fmt.Errorf("error setting tags: %s", err))
+		return diagFromErr(fmt.Errorf("error setting tags: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(hoursOfOperation.HoursOfOperationId)))
@@ -7,13 +7,13 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func testAccHoursOfOperation_basic(t *testing.T) {
@@ -279,14 +279,9 @@ func testAccCheckHoursOfOperationDestroy(ctx context.Context) resource.TestCheck
 				return err
 			}
 
-			params := &connect.DescribeHoursOfOperationInput{
-				HoursOfOperationId: aws.String(hoursOfOperationID),
-				InstanceId:         aws.String(instanceID),
-			}
-
-			_, err = conn.DescribeHoursOfOperationWithContext(ctx, params)
+			_, err = tfconnect.FindHoursOfOperationByID(ctx, conn, instanceID, hoursOfOperationID)
 
-			if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+			if tfresource.NotFound(err) {
 				continue
 			}
 
@@ -0,0 +1,256 @@
+package connect
+
+import (
+	"context"
+	"log"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	connect_sdkv2 "github.com/aws/aws-sdk-go-v2/service/connect"
+	"github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Imported phone numbers, e.g. SMS-capable numbers from AWS End User Messaging,
+// are claimed phone numbers like any other once imported.
+
+// @SDKResource("aws_connect_imported_phone_number", name="Imported Phone Number")
+// @Tags(identifierAttribute="arn")
+func ResourceImportedPhoneNumber() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceImportedPhoneNumberCreate,
+		ReadWithoutTimeout:   resourceImportedPhoneNumberRead,
+		UpdateWithoutTimeout: resourceImportedPhoneNumberUpdate,
+		DeleteWithoutTimeout: resourceImportedPhoneNumberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(phoneNumberCreatedTimeout),
+			Delete: schema.DefaultTimeout(phoneNumberDeletedTimeout),
+		},
+		CustomizeDiff: customdiff.Sequence(
+			defaultInstanceIDCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"country_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 500),
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"phone_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_phone_number_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"target_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceImportedPhoneNumberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+	client := meta.(*conns.AWSClient).ConnectClient()
+
+	instanceID := d.Get("instance_id").(string)
+	sourcePhoneNumberARN := d.Get("source_phone_number_arn").(string)
+
+	uuid, err := uuid.GenerateUUID()
+	if err != nil {
+		return diagErrorf("generating uuid for ClientToken for Connect Instance (%s,%s): %s", instanceID, sourcePhoneNumberARN, err)
+	}
+
+	input := &connect_sdkv2.ImportPhoneNumberInput{
+		ClientToken:          aws_sdkv2.String(uuid),
+		InstanceId:           aws_sdkv2.String(instanceID),
+		SourcePhoneNumberArn: aws_sdkv2.String(sourcePhoneNumberARN),
+		Tags:                 aws_sdkv2.ToStringMap(GetTagsIn(ctx)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.PhoneNumberDescription = aws_sdkv2.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Importing Connect Phone Number (%s) into Connect Instance (%s)", sourcePhoneNumberARN, instanceID)
+	output, err := client.ImportPhoneNumber(ctx, input)
+
+	if err != nil {
+		return diagErrorf("importing Connect Phone Number for Connect Instance (%s,%s): %s", instanceID, sourcePhoneNumberARN, err)
+	}
+
+	if output == nil || output.PhoneNumberId == nil {
+		return diagErrorf("importing Connect Phone Number for Connect Instance (%s,%s): empty output", instanceID, sourcePhoneNumberARN)
+	}
+
+	d.SetId(aws_sdkv2.ToString(output.PhoneNumberId))
+
+	if _, err := waitPhoneNumberCreated(ctx, conn, d.Timeout(schema.TimeoutCreate), d.Id()); err != nil {
+		return diagErrorf("waiting for Phone Number (%s) import: %s", d.Id(), err)
+	}
+
+	return resourceImportedPhoneNumberRead(ctx, d, meta)
+}
+
+func resourceImportedPhoneNumberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).ConnectClient()
+
+	phoneNumberSummary, err := FindImportedPhoneNumberByID(ctx, client, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Phone Number (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diagErrorf("getting Connect Phone Number (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", phoneNumberSummary.PhoneNumberArn)
+	d.Set("country_code", phoneNumberSummary.PhoneNumberCountryCode)
+	d.Set("description", phoneNumberSummary.PhoneNumberDescription)
+	d.Set("instance_id", phoneNumberSummary.InstanceId)
+	d.Set("phone_number", phoneNumberSummary.PhoneNumber)
+	d.Set("source_phone_number_arn", phoneNumberSummary.SourcePhoneNumberArn)
+	d.Set("target_arn", phoneNumberSummary.TargetArn)
+	d.Set("type", phoneNumberSummary.PhoneNumberType)
+
+	if err := d.Set("status", flattenImportedPhoneNumberStatus(phoneNumberSummary.PhoneNumberStatus)); err != nil {
+		return diagErrorf("setting status: %s", err)
+	}
+
+	SetTagsOut(ctx, aws_sdkv2.StringMap(phoneNumberSummary.Tags))
+
+	return nil
+}
+
+func resourceImportedPhoneNumberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceImportedPhoneNumberRead(ctx, d, meta)
+}
+
+func resourceImportedPhoneNumberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	phoneNumberId := d.Id()
+
+	uuid, err := uuid.GenerateUUID()
+	if err != nil {
+		return diagErrorf("generating uuid for ClientToken for Phone Number %s: %s", phoneNumberId, err)
+	}
+
+	// Releasing an imported phone number returns it to the service it was imported from.
+	log.Printf("[DEBUG] Releasing Connect Phone Number: %s", phoneNumberId)
+	_, err = conn.ReleasePhoneNumberWithContext(ctx, &connect.ReleasePhoneNumberInput{
+		ClientToken:   aws.String(uuid),
+		PhoneNumberId: aws.String(phoneNumberId),
+	})
+
+	if err != nil {
+		return diagErrorf("deleting PhoneNumber (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPhoneNumberDeleted(ctx, conn, d.Timeout(schema.TimeoutDelete), phoneNumberId); err != nil {
+		return diagErrorf("waiting for Phone Number (%s) deletion: %s", phoneNumberId, err)
+	}
+
+	return nil
+}
+
+// FindImportedPhoneNumberByID uses the AWS SDK for Go v2 API, as only it returns the source phone number ARN and the instance ID.
+func FindImportedPhoneNumberByID(ctx context.Context, client *connect_sdkv2.Client, phoneNumberID string) (*types.ClaimedPhoneNumberSummary, error) {
+	input := &connect_sdkv2.DescribePhoneNumberInput{
+		PhoneNumberId: aws_sdkv2.String(phoneNumberID),
+	}
+
+	output, err := client.DescribePhoneNumber(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ClaimedPhoneNumberSummary == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ClaimedPhoneNumberSummary, nil
+}
+
+func flattenImportedPhoneNumberStatus(apiObject *types.PhoneNumberStatus) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	values := map[string]interface{}{
+		"message": aws_sdkv2.ToString(apiObject.Message),
+		"status":  string(apiObject.Status),
+	}
+
+	return []interface{}{values}
+}
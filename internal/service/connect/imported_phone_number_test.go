@@ -0,0 +1,141 @@
+package connect_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccImportedPhoneNumber_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	// The source phone number must be provisioned outside of Amazon Connect, e.g. in AWS End User Messaging SMS.
+	sourcePhoneNumberARN := os.Getenv("CONNECT_SOURCE_PHONE_NUMBER_ARN")
+	if sourcePhoneNumberARN == "" {
+		t.Skip("Environment variable CONNECT_SOURCE_PHONE_NUMBER_ARN is not set")
+	}
+
+	var v types.ClaimedPhoneNumberSummary
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_connect_imported_phone_number.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckImportedPhoneNumberDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImportedPhoneNumberConfig_tags1(rName, sourcePhoneNumberARN, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImportedPhoneNumberExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "country_code"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "phone_number"),
+					resource.TestCheckResourceAttr(resourceName, "source_phone_number_arn", sourcePhoneNumberARN),
+					resource.TestCheckResourceAttr(resourceName, "status.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status.0.status", connect.PhoneNumberWorkflowStatusClaimed),
+					resource.TestCheckResourceAttrPair(resourceName, "target_arn", "aws_connect_instance.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccImportedPhoneNumberConfig_tags1(rName, sourcePhoneNumberARN, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImportedPhoneNumberExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImportedPhoneNumberExists(ctx context.Context, resourceName string, v *types.ClaimedPhoneNumberSummary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Connect Phone Number not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Connect Phone Number ID not set")
+		}
+
+		client := acctest.Provider.Meta().(*conns.AWSClient).ConnectClient()
+
+		output, err := tfconnect.FindImportedPhoneNumberByID(ctx, client, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckImportedPhoneNumberDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_connect_imported_phone_number" {
+				continue
+			}
+
+			client := acctest.Provider.Meta().(*conns.AWSClient).ConnectClient()
+
+			_, err := tfconnect.FindImportedPhoneNumberByID(ctx, client, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Phone Number %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccImportedPhoneNumberConfig_tags1(rName, sourcePhoneNumberARN, tag, value string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+
+resource "aws_connect_imported_phone_number" "test" {
+  instance_id             = aws_connect_instance.test.id
+  source_phone_number_arn = %[2]q
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, sourcePhoneNumberARN, tag, value)
+}
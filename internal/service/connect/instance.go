@@ -2,6 +2,7 @@ package connect
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
@@ -12,13 +13,19 @@ import (
 	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-// @SDKResource("aws_connect_instance")
+// @SDKResource("aws_connect_instance", name="Instance")
+// @Tags(identifierAttribute="arn")
 func ResourceInstance() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceInstanceCreate,
@@ -26,12 +33,21 @@ func ResourceInstance() *schema.Resource {
 		UpdateWithoutTimeout: resourceInstanceUpdate,
 		DeleteWithoutTimeout: resourceInstanceDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// deletion_protection is not returned by the API.
+				d.Set("deletion_protection", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(instanceCreatedTimeout),
 			Delete: schema.DefaultTimeout(instanceDeletedTimeout),
 		},
+		CustomizeDiff: customdiff.Sequence(
+			resourceInstanceCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -56,6 +72,11 @@ func ResourceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"directory_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -68,6 +89,11 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				Default:  true, //verified default result from ListInstanceAttributes()
 			},
+			"high_volume_outbound_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false, //verified default result from ListInstanceAttributes()
+			},
 			"identity_management_type": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -106,12 +132,13 @@ func ResourceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			// Pre-release feature requiring allow-list from AWS. Removing all functionality until feature is GA
-			// "use_custom_tts_voices_enabled": {
-			// 	Type:     schema.TypeBool,
-			// 	Optional: true,
-			// 	Default:  false, //verified default result from ListInstanceAttributes()
-			// },
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"use_custom_tts_voices_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false, //verified default result from ListInstanceAttributes()
+			},
 		},
 	}
 }
@@ -137,23 +164,26 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 	output, err := conn.CreateInstanceWithContext(ctx, input)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating Connect Instance (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error creating Connect Instance (%s): %w", d.Id(), err))
 	}
 
 	d.SetId(aws.StringValue(output.Id))
 
 	if _, err := waitInstanceCreated(ctx, conn, d.Timeout(schema.TimeoutCreate), d.Id()); err != nil {
-		return diag.FromErr(fmt.Errorf("error waiting for Connect instance creation (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error waiting for Connect instance creation (%s): %w", d.Id(), err))
+	}
+
+	// CreateInstance does not accept tags, so they are added once the instance is active.
+	if err := createTags(ctx, conn, aws.StringValue(output.Arn), GetTagsIn(ctx)); err != nil {
+		return diagFromErr(fmt.Errorf("setting Connect Instance (%s) tags: %w", d.Id(), err))
 	}
 
-	for att := range InstanceAttributeMapping() {
-		rKey := InstanceAttributeMapping()[att]
+	for att, rKey := range instanceAttributeMapping() {
 		err := resourceInstanceUpdateAttribute(ctx, conn, d.Id(), att, strconv.FormatBool(d.Get(rKey).(bool)))
-		//Pre-release attribute, user/account/instance now allow-listed
-		if err != nil && tfawserr.ErrCodeEquals(err, ErrCodeAccessDeniedException) || tfawserr.ErrMessageContains(err, ErrCodeAccessDeniedException, "not authorized to update") {
+		if isPreReleaseInstanceAttributeAccessDenied(att, err) {
 			log.Printf("[WARN] error setting Connect instance (%s) attribute (%s): %s", d.Id(), att, err)
 		} else if err != nil {
-			return diag.FromErr(fmt.Errorf("error setting Connect instance (%s) attribute (%s): %w", d.Id(), att, err))
+			return diagFromErr(fmt.Errorf("error setting Connect instance (%s) attribute (%s): %w", d.Id(), att, err))
 		}
 	}
 
@@ -163,44 +193,36 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ConnectConn()
 
-	for att := range InstanceAttributeMapping() {
-		rKey := InstanceAttributeMapping()[att]
+	for att, rKey := range instanceAttributeMapping() {
 		if d.HasChange(rKey) {
 			_, n := d.GetChange(rKey)
 			err := resourceInstanceUpdateAttribute(ctx, conn, d.Id(), att, strconv.FormatBool(n.(bool)))
-			//Pre-release attribute, user/account/instance now allow-listed
-			if err != nil && tfawserr.ErrCodeEquals(err, ErrCodeAccessDeniedException) || tfawserr.ErrMessageContains(err, ErrCodeAccessDeniedException, "not authorized to update") {
+			if isPreReleaseInstanceAttributeAccessDenied(att, err) {
 				log.Printf("[WARN] error setting Connect instance (%s) attribute (%s): %s", d.Id(), att, err)
 			} else if err != nil {
-				return diag.FromErr(fmt.Errorf("error setting Connect instance (%s) attribute (%s): %s", d.Id(), att, err))
+				return diagFromErr(fmt.Errorf("error setting Connect instance (%s) attribute (%s): %s", d.Id(), att, err))
 			}
 		}
 	}
 
-	return nil
+	return resourceInstanceRead(ctx, d, meta)
 }
 func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ConnectConn()
 
-	input := connect.DescribeInstanceInput{
-		InstanceId: aws.String(d.Id()),
-	}
-
 	log.Printf("[DEBUG] Reading Connect Instance %s", d.Id())
-	output, err := conn.DescribeInstanceWithContext(ctx, &input)
+	instance, err := FindInstanceByID(ctx, conn, d.Id())
 
-	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Connect Instance (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error reading Connect Instance (%s): %s", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error reading Connect Instance (%s): %s", d.Id(), err))
 	}
 
-	instance := output.Instance
-
 	d.SetId(aws.StringValue(instance.Id))
 	d.Set("arn", instance.Arn)
 	d.Set("created_time", instance.CreatedTime.Format(time.RFC3339))
@@ -211,12 +233,16 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("service_role", instance.ServiceRole)
 	d.Set("status", instance.InstanceStatus)
 
-	for att := range InstanceAttributeMapping() {
+	for att, rKey := range instanceAttributeMapping() {
 		value, err := resourceInstanceReadAttribute(ctx, conn, d.Id(), att)
+		if isPreReleaseInstanceAttributeAccessDenied(att, err) {
+			log.Printf("[WARN] error reading Connect instance (%s) attribute (%s): %s", d.Id(), att, err)
+			continue
+		}
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error reading Connect instance (%s) attribute (%s): %s", d.Id(), att, err))
+			return diagFromErr(fmt.Errorf("error reading Connect instance (%s) attribute (%s): %s", d.Id(), att, err))
 		}
-		d.Set(InstanceAttributeMapping()[att], value)
+		d.Set(rKey, value)
 	}
 
 	return nil
@@ -225,6 +251,11 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ConnectConn()
 
+	// Deleting an instance also deletes its users, flows and claimed phone numbers and cannot be undone.
+	if d.Get("deletion_protection").(bool) {
+		return diagErrorf("deleting Connect Instance (%s): deletion protection is enabled, set deletion_protection to false and apply before destroying", d.Id())
+	}
+
 	input := &connect.DeleteInstanceInput{
 		InstanceId: aws.String(d.Id()),
 	}
@@ -233,20 +264,127 @@ func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta in
 
 	_, err := conn.DeleteInstanceWithContext(ctx, input)
 
+	conns.Forget(meta.(*conns.AWSClient), instanceMemoKey(d.Id()))
+
 	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
 		return nil
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting Connect Instance (%s): %s", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error deleting Connect Instance (%s): %s", d.Id(), err))
 	}
 
-	if _, err := waitInstanceDeleted(ctx, conn, d.Timeout(schema.TimeoutCreate), d.Id()); err != nil {
-		return diag.FromErr(fmt.Errorf("error waiting for Connect Instance deletion (%s): %s", d.Id(), err))
+	if _, err := waitInstanceDeleted(ctx, conn, d.Timeout(schema.TimeoutDelete), d.Id()); err != nil {
+		return diagFromErr(fmt.Errorf("error waiting for Connect Instance deletion (%s): %s", d.Id(), err))
 	}
 	return nil
 }
 
+func resourceInstanceCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("identity_management_type") {
+		return nil
+	}
+
+	identityManagementType := diff.Get("identity_management_type").(string)
+
+	switch identityManagementType {
+	case connect.DirectoryTypeExistingDirectory:
+		// an unknown directory_id (e.g. from a directory created in the same configuration) is validated at apply time
+		if diff.NewValueKnown("directory_id") && diff.Get("directory_id").(string) == "" {
+			return fmt.Errorf("`directory_id` must be set when `identity_management_type` is %q", identityManagementType)
+		}
+	case connect.DirectoryTypeConnectManaged, connect.DirectoryTypeSaml:
+		if diff.Get("directory_id").(string) != "" {
+			return fmt.Errorf("`directory_id` can only be set when `identity_management_type` is %q", connect.DirectoryTypeExistingDirectory)
+		}
+
+		if diff.NewValueKnown("instance_alias") && diff.Get("instance_alias").(string) == "" {
+			return fmt.Errorf("`instance_alias` must be set when `identity_management_type` is %q", identityManagementType)
+		}
+	}
+
+	return nil
+}
+
+// defaultInstanceIDCustomizeDiff sets instance_id to the provider's connect_default_instance_id when it is not configured.
+func defaultInstanceIDCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if rawConfig := diff.GetRawConfig(); rawConfig.IsNull() || !rawConfig.GetAttr("instance_id").IsNull() {
+		return nil
+	}
+
+	instanceID := meta.(*conns.AWSClient).ConnectDefaultInstanceID
+
+	if instanceID == "" {
+		return errDefaultInstanceIDNotSet
+	}
+
+	if diff.Id() == "" {
+		return diff.SetNew("instance_id", instanceID)
+	}
+
+	// resources cannot be moved between instances, so a changed default replaces them
+	if diff.Get("instance_id").(string) != instanceID {
+		if err := diff.SetNew("instance_id", instanceID); err != nil {
+			return err
+		}
+
+		return diff.ForceNew("instance_id")
+	}
+
+	return nil
+}
+
+// setDefaultInstanceID sets a data source's instance_id to the provider's connect_default_instance_id when it is not configured.
+func setDefaultInstanceID(d *schema.ResourceData, meta interface{}) error {
+	if d.Get("instance_id").(string) != "" {
+		return nil
+	}
+
+	instanceID := meta.(*conns.AWSClient).ConnectDefaultInstanceID
+
+	if instanceID == "" {
+		return errDefaultInstanceIDNotSet
+	}
+
+	return d.Set("instance_id", instanceID)
+}
+
+var errDefaultInstanceIDNotSet = errors.New("instance_id must be set when connect_default_instance_id is not set in the provider configuration")
+
+// instanceMutexKey returns the key that serializes mutations Amazon Connect rejects with conflict errors
+// when they are made concurrently against the same instance, e.g. creating hierarchy groups, queues or storage configs.
+func instanceMutexKey(instanceID string) string {
+	return "aws_connect_instance:" + instanceID
+}
+
+// withInstanceLock makes a single mutating API call while holding the instance's lock.
+// The lock is not held across retries or other calls so that resources on the same instance are not blocked.
+func withInstanceLock[T any](instanceID string, f func() (T, error)) (T, error) {
+	conns.GlobalMutexKV.Lock(instanceMutexKey(instanceID))
+	defer conns.GlobalMutexKV.Unlock(instanceMutexKey(instanceID))
+
+	return f()
+}
+
+// instanceAttributeMapping returns the GA and pre-release instance attributes.
+func instanceAttributeMapping() map[string]string {
+	m := InstanceAttributeMapping()
+
+	for k, v := range PreReleaseInstanceAttributeMapping() {
+		m[k] = v
+	}
+
+	return m
+}
+
+// isPreReleaseInstanceAttributeAccessDenied returns whether err is the AccessDeniedException returned
+// for a pre-release attribute of an instance whose account is not allow-listed.
+func isPreReleaseInstanceAttributeAccessDenied(attributeType string, err error) bool {
+	_, ok := PreReleaseInstanceAttributeMapping()[attributeType]
+
+	return ok && tfawserr.ErrCodeEquals(err, ErrCodeAccessDeniedException)
+}
+
 func resourceInstanceUpdateAttribute(ctx context.Context, conn *connect.Connect, instanceID string, attributeType string, value string) error {
 	input := &connect.UpdateInstanceAttributeInput{
 		InstanceId:    aws.String(instanceID),
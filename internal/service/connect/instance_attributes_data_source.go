@@ -0,0 +1,93 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// @SDKDataSource("aws_connect_instance_attributes")
+func DataSourceInstanceAttributes() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceInstanceAttributesRead,
+		Schema: map[string]*schema.Schema{
+			// Keyed by attribute type, e.g. CONTACT_LENS, so that attributes not yet mapped
+			// onto aws_connect_instance arguments are available too.
+			"attributes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeBool},
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+		},
+	}
+}
+
+func dataSourceInstanceAttributesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setDefaultInstanceID(d, meta); err != nil {
+		return diagFromErr(err)
+	}
+
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID := d.Get("instance_id").(string)
+
+	input := &connect.ListInstanceAttributesInput{
+		InstanceId: aws.String(instanceID),
+	}
+
+	attributes := map[string]bool{}
+	var parseErr error
+
+	err := conn.ListInstanceAttributesPagesWithContext(ctx, input, func(page *connect.ListInstanceAttributesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Attributes {
+			if v == nil {
+				continue
+			}
+
+			attributeType := aws.StringValue(v.AttributeType)
+			value, err := strconv.ParseBool(aws.StringValue(v.Value))
+
+			if err != nil {
+				parseErr = fmt.Errorf("parsing value of attribute (%s): %w", attributeType, err)
+				return false
+			}
+
+			attributes[attributeType] = value
+		}
+
+		return !lastPage
+	})
+
+	if err == nil {
+		err = parseErr
+	}
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error listing Connect Instance (%s) attributes: %w", instanceID, err))
+	}
+
+	if err := d.Set("attributes", attributes); err != nil {
+		return diagFromErr(fmt.Errorf("error setting attributes: %w", err))
+	}
+
+	d.SetId(instanceID)
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package connect_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccInstanceAttributesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("datasource-test-terraform")
+	dataSourceName := "data.aws_connect_instance_attributes.test"
+	resourceName := "aws_connect_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceAttributesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "attributes.CONTACTFLOW_LOGS", resourceName, "contact_flow_logs_enabled"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "attributes.CONTACT_LENS", resourceName, "contact_lens_enabled"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "attributes.EARLY_MEDIA", resourceName, "early_media_enabled"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "attributes.INBOUND_CALLS", resourceName, "inbound_calls_enabled"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "attributes.OUTBOUND_CALLS", resourceName, "outbound_calls_enabled"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstanceAttributesDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+
+data "aws_connect_instance_attributes" "test" {
+  instance_id = aws_connect_instance.test.id
+}
+`, rName)
+}
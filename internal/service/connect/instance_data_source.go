@@ -47,6 +47,10 @@ func DataSourceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"high_volume_outbound_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"inbound_calls_enabled": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -79,10 +83,10 @@ func DataSourceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			// "use_custom_tts_voices_enabled": {
-			// 	Type:     schema.TypeBool,
-			// 	Computed: true,
-			// },
+			"use_custom_tts_voices_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -104,11 +108,11 @@ func dataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta in
 		output, err := conn.DescribeInstanceWithContext(ctx, &input)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error getting Connect Instance by instance_id (%s): %w", instanceId, err))
+			return diagFromErr(fmt.Errorf("error getting Connect Instance by instance_id (%s): %w", instanceId, err))
 		}
 
 		if output == nil {
-			return diag.FromErr(fmt.Errorf("error getting Connect Instance by instance_id (%s): empty output", instanceId))
+			return diagFromErr(fmt.Errorf("error getting Connect Instance by instance_id (%s): empty output", instanceId))
 		}
 
 		matchedInstance = output.Instance
@@ -118,11 +122,11 @@ func dataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta in
 		instanceSummary, err := dataSourceGetInstanceSummaryByInstanceAlias(ctx, conn, instanceAlias)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error finding Connect Instance Summary by instance_alias (%s): %w", instanceAlias, err))
+			return diagFromErr(fmt.Errorf("error finding Connect Instance Summary by instance_alias (%s): %w", instanceAlias, err))
 		}
 
 		if instanceSummary == nil {
-			return diag.FromErr(fmt.Errorf("error finding Connect Instance Summary by instance_alias (%s): not found", instanceAlias))
+			return diagFromErr(fmt.Errorf("error finding Connect Instance Summary by instance_alias (%s): not found", instanceAlias))
 		}
 
 		matchedInstance = &connect.Instance{
@@ -139,7 +143,7 @@ func dataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	if matchedInstance == nil {
-		return diag.FromErr(fmt.Errorf("no Connect Instance found for query, try adjusting your search criteria"))
+		return diagFromErr(fmt.Errorf("no Connect Instance found for query, try adjusting your search criteria"))
 	}
 
 	d.SetId(aws.StringValue(matchedInstance.Id))
@@ -153,12 +157,16 @@ func dataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta in
 	d.Set("service_role", matchedInstance.ServiceRole)
 	d.Set("status", matchedInstance.InstanceStatus)
 
-	for att := range InstanceAttributeMapping() {
+	for att, rKey := range instanceAttributeMapping() {
 		value, err := dataSourceInstanceReadAttribute(ctx, conn, d.Id(), att)
+		if isPreReleaseInstanceAttributeAccessDenied(att, err) {
+			log.Printf("[WARN] error reading Connect Instance (%s) attribute (%s): %s", d.Id(), att, err)
+			continue
+		}
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error reading Connect Instance (%s) attribute (%s): %w", d.Id(), att, err))
+			return diagFromErr(fmt.Errorf("error reading Connect Instance (%s) attribute (%s): %w", d.Id(), att, err))
 		}
-		d.Set(InstanceAttributeMapping()[att], value)
+		d.Set(rKey, value)
 	}
 
 	return nil
@@ -26,6 +26,7 @@ func ResourceInstanceStorageConfig() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: defaultInstanceIDCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"association_id": {
 				Type:     schema.TypeString,
@@ -33,7 +34,8 @@ func ResourceInstanceStorageConfig() *schema.Resource {
 			},
 			"instance_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 100),
 			},
@@ -186,14 +188,16 @@ func resourceInstanceStorageConfigCreate(ctx context.Context, d *schema.Resource
 	}
 
 	log.Printf("[DEBUG] Creating Connect Instance Storage Config %s", input)
-	output, err := conn.AssociateInstanceStorageConfigWithContext(ctx, input)
+	output, err := withInstanceLock(instanceId, func() (*connect.AssociateInstanceStorageConfigOutput, error) {
+		return conn.AssociateInstanceStorageConfigWithContext(ctx, input)
+	})
 
 	if err != nil {
-		return diag.Errorf("creating Connect Instance Storage Config for Connect Instance (%s,%s): %s", instanceId, resourceType, err)
+		return diagErrorf("creating Connect Instance Storage Config for Connect Instance (%s,%s): %s", instanceId, resourceType, err)
 	}
 
 	if output == nil || output.AssociationId == nil {
-		return diag.Errorf("creating Connect Instance Storage Config for Connect Instance (%s,%s): empty output", instanceId, resourceType)
+		return diagErrorf("creating Connect Instance Storage Config for Connect Instance (%s,%s): empty output", instanceId, resourceType)
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s:%s", instanceId, aws.StringValue(output.AssociationId), resourceType))
@@ -207,7 +211,7 @@ func resourceInstanceStorageConfigRead(ctx context.Context, d *schema.ResourceDa
 	instanceId, associationId, resourceType, err := InstanceStorageConfigParseId(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	resp, err := conn.DescribeInstanceStorageConfigWithContext(ctx, &connect.DescribeInstanceStorageConfigInput{
@@ -223,11 +227,11 @@ func resourceInstanceStorageConfigRead(ctx context.Context, d *schema.ResourceDa
 	}
 
 	if err != nil {
-		return diag.Errorf("getting Connect Instance Storage Config (%s): %s", d.Id(), err)
+		return diagErrorf("getting Connect Instance Storage Config (%s): %s", d.Id(), err)
 	}
 
 	if resp == nil || resp.StorageConfig == nil {
-		return diag.Errorf("getting Connect Instance Storage Config (%s): empty response", d.Id())
+		return diagErrorf("getting Connect Instance Storage Config (%s): empty response", d.Id())
 	}
 
 	storageConfig := resp.StorageConfig
@@ -237,7 +241,7 @@ func resourceInstanceStorageConfigRead(ctx context.Context, d *schema.ResourceDa
 	d.Set("resource_type", resourceType)
 
 	if err := d.Set("storage_config", flattenStorageConfig(storageConfig)); err != nil {
-		return diag.Errorf("setting storage_config: %s", err)
+		return diagErrorf("setting storage_config: %s", err)
 	}
 
 	return nil
@@ -249,7 +253,7 @@ func resourceInstanceStorageConfigUpdate(ctx context.Context, d *schema.Resource
 	instanceId, associationId, resourceType, err := InstanceStorageConfigParseId(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	input := &connect.UpdateInstanceStorageConfigInput{
@@ -262,10 +266,12 @@ func resourceInstanceStorageConfigUpdate(ctx context.Context, d *schema.Resource
 		input.StorageConfig = expandStorageConfig(d.Get("storage_config").([]interface{}))
 	}
 
-	_, err = conn.UpdateInstanceStorageConfigWithContext(ctx, input)
+	_, err = withInstanceLock(instanceId, func() (*connect.UpdateInstanceStorageConfigOutput, error) {
+		return conn.UpdateInstanceStorageConfigWithContext(ctx, input)
+	})
 
 	if err != nil {
-		return diag.Errorf("updating Instance Storage Config (%s): %s", d.Id(), err)
+		return diagErrorf("updating Instance Storage Config (%s): %s", d.Id(), err)
 	}
 
 	return resourceInstanceStorageConfigRead(ctx, d, meta)
@@ -277,17 +283,19 @@ func resourceInstanceStorageConfigDelete(ctx context.Context, d *schema.Resource
 	instanceId, associationId, resourceType, err := InstanceStorageConfigParseId(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
-	_, err = conn.DisassociateInstanceStorageConfigWithContext(ctx, &connect.DisassociateInstanceStorageConfigInput{
-		AssociationId: aws.String(associationId),
-		InstanceId:    aws.String(instanceId),
-		ResourceType:  aws.String(resourceType),
+	_, err = withInstanceLock(instanceId, func() (*connect.DisassociateInstanceStorageConfigOutput, error) {
+		return conn.DisassociateInstanceStorageConfigWithContext(ctx, &connect.DisassociateInstanceStorageConfigInput{
+			AssociationId: aws.String(associationId),
+			InstanceId:    aws.String(instanceId),
+			ResourceType:  aws.String(resourceType),
+		})
 	})
 
 	if err != nil {
-		return diag.Errorf("deleting InstanceStorageConfig (%s): %s", d.Id(), err)
+		return diagErrorf("deleting InstanceStorageConfig (%s): %s", d.Id(), err)
 	}
 
 	return nil
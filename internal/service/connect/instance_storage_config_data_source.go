@@ -24,7 +24,8 @@ func DataSourceInstanceStorageConfig() *schema.Resource {
 			},
 			"instance_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(1, 100),
 			},
 			"resource_type": {
@@ -137,6 +138,10 @@ func DataSourceInstanceStorageConfig() *schema.Resource {
 }
 
 func dataSourceInstanceStorageConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setDefaultInstanceID(d, meta); err != nil {
+		return diagFromErr(err)
+	}
+
 	conn := meta.(*conns.AWSClient).ConnectConn()
 
 	associationId := d.Get("association_id").(string)
@@ -152,17 +157,17 @@ func dataSourceInstanceStorageConfigRead(ctx context.Context, d *schema.Resource
 	resp, err := conn.DescribeInstanceStorageConfigWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("getting Connect Instance Storage Config for Connect Instance (%s,%s,%s): %s", associationId, instanceId, resourceType, err)
+		return diagErrorf("getting Connect Instance Storage Config for Connect Instance (%s,%s,%s): %s", associationId, instanceId, resourceType, err)
 	}
 
 	if resp == nil || resp.StorageConfig == nil {
-		return diag.Errorf("getting Connect Instance Storage Config: empty response")
+		return diagErrorf("getting Connect Instance Storage Config: empty response")
 	}
 
 	storageConfig := resp.StorageConfig
 
 	if err := d.Set("storage_config", flattenStorageConfig(storageConfig)); err != nil {
-		return diag.Errorf("setting storage_config: %s", err)
+		return diagErrorf("setting storage_config: %s", err)
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s:%s", instanceId, associationId, resourceType))
@@ -3,18 +3,19 @@ package connect_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func testAccInstanceStorageConfig_basic(t *testing.T) {
@@ -53,6 +54,42 @@ func testAccInstanceStorageConfig_basic(t *testing.T) {
 	})
 }
 
+func testAccInstanceStorageConfig_import(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.DescribeInstanceStorageConfigOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_instance_storage_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceStorageConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceStorageConfigConfig_basic(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceStorageConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "association_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccInstanceStorageConfigImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "instance-id:association-id",
+				ExpectError:   regexp.MustCompile(`expected instanceId:associationId:resourceType`),
+			},
+		},
+	})
+}
+
 func testAccInstanceStorageConfig_KinesisFirehoseConfig_FirehoseARN(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v connect.DescribeInstanceStorageConfigOutput
@@ -470,6 +507,18 @@ func testAccInstanceStorageConfig_disappears(t *testing.T) {
 	})
 }
 
+// testAccInstanceStorageConfigImportStateIdFunc builds the documented instance_id:association_id:resource_type import ID.
+func testAccInstanceStorageConfigImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s:%s:%s", rs.Primary.Attributes["instance_id"], rs.Primary.Attributes["association_id"], rs.Primary.Attributes["resource_type"]), nil
+	}
+}
+
 func testAccCheckInstanceStorageConfigExists(ctx context.Context, resourceName string, function *connect.DescribeInstanceStorageConfigOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -520,15 +569,9 @@ func testAccCheckInstanceStorageConfigDestroy(ctx context.Context) resource.Test
 				return err
 			}
 
-			params := &connect.DescribeInstanceStorageConfigInput{
-				AssociationId: aws.String(associationId),
-				InstanceId:    aws.String(instanceId),
-				ResourceType:  aws.String(resourceType),
-			}
-
-			_, err = conn.DescribeInstanceStorageConfigWithContext(ctx, params)
+			_, err = tfconnect.FindInstanceStorageConfigByID(ctx, conn, instanceId, associationId, resourceType)
 
-			if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+			if tfresource.NotFound(err) {
 				continue
 			}
 
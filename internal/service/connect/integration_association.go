@@ -0,0 +1,203 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_connect_integration_association", name="Integration Association")
+// @Tags(identifierAttribute="arn")
+func ResourceIntegrationAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIntegrationAssociationCreate,
+		ReadWithoutTimeout:   resourceIntegrationAssociationRead,
+		UpdateWithoutTimeout: resourceIntegrationAssociationUpdate,
+		DeleteWithoutTimeout: resourceIntegrationAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"integration_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"integration_association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"integration_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(connect.IntegrationType_Values(), false),
+			},
+			"source_application_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"source_application_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2000),
+			},
+			"source_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(connect.SourceType_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			defaultInstanceIDCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func resourceIntegrationAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID := d.Get("instance_id").(string)
+	integrationARN := d.Get("integration_arn").(string)
+	input := &connect.CreateIntegrationAssociationInput{
+		InstanceId:      aws.String(instanceID),
+		IntegrationArn:  aws.String(integrationARN),
+		IntegrationType: aws.String(d.Get("integration_type").(string)),
+		Tags:            GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("source_application_name"); ok {
+		input.SourceApplicationName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_application_url"); ok {
+		input.SourceApplicationUrl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_type"); ok {
+		input.SourceType = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateIntegrationAssociationWithContext(ctx, input)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error creating Connect Integration Association (%s,%s): %w", instanceID, integrationARN, err))
+	}
+
+	if output == nil {
+		return diagFromErr(fmt.Errorf("error creating Connect Integration Association (%s,%s): empty output", instanceID, integrationARN))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(output.IntegrationAssociationId)))
+
+	return resourceIntegrationAssociationRead(ctx, d, meta)
+}
+
+func resourceIntegrationAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID, associationID, err := IntegrationAssociationParseID(d.Id())
+
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	association, err := FindIntegrationAssociationByID(ctx, conn, instanceID, associationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Integration Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error getting Connect Integration Association (%s): %w", d.Id(), err))
+	}
+
+	d.Set("arn", association.IntegrationAssociationArn)
+	d.Set("instance_id", instanceID)
+	d.Set("integration_arn", association.IntegrationArn)
+	d.Set("integration_association_id", association.IntegrationAssociationId)
+	d.Set("integration_type", association.IntegrationType)
+	d.Set("source_application_name", association.SourceApplicationName)
+	d.Set("source_application_url", association.SourceApplicationUrl)
+	d.Set("source_type", association.SourceType)
+
+	return nil
+}
+
+func resourceIntegrationAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceIntegrationAssociationRead(ctx, d, meta)
+}
+
+func resourceIntegrationAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID, associationID, err := IntegrationAssociationParseID(d.Id())
+
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Connect Integration Association: %s", d.Id())
+	_, err = conn.DeleteIntegrationAssociationWithContext(ctx, &connect.DeleteIntegrationAssociationInput{
+		InstanceId:               aws.String(instanceID),
+		IntegrationAssociationId: aws.String(associationID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error deleting Connect Integration Association (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func IntegrationAssociationParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected instanceID:integrationAssociationID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
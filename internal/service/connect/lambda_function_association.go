@@ -24,6 +24,7 @@ func ResourceLambdaFunctionAssociation() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: defaultInstanceIDCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"function_arn": {
 				Type:         schema.TypeString,
@@ -33,7 +34,8 @@ func ResourceLambdaFunctionAssociation() *schema.Resource {
 			},
 			"instance_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 		},
@@ -53,7 +55,7 @@ func resourceLambdaFunctionAssociationCreate(ctx context.Context, d *schema.Reso
 
 	_, err := conn.AssociateLambdaFunctionWithContext(ctx, input)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating Connect Lambda Function Association (%s,%s): %s", instanceId, functionArn, err))
+		return diagFromErr(fmt.Errorf("error creating Connect Lambda Function Association (%s,%s): %s", instanceId, functionArn, err))
 	}
 
 	d.SetId(LambdaFunctionAssociationCreateResourceID(instanceId, functionArn))
@@ -67,7 +69,7 @@ func resourceLambdaFunctionAssociationRead(ctx context.Context, d *schema.Resour
 	instanceID, functionArn, err := LambdaFunctionAssociationParseResourceID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	lfaArn, err := FindLambdaFunctionAssociationByARNWithContext(ctx, conn, instanceID, functionArn)
@@ -79,7 +81,7 @@ func resourceLambdaFunctionAssociationRead(ctx context.Context, d *schema.Resour
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error finding Connect Lambda Function Association by Function ARN (%s): %w", functionArn, err))
+		return diagFromErr(fmt.Errorf("error finding Connect Lambda Function Association by Function ARN (%s): %w", functionArn, err))
 	}
 
 	d.Set("function_arn", lfaArn)
@@ -93,7 +95,7 @@ func resourceLambdaFunctionAssociationDelete(ctx context.Context, d *schema.Reso
 
 	instanceID, functionArn, err := LambdaFunctionAssociationParseResourceID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	input := &connect.DisassociateLambdaFunctionInput{
@@ -108,7 +110,7 @@ func resourceLambdaFunctionAssociationDelete(ctx context.Context, d *schema.Reso
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting Connect Lambda Function Association (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error deleting Connect Lambda Function Association (%s): %w", d.Id(), err))
 	}
 
 	return nil
@@ -4,6 +4,8 @@ import (
 	"context"
 	"log"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	connect_sdkv2 "github.com/aws/aws-sdk-go-v2/service/connect"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -48,7 +50,6 @@ func ResourcePhoneNumber() *schema.Resource {
 			"description": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 500),
 			},
 			"phone_number": {
@@ -80,7 +81,7 @@ func ResourcePhoneNumber() *schema.Resource {
 			"target_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidConnectARN("instance", "traffic-distribution-group"),
 			},
 			"type": {
 				Type:         schema.TypeString,
@@ -114,18 +115,18 @@ func resourcePhoneNumberCreate(ctx context.Context, d *schema.ResourceData, meta
 	output, err := conn.SearchAvailablePhoneNumbersWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("searching Connect Phone Number for Connect Instance (%s,%s): %s", targetArn, phoneNumberType, err)
+		return diagErrorf("searching Connect Phone Number for Connect Instance (%s,%s): %s", targetArn, phoneNumberType, err)
 	}
 
 	if output == nil || output.AvailableNumbersList == nil || len(output.AvailableNumbersList) == 0 {
-		return diag.Errorf("searching Connect Phone Number for Connect Instance (%s,%s): empty output", targetArn, phoneNumberType)
+		return diagErrorf("searching Connect Phone Number for Connect Instance (%s,%s): empty output", targetArn, phoneNumberType)
 	}
 
 	phoneNumber := output.AvailableNumbersList[0].PhoneNumber
 
 	uuid, err := uuid.GenerateUUID()
 	if err != nil {
-		return diag.Errorf("generating uuid for ClientToken for Connect Instance (%s,%s): %s", targetArn, aws.StringValue(phoneNumber), err)
+		return diagErrorf("generating uuid for ClientToken for Connect Instance (%s,%s): %s", targetArn, aws.StringValue(phoneNumber), err)
 	}
 
 	input2 := &connect.ClaimPhoneNumberInput{
@@ -143,18 +144,18 @@ func resourcePhoneNumberCreate(ctx context.Context, d *schema.ResourceData, meta
 	output2, err2 := conn.ClaimPhoneNumberWithContext(ctx, input2)
 
 	if err2 != nil {
-		return diag.Errorf("creating Connect Phone Number for Connect Instance (%s,%s): %s", targetArn, aws.StringValue(phoneNumber), err2)
+		return diagErrorf("creating Connect Phone Number for Connect Instance (%s,%s): %s", targetArn, aws.StringValue(phoneNumber), err2)
 	}
 
 	if output2 == nil || output2.PhoneNumberId == nil {
-		return diag.Errorf("creating Connect Phone Number for Connect Instance (%s,%s): empty output", targetArn, aws.StringValue(phoneNumber))
+		return diagErrorf("creating Connect Phone Number for Connect Instance (%s,%s): empty output", targetArn, aws.StringValue(phoneNumber))
 	}
 
 	phoneNumberId := output2.PhoneNumberId
 	d.SetId(aws.StringValue(phoneNumberId))
 
 	if _, err := waitPhoneNumberCreated(ctx, conn, d.Timeout(schema.TimeoutCreate), d.Id()); err != nil {
-		return diag.Errorf("waiting for Phone Number (%s) creation: %s", d.Id(), err)
+		return diagErrorf("waiting for Phone Number (%s) creation: %s", d.Id(), err)
 	}
 
 	return resourcePhoneNumberRead(ctx, d, meta)
@@ -176,11 +177,11 @@ func resourcePhoneNumberRead(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	if err != nil {
-		return diag.Errorf("getting Connect Phone Number (%s): %s", d.Id(), err)
+		return diagErrorf("getting Connect Phone Number (%s): %s", d.Id(), err)
 	}
 
 	if resp == nil || resp.ClaimedPhoneNumberSummary == nil {
-		return diag.Errorf("getting Connect Phone Number (%s): empty response", d.Id())
+		return diagErrorf("getting Connect Phone Number (%s): empty response", d.Id())
 	}
 
 	phoneNumberSummary := resp.ClaimedPhoneNumberSummary
@@ -193,7 +194,7 @@ func resourcePhoneNumberRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("target_arn", phoneNumberSummary.TargetArn)
 
 	if err := d.Set("status", flattenPhoneNumberStatus(phoneNumberSummary.PhoneNumberStatus)); err != nil {
-		return diag.Errorf("setting status: %s", err)
+		return diagErrorf("setting status: %s", err)
 	}
 
 	SetTagsOut(ctx, resp.ClaimedPhoneNumberSummary.Tags)
@@ -206,25 +207,56 @@ func resourcePhoneNumberUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	phoneNumberId := d.Id()
 
-	uuid, err := uuid.GenerateUUID()
-	if err != nil {
-		return diag.Errorf("generating uuid for ClientToken for Phone Number %s: %s", phoneNumberId, err)
-	}
-
+	// Moving the number between an instance and a traffic distribution group is done in place
+	// so that the customer-facing phone number is retained.
 	if d.HasChange("target_arn") {
-		_, err := conn.UpdatePhoneNumberWithContext(ctx, &connect.UpdatePhoneNumberInput{
+		uuid, err := uuid.GenerateUUID()
+		if err != nil {
+			return diagErrorf("generating uuid for ClientToken for Phone Number %s: %s", phoneNumberId, err)
+		}
+
+		_, err = conn.UpdatePhoneNumberWithContext(ctx, &connect.UpdatePhoneNumberInput{
 			ClientToken:   aws.String(uuid),
 			PhoneNumberId: aws.String(phoneNumberId),
 			TargetArn:     aws.String(d.Get("target_arn").(string)),
 		})
 
 		if err != nil {
-			return diag.Errorf("updating Phone Number (%s): %s", d.Id(), err)
+			return diagErrorf("updating Phone Number (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitPhoneNumberUpdated(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id()); err != nil {
+			return diagErrorf("waiting for Phone Number (%s) update: %s", d.Id(), err)
 		}
 	}
 
-	if _, err := waitPhoneNumberUpdated(ctx, conn, d.Timeout(schema.TimeoutCreate), d.Id()); err != nil {
-		return diag.Errorf("waiting for Phone Number (%s) update: %s", d.Id(), err)
+	if d.HasChange("description") {
+		uuid, err := uuid.GenerateUUID()
+		if err != nil {
+			return diagErrorf("generating uuid for ClientToken for Phone Number %s: %s", phoneNumberId, err)
+		}
+
+		input := &connect_sdkv2.UpdatePhoneNumberMetadataInput{
+			ClientToken:   aws_sdkv2.String(uuid),
+			PhoneNumberId: aws_sdkv2.String(phoneNumberId),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.PhoneNumberDescription = aws_sdkv2.String(v.(string))
+		} else {
+			// There is no separate operation to remove the description; an empty description replaces it.
+			input.PhoneNumberDescription = aws_sdkv2.String("")
+		}
+
+		_, err = meta.(*conns.AWSClient).ConnectClient().UpdatePhoneNumberMetadata(ctx, input)
+
+		if err != nil {
+			return diagErrorf("updating Phone Number (%s) metadata: %s", d.Id(), err)
+		}
+
+		if _, err := waitPhoneNumberUpdated(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id()); err != nil {
+			return diagErrorf("waiting for Phone Number (%s) update: %s", d.Id(), err)
+		}
 	}
 
 	return resourcePhoneNumberRead(ctx, d, meta)
@@ -237,7 +269,7 @@ func resourcePhoneNumberDelete(ctx context.Context, d *schema.ResourceData, meta
 
 	uuid, err := uuid.GenerateUUID()
 	if err != nil {
-		return diag.Errorf("generating uuid for ClientToken for Phone Number %s: %s", phoneNumberId, err)
+		return diagErrorf("generating uuid for ClientToken for Phone Number %s: %s", phoneNumberId, err)
 	}
 
 	_, err = conn.ReleasePhoneNumberWithContext(ctx, &connect.ReleasePhoneNumberInput{
@@ -246,11 +278,11 @@ func resourcePhoneNumberDelete(ctx context.Context, d *schema.ResourceData, meta
 	})
 
 	if err != nil {
-		return diag.Errorf("deleting PhoneNumber (%s): %s", d.Id(), err)
+		return diagErrorf("deleting PhoneNumber (%s): %s", d.Id(), err)
 	}
 
 	if _, err := waitPhoneNumberDeleted(ctx, conn, d.Timeout(schema.TimeoutCreate), phoneNumberId); err != nil {
-		return diag.Errorf("waiting for Phone Number (%s) deletion: %s", phoneNumberId, err)
+		return diagErrorf("waiting for Phone Number (%s) deletion: %s", phoneNumberId, err)
 	}
 
 	return nil
@@ -0,0 +1,157 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_connect_phone_number")
+func DataSourcePhoneNumber() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePhoneNumberRead,
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"country_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"phone_number": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"phone_number", "phone_number_id"},
+				ValidateFunc: verify.ValidE164PhoneNumber,
+			},
+			"phone_number_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"phone_number_id", "phone_number"},
+			},
+			"status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"target_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePhoneNumberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	var phoneNumberID string
+
+	if v, ok := d.GetOk("phone_number_id"); ok {
+		phoneNumberID = v.(string)
+	} else if v, ok := d.GetOk("phone_number"); ok {
+		phoneNumber := v.(string)
+		phoneNumberSummary, err := dataSourceGetPhoneNumberSummaryByPhoneNumber(ctx, conn, phoneNumber)
+
+		if err != nil {
+			return diagFromErr(fmt.Errorf("error finding Connect Phone Number Summary by phone_number (%s): %w", phoneNumber, err))
+		}
+
+		if phoneNumberSummary == nil {
+			return diagFromErr(fmt.Errorf("error finding Connect Phone Number Summary by phone_number (%s): not found", phoneNumber))
+		}
+
+		phoneNumberID = aws.StringValue(phoneNumberSummary.PhoneNumberId)
+	}
+
+	phoneNumberSummary, err := FindPhoneNumberByID(ctx, conn, phoneNumberID)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error getting Connect Phone Number (%s): %w", phoneNumberID, err))
+	}
+
+	d.Set("arn", phoneNumberSummary.PhoneNumberArn)
+	d.Set("country_code", phoneNumberSummary.PhoneNumberCountryCode)
+	d.Set("description", phoneNumberSummary.PhoneNumberDescription)
+	d.Set("phone_number", phoneNumberSummary.PhoneNumber)
+	d.Set("phone_number_id", phoneNumberSummary.PhoneNumberId)
+	if err := d.Set("status", flattenPhoneNumberStatus(phoneNumberSummary.PhoneNumberStatus)); err != nil {
+		return diagFromErr(fmt.Errorf("error setting status: %w", err))
+	}
+	d.Set("target_arn", phoneNumberSummary.TargetArn)
+	d.Set("type", phoneNumberSummary.PhoneNumberType)
+
+	if err := d.Set("tags", KeyValueTags(ctx, phoneNumberSummary.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diagFromErr(fmt.Errorf("error setting tags: %s", err))
+	}
+
+	d.SetId(aws.StringValue(phoneNumberSummary.PhoneNumberId))
+
+	return nil
+}
+
+// dataSourceGetPhoneNumberSummaryByPhoneNumber returns the phone number claimed to any instance or
+// traffic distribution group of the account in the current region.
+func dataSourceGetPhoneNumberSummaryByPhoneNumber(ctx context.Context, conn *connect.Connect, phoneNumber string) (*connect.ListPhoneNumbersSummary, error) {
+	var result *connect.ListPhoneNumbersSummary
+
+	input := &connect.ListPhoneNumbersV2Input{
+		MaxResults: aws.Int64(ListPhoneNumbersV2MaxResults),
+	}
+
+	err := conn.ListPhoneNumbersV2PagesWithContext(ctx, input, func(page *connect.ListPhoneNumbersV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ListPhoneNumbersSummaryList {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.PhoneNumber) == phoneNumber {
+				result = v
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
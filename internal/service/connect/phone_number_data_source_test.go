@@ -0,0 +1,56 @@
+package connect_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccPhoneNumberDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_connect_phone_number.test"
+	datasourceName := "data.aws_connect_phone_number.test"
+	datasourceName2 := "data.aws_connect_phone_number.test2"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPhoneNumberDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "country_code", resourceName, "country_code"),
+					resource.TestCheckResourceAttrPair(datasourceName, "phone_number", resourceName, "phone_number"),
+					resource.TestCheckResourceAttrPair(datasourceName, "phone_number_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "status.#", resourceName, "status.#"),
+					resource.TestCheckResourceAttrPair(datasourceName, "target_arn", resourceName, "target_arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "type", resourceName, "type"),
+					resource.TestCheckResourceAttrPair(datasourceName2, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName2, "phone_number", resourceName, "phone_number"),
+					resource.TestCheckResourceAttrPair(datasourceName2, "phone_number_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(datasourceName2, "target_arn", resourceName, "target_arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPhoneNumberDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccPhoneNumberConfig_basic(rName),
+		`
+data "aws_connect_phone_number" "test" {
+  phone_number_id = aws_connect_phone_number.test.id
+}
+
+data "aws_connect_phone_number" "test2" {
+  phone_number = aws_connect_phone_number.test.phone_number
+}
+`)
+}
@@ -8,13 +8,13 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func testAccPhoneNumber_basic(t *testing.T) {
@@ -53,9 +53,10 @@ func testAccPhoneNumber_basic(t *testing.T) {
 
 func testAccPhoneNumber_description(t *testing.T) {
 	ctx := acctest.Context(t)
-	var v connect.DescribePhoneNumberOutput
+	var v, v2, v3 connect.DescribePhoneNumberOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	description := "example description"
+	descriptionUpdated := "example description updated"
 	resourceName := "aws_connect_phone_number.test"
 
 	resource.Test(t, resource.TestCase{
@@ -76,6 +77,22 @@ func testAccPhoneNumber_description(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccPhoneNumberConfig_description(rName, descriptionUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPhoneNumberExists(ctx, resourceName, &v2),
+					testAccCheckPhoneNumberNotRecreated(&v, &v2),
+					resource.TestCheckResourceAttr(resourceName, "description", descriptionUpdated),
+				),
+			},
+			{
+				Config: testAccPhoneNumberConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPhoneNumberExists(ctx, resourceName, &v3),
+					testAccCheckPhoneNumberNotRecreated(&v2, &v3),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+				),
+			},
 		},
 	})
 }
@@ -114,7 +131,7 @@ func testAccPhoneNumber_prefix(t *testing.T) {
 
 func testAccPhoneNumber_targetARN(t *testing.T) {
 	ctx := acctest.Context(t)
-	var v connect.DescribePhoneNumberOutput
+	var v, v2 connect.DescribePhoneNumberOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_connect_phone_number.test"
@@ -140,7 +157,8 @@ func testAccPhoneNumber_targetARN(t *testing.T) {
 			{
 				Config: testAccPhoneNumberConfig_targetARN(rName, rName2, "second"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckPhoneNumberExists(ctx, resourceName, &v),
+					testAccCheckPhoneNumberExists(ctx, resourceName, &v2),
+					testAccCheckPhoneNumberNotRecreated(&v, &v2),
 					resource.TestCheckResourceAttrPair(resourceName, "target_arn", "aws_connect_instance.test2", "arn"),
 				),
 			},
@@ -246,6 +264,20 @@ func testAccCheckPhoneNumberExists(ctx context.Context, resourceName string, fun
 	}
 }
 
+func testAccCheckPhoneNumberNotRecreated(i, j *connect.DescribePhoneNumberOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(i.ClaimedPhoneNumberSummary.PhoneNumber), aws.StringValue(j.ClaimedPhoneNumberSummary.PhoneNumber); before != after {
+			return fmt.Errorf("Connect Phone Number changed from %s to %s", before, after)
+		}
+
+		if before, after := aws.StringValue(i.ClaimedPhoneNumberSummary.PhoneNumberId), aws.StringValue(j.ClaimedPhoneNumberSummary.PhoneNumberId); before != after {
+			return fmt.Errorf("Connect Phone Number recreated: %s to %s", before, after)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckPhoneNumberDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -255,13 +287,9 @@ func testAccCheckPhoneNumberDestroy(ctx context.Context) resource.TestCheckFunc
 
 			conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn()
 
-			params := &connect.DescribePhoneNumberInput{
-				PhoneNumberId: aws.String(rs.Primary.ID),
-			}
-
-			_, err := conn.DescribePhoneNumberWithContext(ctx, params)
+			_, err := tfconnect.FindPhoneNumberByID(ctx, conn, rs.Primary.ID)
 
-			if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+			if tfresource.NotFound(err) {
 				continue
 			}
 
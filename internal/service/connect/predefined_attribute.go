@@ -0,0 +1,270 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	connect_sdkv2 "github.com/aws/aws-sdk-go-v2/service/connect"
+	"github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_connect_predefined_attribute", name="Predefined Attribute")
+func ResourcePredefinedAttribute() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePredefinedAttributeCreate,
+		ReadWithoutTimeout:   resourcePredefinedAttributeRead,
+		UpdateWithoutTimeout: resourcePredefinedAttributeUpdate,
+		DeleteWithoutTimeout: resourcePredefinedAttributeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: defaultInstanceIDCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"last_modified_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"values": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 128,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+			},
+		},
+	}
+}
+
+func resourcePredefinedAttributeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).ConnectClient()
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	input := &connect_sdkv2.CreatePredefinedAttributeInput{
+		InstanceId: aws_sdkv2.String(instanceID),
+		Name:       aws_sdkv2.String(name),
+		Values: &types.PredefinedAttributeValuesMemberStringList{
+			Value: flex.ExpandStringValueSet(d.Get("values").(*schema.Set)),
+		},
+	}
+
+	log.Printf("[DEBUG] Creating Connect Predefined Attribute (%s) for Connect Instance (%s)", name, instanceID)
+	_, err := client.CreatePredefinedAttribute(ctx, input)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error creating Connect Predefined Attribute (%s): %w", name, err))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, name))
+
+	return resourcePredefinedAttributeRead(ctx, d, meta)
+}
+
+func resourcePredefinedAttributeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).ConnectClient()
+
+	instanceID, name, err := PredefinedAttributeParseID(d.Id())
+
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	attribute, err := FindPredefinedAttributeByName(ctx, client, instanceID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Predefined Attribute (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error getting Connect Predefined Attribute (%s): %w", d.Id(), err))
+	}
+
+	d.Set("instance_id", instanceID)
+	d.Set("last_modified_region", attribute.LastModifiedRegion)
+	if attribute.LastModifiedTime != nil {
+		d.Set("last_modified_time", attribute.LastModifiedTime.Format(time.RFC3339))
+	}
+	d.Set("name", attribute.Name)
+
+	if v, ok := attribute.Values.(*types.PredefinedAttributeValuesMemberStringList); ok {
+		d.Set("values", v.Value)
+	}
+
+	return nil
+}
+
+func resourcePredefinedAttributeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).ConnectClient()
+
+	instanceID, name, err := PredefinedAttributeParseID(d.Id())
+
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	if d.HasChange("values") {
+		input := &connect_sdkv2.UpdatePredefinedAttributeInput{
+			InstanceId: aws_sdkv2.String(instanceID),
+			Name:       aws_sdkv2.String(name),
+			Values: &types.PredefinedAttributeValuesMemberStringList{
+				Value: flex.ExpandStringValueSet(d.Get("values").(*schema.Set)),
+			},
+		}
+
+		_, err = client.UpdatePredefinedAttribute(ctx, input)
+
+		if err != nil {
+			return diagFromErr(fmt.Errorf("error updating Connect Predefined Attribute (%s): %w", d.Id(), err))
+		}
+	}
+
+	return resourcePredefinedAttributeRead(ctx, d, meta)
+}
+
+func resourcePredefinedAttributeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).ConnectClient()
+
+	instanceID, name, err := PredefinedAttributeParseID(d.Id())
+
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	// Amazon Connect refuses to delete an attribute that agents still hold as a proficiency,
+	// so check up front and say which users need to be updated first.
+	users, err := findUserNamesByProficiencyName(ctx, client, instanceID, name)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error searching for Connect Users with Predefined Attribute (%s) proficiencies: %w", d.Id(), err))
+	}
+
+	if len(users) > 0 {
+		return diagErrorf("deleting Connect Predefined Attribute (%s): still assigned as a proficiency to %d user(s) (%s); remove the proficiency from these users first", d.Id(), len(users), strings.Join(users, ", "))
+	}
+
+	log.Printf("[DEBUG] Deleting Connect Predefined Attribute: %s", d.Id())
+	_, err = client.DeletePredefinedAttribute(ctx, &connect_sdkv2.DeletePredefinedAttributeInput{
+		InstanceId: aws_sdkv2.String(instanceID),
+		Name:       aws_sdkv2.String(name),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error deleting Connect Predefined Attribute (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func PredefinedAttributeParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected instanceID:name", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func FindPredefinedAttributeByName(ctx context.Context, client *connect_sdkv2.Client, instanceID, name string) (*types.PredefinedAttribute, error) {
+	input := &connect_sdkv2.DescribePredefinedAttributeInput{
+		InstanceId: aws_sdkv2.String(instanceID),
+		Name:       aws_sdkv2.String(name),
+	}
+
+	output, err := client.DescribePredefinedAttribute(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PredefinedAttribute == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.PredefinedAttribute, nil
+}
+
+func findUserNamesByProficiencyName(ctx context.Context, client *connect_sdkv2.Client, instanceID, name string) ([]string, error) {
+	var result []string
+
+	input := &connect_sdkv2.SearchUsersInput{
+		InstanceId: aws_sdkv2.String(instanceID),
+		SearchCriteria: &types.UserSearchCriteria{
+			ListCondition: &types.ListCondition{
+				Conditions: []types.Condition{
+					{
+						StringCondition: &types.StringCondition{
+							ComparisonType: types.StringComparisonTypeExact,
+							FieldName:      aws_sdkv2.String("name"),
+							Value:          aws_sdkv2.String(name),
+						},
+					},
+				},
+				TargetListType: types.TargetListTypeProficiencies,
+			},
+		},
+	}
+
+	pages := connect_sdkv2.NewSearchUsersPaginator(client, input)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, user := range page.Users {
+			result = append(result, aws_sdkv2.ToString(user.Username))
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,166 @@
+package connect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccPredefinedAttribute_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.PredefinedAttribute
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_predefined_attribute.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPredefinedAttributeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPredefinedAttributeConfig_basic(rName, rName2, `"English", "French"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPredefinedAttributeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified_time"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+					resource.TestCheckResourceAttr(resourceName, "values.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "English"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "French"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPredefinedAttributeConfig_basic(rName, rName2, `"English", "German", "Spanish"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPredefinedAttributeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "values.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "English"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "German"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "Spanish"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPredefinedAttribute_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.PredefinedAttribute
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_predefined_attribute.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPredefinedAttributeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPredefinedAttributeConfig_basic(rName, rName2, `"English"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPredefinedAttributeExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfconnect.ResourcePredefinedAttribute(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPredefinedAttributeExists(ctx context.Context, resourceName string, v *types.PredefinedAttribute) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Connect Predefined Attribute not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Connect Predefined Attribute ID not set")
+		}
+
+		instanceID, name, err := tfconnect.PredefinedAttributeParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		client := acctest.Provider.Meta().(*conns.AWSClient).ConnectClient()
+
+		output, err := tfconnect.FindPredefinedAttributeByName(ctx, client, instanceID, name)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPredefinedAttributeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_connect_predefined_attribute" {
+				continue
+			}
+
+			client := acctest.Provider.Meta().(*conns.AWSClient).ConnectClient()
+
+			instanceID, name, err := tfconnect.PredefinedAttributeParseID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfconnect.FindPredefinedAttributeByName(ctx, client, instanceID, name)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Predefined Attribute %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccPredefinedAttributeConfig_basic(rName, rName2, values string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+
+resource "aws_connect_predefined_attribute" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[2]q
+  values      = [%[3]s]
+}
+`, rName, rName2, values)
+}
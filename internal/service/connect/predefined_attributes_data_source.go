@@ -0,0 +1,117 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	connect_sdkv2 "github.com/aws/aws-sdk-go-v2/service/connect"
+	"github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// SearchPredefinedAttributes is used instead of ListPredefinedAttributes as it returns the values too.
+
+// @SDKDataSource("aws_connect_predefined_attributes")
+func DataSourcePredefinedAttributes() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePredefinedAttributesRead,
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"predefined_attributes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"last_modified_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePredefinedAttributesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setDefaultInstanceID(d, meta); err != nil {
+		return diagFromErr(err)
+	}
+
+	client := meta.(*conns.AWSClient).ConnectClient()
+
+	instanceID := d.Get("instance_id").(string)
+
+	input := &connect_sdkv2.SearchPredefinedAttributesInput{
+		InstanceId: aws_sdkv2.String(instanceID),
+		MaxResults: aws_sdkv2.Int32(SearchPredefinedAttributesMaxResults),
+	}
+
+	var attributes []types.PredefinedAttribute
+
+	pages := connect_sdkv2.NewSearchPredefinedAttributesPaginator(client, input)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return diagFromErr(fmt.Errorf("error listing Connect Predefined Attributes for Connect Instance (%s): %w", instanceID, err))
+		}
+
+		attributes = append(attributes, page.PredefinedAttributes...)
+	}
+
+	if err := d.Set("predefined_attributes", flattenPredefinedAttributes(attributes)); err != nil {
+		return diagFromErr(fmt.Errorf("error setting predefined_attributes: %w", err))
+	}
+
+	d.SetId(instanceID)
+
+	return nil
+}
+
+func flattenPredefinedAttributes(apiObjects []types.PredefinedAttribute) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"last_modified_region": aws_sdkv2.ToString(apiObject.LastModifiedRegion),
+			"name":                 aws_sdkv2.ToString(apiObject.Name),
+		}
+
+		if v := apiObject.LastModifiedTime; v != nil {
+			tfMap["last_modified_time"] = v.Format(time.RFC3339)
+		}
+
+		if v, ok := apiObject.Values.(*types.PredefinedAttributeValuesMemberStringList); ok {
+			tfMap["values"] = v.Value
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,49 @@
+package connect_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccPredefinedAttributesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	datasourceName := "data.aws_connect_predefined_attributes.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPredefinedAttributesDataSourceConfig_basic(rName, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttr(datasourceName, "predefined_attributes.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "predefined_attributes.0.name", rName2),
+					resource.TestCheckResourceAttrSet(datasourceName, "predefined_attributes.0.last_modified_time"),
+					resource.TestCheckResourceAttr(datasourceName, "predefined_attributes.0.values.#", "2"),
+					resource.TestCheckTypeSetElemAttr(datasourceName, "predefined_attributes.0.values.*", "English"),
+					resource.TestCheckTypeSetElemAttr(datasourceName, "predefined_attributes.0.values.*", "French"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPredefinedAttributesDataSourceConfig_basic(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccPredefinedAttributeConfig_basic(rName, rName2, `"English", "French"`),
+		`
+data "aws_connect_predefined_attributes" "test" {
+  instance_id = aws_connect_instance.test.id
+
+  depends_on = [aws_connect_predefined_attribute.test]
+}
+`)
+}
@@ -0,0 +1,429 @@
+package connect
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	connect_sdkv2 "github.com/aws/aws-sdk-go-v2/service/connect"
+	"github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+// @SDKResource("aws_connect_prompt", name="Prompt")
+// @Tags(identifierAttribute="arn")
+func ResourcePrompt() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePromptCreate,
+		ReadWithoutTimeout:   resourcePromptRead,
+		UpdateWithoutTimeout: resourcePromptUpdate,
+		DeleteWithoutTimeout: resourcePromptDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customdiff.Sequence(
+			defaultInstanceIDCustomizeDiff,
+			resourcePromptContentHashCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 250),
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			"prompt_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// Either an S3 URI or a pre-signed URL, e.g. one returned by GetPromptFile for another prompt.
+			// If source_file is set, it must be the S3 URI that the file is uploaded to, and defaults to
+			// the instance's call recordings bucket.
+			"s3_uri": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(https|s3)\:\/\/.+`), "must begin with s3:// or https://"),
+				AtLeastOneOf: []string{"s3_uri", "source_file"},
+			},
+			"source_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"s3_uri", "source_file"},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourcePromptCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).ConnectClient()
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	s3URI := d.Get("s3_uri").(string)
+
+	if v, ok := d.GetOk("source_file"); ok {
+		if s3URI == "" {
+			var err error
+
+			if s3URI, err = defaultPromptS3URI(ctx, meta.(*conns.AWSClient).ConnectConn(), instanceID, name, v.(string)); err != nil {
+				return diagErrorf("creating Connect Prompt (%s): %s", name, err)
+			}
+		}
+
+		if err := uploadPromptSourceFile(ctx, meta.(*conns.AWSClient).S3Conn(), v.(string), s3URI); err != nil {
+			return diagErrorf("creating Connect Prompt (%s): %s", name, err)
+		}
+
+		if err := setPromptContentHash(d, v.(string)); err != nil {
+			return diagErrorf("creating Connect Prompt (%s): %s", name, err)
+		}
+	}
+
+	input := &connect_sdkv2.CreatePromptInput{
+		InstanceId: aws_sdkv2.String(instanceID),
+		Name:       aws_sdkv2.String(name),
+		S3Uri:      aws_sdkv2.String(s3URI),
+		Tags:       aws_sdkv2.ToStringMap(GetTagsIn(ctx)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws_sdkv2.String(v.(string))
+	}
+
+	output, err := client.CreatePrompt(ctx, input)
+
+	if err != nil {
+		return diagErrorf("creating Connect Prompt (%s): %s", name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws_sdkv2.ToString(output.PromptId)))
+	d.Set("s3_uri", s3URI)
+
+	return resourcePromptRead(ctx, d, meta)
+}
+
+func resourcePromptRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).ConnectClient()
+
+	instanceID, promptID, err := PromptParseID(d.Id())
+
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	prompt, err := FindPromptByID(ctx, client, instanceID, promptID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Prompt (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diagErrorf("reading Connect Prompt (%s): %s", d.Id(), err)
+	}
+
+	// The location of the audio file is not returned, so s3_uri and source_file are kept as configured.
+	d.Set("arn", prompt.PromptARN)
+	d.Set("description", prompt.Description)
+	d.Set("instance_id", instanceID)
+	d.Set("name", prompt.Name)
+	d.Set("prompt_id", prompt.PromptId)
+
+	SetTagsOut(ctx, aws_sdkv2.StringMap(prompt.Tags))
+
+	return nil
+}
+
+func resourcePromptUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).ConnectClient()
+
+	instanceID, promptID, err := PromptParseID(d.Id())
+
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	if d.HasChanges("content_hash", "description", "name", "s3_uri", "source_file") {
+		input := &connect_sdkv2.UpdatePromptInput{
+			InstanceId: aws_sdkv2.String(instanceID),
+			Name:       aws_sdkv2.String(d.Get("name").(string)),
+			PromptId:   aws_sdkv2.String(promptID),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws_sdkv2.String(v.(string))
+		}
+
+		if d.HasChanges("content_hash", "s3_uri", "source_file") {
+			s3URI := d.Get("s3_uri").(string)
+
+			if v, ok := d.GetOk("source_file"); ok {
+				if err := uploadPromptSourceFile(ctx, meta.(*conns.AWSClient).S3Conn(), v.(string), s3URI); err != nil {
+					return diagErrorf("updating Connect Prompt (%s): %s", d.Id(), err)
+				}
+
+				if err := setPromptContentHash(d, v.(string)); err != nil {
+					return diagErrorf("updating Connect Prompt (%s): %s", d.Id(), err)
+				}
+			}
+
+			input.S3Uri = aws_sdkv2.String(s3URI)
+		}
+
+		_, err := client.UpdatePrompt(ctx, input)
+
+		if err != nil {
+			return diagErrorf("updating Connect Prompt (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourcePromptRead(ctx, d, meta)
+}
+
+func resourcePromptDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).ConnectClient()
+
+	instanceID, promptID, err := PromptParseID(d.Id())
+
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Connect Prompt: %s", d.Id())
+	_, err = client.DeletePrompt(ctx, &connect_sdkv2.DeletePromptInput{
+		InstanceId: aws_sdkv2.String(instanceID),
+		PromptId:   aws_sdkv2.String(promptID),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diagErrorf("deleting Connect Prompt (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindPromptByID(ctx context.Context, client *connect_sdkv2.Client, instanceID, promptID string) (*types.Prompt, error) {
+	input := &connect_sdkv2.DescribePromptInput{
+		InstanceId: aws_sdkv2.String(instanceID),
+		PromptId:   aws_sdkv2.String(promptID),
+	}
+
+	output, err := client.DescribePrompt(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Prompt == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Prompt, nil
+}
+
+func PromptParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected instanceID:promptID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// resourcePromptContentHashCustomizeDiff sets content_hash to the hash of source_file, so that changes to the
+// file's contents update the prompt.
+func resourcePromptContentHashCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("source_file") {
+		return diff.SetNewComputed("content_hash")
+	}
+
+	var hash string
+
+	if v := diff.Get("source_file").(string); v != "" {
+		var err error
+
+		hash, err = promptSourceFileHash(v)
+
+		// the file may not exist yet, e.g. when it is generated during apply
+		if errors.Is(err, fs.ErrNotExist) {
+			return diff.SetNewComputed("content_hash")
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if diff.Get("content_hash").(string) != hash {
+		return diff.SetNew("content_hash", hash)
+	}
+
+	return nil
+}
+
+func setPromptContentHash(d *schema.ResourceData, filename string) error {
+	hash, err := promptSourceFileHash(filename)
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("content_hash", hash)
+
+	return nil
+}
+
+// promptSourceFileHash returns the base64-encoded SHA256 hash of the local audio file, as filebase64sha256 does.
+func promptSourceFileHash(filename string) (string, error) {
+	filename, err := homedir.Expand(filename)
+	if err != nil {
+		return "", err
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return "", fmt.Errorf("unable to open %q: %w", filename, err)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("reading %q: %w", filename, err)
+	}
+
+	return base64.StdEncoding.EncodeToString(hash.Sum(nil)), nil
+}
+
+// defaultPromptS3URI returns the S3 URI that a source_file is uploaded to when s3_uri is not set:
+// the prompts/ prefix of the S3 bucket that the instance stores call recordings in.
+func defaultPromptS3URI(ctx context.Context, conn *connect.Connect, instanceID, name, filename string) (string, error) {
+	var s3Config *connect.S3Config
+
+	input := &connect.ListInstanceStorageConfigsInput{
+		InstanceId:   aws.String(instanceID),
+		ResourceType: aws.String(connect.InstanceStorageResourceTypeCallRecordings),
+	}
+
+	err := conn.ListInstanceStorageConfigsPagesWithContext(ctx, input, func(page *connect.ListInstanceStorageConfigsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.StorageConfigs {
+			if v != nil && v.S3Config != nil {
+				s3Config = v.S3Config
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("listing Connect Instance (%s) storage configs: %w", instanceID, err)
+	}
+
+	if s3Config == nil {
+		return "", fmt.Errorf("s3_uri must be set, as Connect Instance (%s) does not store call recordings in S3", instanceID)
+	}
+
+	key := path.Join(aws.StringValue(s3Config.BucketPrefix), "prompts", name+filepath.Ext(filename))
+
+	return fmt.Sprintf("s3://%s/%s", aws.StringValue(s3Config.BucketName), key), nil
+}
+
+// uploadPromptSourceFile uploads the local audio file to the S3 URI that the prompt is created from.
+func uploadPromptSourceFile(ctx context.Context, conn *s3.S3, filename, s3URI string) error {
+	u, err := url.Parse(s3URI)
+
+	if err != nil || u.Scheme != "s3" || u.Host == "" || strings.TrimPrefix(u.Path, "/") == "" {
+		return fmt.Errorf("s3_uri (%s) must be an S3 URI of the form s3://bucket/key when source_file is set", s3URI)
+	}
+
+	filename, err = homedir.Expand(filename)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return fmt.Errorf("unable to open %q: %w", filename, err)
+	}
+	defer file.Close()
+
+	_, err = conn.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Body:   file,
+		Bucket: aws.String(u.Host),
+		Key:    aws.String(strings.TrimPrefix(u.Path, "/")),
+	})
+
+	if err != nil {
+		return fmt.Errorf("uploading %q to %s: %w", filename, s3URI, err)
+	}
+
+	return nil
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 // @SDKDataSource("aws_connect_prompt")
@@ -20,44 +22,78 @@ func DataSourcePrompt() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"instance_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "prompt_id"},
 			},
 			"prompt_id": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"prompt_id", "name"},
 			},
+			"tags": tftags.TagsSchemaComputed(),
 		},
 	}
 }
 
 func dataSourcePromptRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setDefaultInstanceID(d, meta); err != nil {
+		return diagFromErr(err)
+	}
+
 	conn := meta.(*conns.AWSClient).ConnectConn()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	instanceID := d.Get("instance_id").(string)
-	name := d.Get("name").(string)
 
-	promptSummary, err := dataSourceGetPromptSummaryByName(ctx, conn, instanceID, name)
+	var promptID string
 
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error finding Connect Prompt Summary by name (%s): %w", name, err))
+	if v, ok := d.GetOk("prompt_id"); ok {
+		promptID = v.(string)
+	} else if v, ok := d.GetOk("name"); ok {
+		name := v.(string)
+		promptSummary, err := dataSourceGetPromptSummaryByName(ctx, conn, instanceID, name)
+
+		if err != nil {
+			return diagFromErr(fmt.Errorf("error finding Connect Prompt Summary by name (%s): %w", name, err))
+		}
+
+		if promptSummary == nil {
+			return diagFromErr(fmt.Errorf("error finding Connect Prompt Summary by name (%s): not found", name))
+		}
+
+		promptID = aws.StringValue(promptSummary.Id)
 	}
 
-	if promptSummary == nil {
-		return diag.FromErr(fmt.Errorf("error finding Connect Prompt Summary by name (%s): not found", name))
+	prompt, err := FindPromptByID(ctx, meta.(*conns.AWSClient).ConnectClient(), instanceID, promptID)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error getting Connect Prompt (%s): %w", promptID, err))
 	}
 
-	d.Set("arn", promptSummary.Arn)
+	d.Set("arn", prompt.PromptARN)
+	d.Set("description", prompt.Description)
 	d.Set("instance_id", instanceID)
-	d.Set("prompt_id", promptSummary.Id)
-	d.Set("name", promptSummary.Name)
+	d.Set("name", prompt.Name)
+	d.Set("prompt_id", prompt.PromptId)
+
+	if err := d.Set("tags", KeyValueTags(ctx, aws_sdkv2.StringMap(prompt.Tags)).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diagFromErr(fmt.Errorf("error setting tags: %s", err))
+	}
 
-	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(promptSummary.Id)))
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, promptID))
 
 	return nil
 }
@@ -33,6 +33,32 @@ func testAccPromptDataSource_name(t *testing.T) {
 	})
 }
 
+func testAccPromptDataSource_promptID(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "data.aws_connect_prompt.by_name"
+	datasourceName := "data.aws_connect_prompt.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPromptDataSourceConfig_promptID(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(datasourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttr(datasourceName, "name", "Beep.wav"),
+					resource.TestCheckResourceAttrPair(datasourceName, "prompt_id", resourceName, "prompt_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "tags.%", resourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
 func testAccPromptBaseDataSourceConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_connect_instance" "test" {
@@ -54,3 +80,19 @@ data "aws_connect_prompt" "test" {
 }
 `)
 }
+
+func testAccPromptDataSourceConfig_promptID(rName string) string {
+	return acctest.ConfigCompose(
+		testAccPromptBaseDataSourceConfig(rName),
+		`
+data "aws_connect_prompt" "by_name" {
+  instance_id = aws_connect_instance.test.id
+  name        = "Beep.wav"
+}
+
+data "aws_connect_prompt" "test" {
+  instance_id = aws_connect_instance.test.id
+  prompt_id   = data.aws_connect_prompt.by_name.prompt_id
+}
+`)
+}
@@ -0,0 +1,242 @@
+package connect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccPrompt_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.Prompt
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_connect_prompt.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPromptDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPromptConfig_sourceFile(rName, "Created", "test-fixtures/connect_prompt.wav"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPromptExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Created"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "prompt_id"),
+					resource.TestCheckResourceAttr(resourceName, "content_hash", "t/z4q54DpkUQZ+L6QR17kT/CC04SmVKapnb4rUOPKbY="),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"content_hash",
+					"s3_uri",
+					"source_file",
+				},
+			},
+			{
+				Config: testAccPromptConfig_sourceFile(rName, "Updated", "test-fixtures/connect_prompt_updated.wav"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPromptExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated"),
+					resource.TestCheckResourceAttr(resourceName, "content_hash", "uWLfQ55NYQdEACgmpRVzfD26sxf0VLKIwzs7O5VyHD4="),
+				),
+			},
+		},
+	})
+}
+
+func testAccPrompt_sourceFileOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.Prompt
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_connect_prompt.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPromptDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPromptConfig_sourceFileOnly(rName, "test-fixtures/connect_prompt.wav"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPromptExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "content_hash", "t/z4q54DpkUQZ+L6QR17kT/CC04SmVKapnb4rUOPKbY="),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "s3_uri", fmt.Sprintf("s3://%s/tf-test-Call-Recordings/prompts/%s.wav", rName, rName)),
+				),
+			},
+			{
+				Config: testAccPromptConfig_sourceFileOnly(rName, "test-fixtures/connect_prompt_updated.wav"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPromptExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "content_hash", "uWLfQ55NYQdEACgmpRVzfD26sxf0VLKIwzs7O5VyHD4="),
+				),
+			},
+		},
+	})
+}
+
+func testAccPrompt_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.Prompt
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_connect_prompt.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPromptDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPromptConfig_sourceFile(rName, "Disappear", "test-fixtures/connect_prompt.wav"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPromptExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfconnect.ResourcePrompt(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPromptExists(ctx context.Context, resourceName string, v *types.Prompt) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Connect Prompt not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Connect Prompt ID not set")
+		}
+
+		instanceID, promptID, err := tfconnect.PromptParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		client := acctest.Provider.Meta().(*conns.AWSClient).ConnectClient()
+
+		output, err := tfconnect.FindPromptByID(ctx, client, instanceID, promptID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPromptDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_connect_prompt" {
+				continue
+			}
+
+			instanceID, promptID, err := tfconnect.PromptParseID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			client := acctest.Provider.Meta().(*conns.AWSClient).ConnectClient()
+
+			_, err = tfconnect.FindPromptByID(ctx, client, instanceID, promptID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Prompt %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccPromptConfig_sourceFile(rName, label, filepath string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_connect_prompt" "test" {
+  instance_id  = aws_connect_instance.test.id
+  name         = %[1]q
+  description  = %[2]q
+  s3_uri      = "s3://${aws_s3_bucket.test.bucket}/prompt.wav"
+  source_file = %[3]q
+}
+`, rName, label, filepath)
+}
+
+func testAccPromptConfig_sourceFileOnly(rName, filepath string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_connect_instance_storage_config" "test" {
+  instance_id   = aws_connect_instance.test.id
+  resource_type = "CALL_RECORDINGS"
+
+  storage_config {
+    s3_config {
+      bucket_name   = aws_s3_bucket.test.id
+      bucket_prefix = "tf-test-Call-Recordings"
+    }
+    storage_type = "S3"
+  }
+}
+
+resource "aws_connect_prompt" "test" {
+  instance_id = aws_connect_instance_storage_config.test.instance_id
+  name        = %[1]q
+  source_file = %[2]q
+}
+`, rName, filepath)
+}
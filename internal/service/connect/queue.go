@@ -4,19 +4,34 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	connect_sdkv2 "github.com/aws/aws-sdk-go-v2/service/connect"
+	"github.com/aws/aws-sdk-go-v2/service/connect/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/exp/maps"
+)
+
+const (
+	AssociateQueueQuickConnectsMaxItems    = 50
+	DisassociateQueueQuickConnectsMaxItems = 50
+	CreateQueueQuickConnectsMaxItems       = 50
 )
 
 // @SDKResource("aws_connect_queue", name="Queue")
@@ -26,15 +41,25 @@ func ResourceQueue() *schema.Resource {
 		CreateWithoutTimeout: resourceQueueCreate,
 		ReadWithoutTimeout:   resourceQueueRead,
 		UpdateWithoutTimeout: resourceQueueUpdate,
-		// Queues do not support deletion today. NoOp the Delete method.
-		// Users can rename their queues manually if they want.
-		DeleteWithoutTimeout: schema.NoopContext,
+		DeleteWithoutTimeout: resourceQueueDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// force_detach is not returned by the API.
+				d.Set("force_detach", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(queueDeletedTimeout),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			defaultInstanceIDCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -46,13 +71,19 @@ func ResourceQueue() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 250),
 			},
+			"force_detach": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"hours_of_operation_id": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"instance_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(1, 100),
 			},
 			"max_contacts": {
@@ -66,21 +97,18 @@ func ResourceQueue() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 127),
 			},
 			"outbound_caller_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     queueOutboundCallerConfigSchema(),
+			},
+			"outbound_email_config": {
 				Type:     schema.TypeList,
 				Optional: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"outbound_caller_id_name": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringLenBetween(1, 255),
-						},
-						"outbound_caller_id_number_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"outbound_flow_id": {
+						"outbound_email_address_id": {
 							Type:         schema.TypeString,
 							Optional:     true,
 							ValidateFunc: validation.StringLenBetween(1, 500),
@@ -116,6 +144,7 @@ func resourceQueueCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	instanceID := d.Get("instance_id").(string)
 	name := d.Get("name").(string)
+
 	input := &connect.CreateQueueInput{
 		InstanceId: aws.String(instanceID),
 		Name:       aws.String(name),
@@ -135,26 +164,59 @@ func resourceQueueCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	if v, ok := d.GetOk("outbound_caller_config"); ok {
-		input.OutboundCallerConfig = expandOutboundCallerConfig(v.([]interface{}))
+		outboundCallerConfig, err := expandOutboundCallerConfig(v.([]interface{}))
+
+		if err != nil {
+			return diagFromErr(fmt.Errorf("expanding outbound_caller_config: %w", err))
+		}
+
+		input.OutboundCallerConfig = outboundCallerConfig
 	}
 
-	if v, ok := d.GetOk("quick_connect_ids"); ok && v.(*schema.Set).Len() > 0 {
+	if v, ok := d.GetOk("quick_connect_ids"); ok && v.(*schema.Set).Len() > 0 && v.(*schema.Set).Len() <= CreateQueueQuickConnectsMaxItems {
 		input.QuickConnectIds = flex.ExpandStringSet(v.(*schema.Set))
 	}
 
 	log.Printf("[DEBUG] Creating Connect Queue %s", input)
-	output, err := conn.CreateQueueWithContext(ctx, input)
+	output, err := withInstanceLock(instanceID, func() (*connect.CreateQueueOutput, error) {
+		return conn.CreateQueueWithContext(ctx, input)
+	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating Connect Queue (%s): %w", name, err))
+		return diagFromErr(fmt.Errorf("error creating Connect Queue (%s): %w", name, err))
 	}
 
 	if output == nil {
-		return diag.FromErr(fmt.Errorf("error creating Connect Queue (%s): empty output", name))
+		return diagFromErr(fmt.Errorf("error creating Connect Queue (%s): empty output", name))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(output.QueueId)))
 
+	// call the batched association API if the number of quick connects to associate with the queue is > CreateQueueQuickConnectsMaxItems
+	if v, ok := d.GetOk("quick_connect_ids"); ok && v.(*schema.Set).Len() > CreateQueueQuickConnectsMaxItems {
+		err = updateQueueQuickConnectIDs(ctx, conn, instanceID, aws.StringValue(output.QueueId), flex.ExpandStringSet(v.(*schema.Set)), nil)
+
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	if v, ok := d.GetOk("outbound_email_config"); ok {
+		input := &connect_sdkv2.UpdateQueueOutboundEmailConfigInput{
+			InstanceId:          aws_sdkv2.String(instanceID),
+			OutboundEmailConfig: expandOutboundEmailConfig(v.([]interface{})),
+			QueueId:             output.QueueId,
+		}
+
+		_, err = withInstanceLock(instanceID, func() (*connect_sdkv2.UpdateQueueOutboundEmailConfigOutput, error) {
+			return meta.(*conns.AWSClient).ConnectClient().UpdateQueueOutboundEmailConfig(ctx, input)
+		})
+
+		if err != nil {
+			return diagFromErr(fmt.Errorf("setting Connect Queue (%s) Outbound Email Config: %w", d.Id(), err))
+		}
+	}
+
 	return resourceQueueRead(ctx, d, meta)
 }
 
@@ -164,7 +226,7 @@ func resourceQueueRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	instanceID, queueID, err := QueueParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	resp, err := conn.DescribeQueueWithContext(ctx, &connect.DescribeQueueInput{
@@ -179,15 +241,21 @@ func resourceQueueRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Queue (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error getting Connect Queue (%s): %w", d.Id(), err))
 	}
 
 	if resp == nil || resp.Queue == nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Queue (%s): empty response", d.Id()))
+		return diagFromErr(fmt.Errorf("error getting Connect Queue (%s): empty response", d.Id()))
+	}
+
+	outboundCallerConfig, err := flattenOutboundCallerConfig(resp.Queue.OutboundCallerConfig)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("flattening outbound_caller_config: %w", err))
 	}
 
-	if err := d.Set("outbound_caller_config", flattenOutboundCallerConfig(resp.Queue.OutboundCallerConfig)); err != nil {
-		return diag.FromErr(err)
+	if err := d.Set("outbound_caller_config", outboundCallerConfig); err != nil {
+		return diagFromErr(err)
 	}
 
 	d.Set("arn", resp.Queue.QueueArn)
@@ -203,11 +271,21 @@ func resourceQueueRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	quickConnectIds, err := getQueueQuickConnectIDs(ctx, conn, instanceID, queueID)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error finding Connect Queue Quick Connect ID for Queue (%s): %w", queueID, err))
+		return diagFromErr(fmt.Errorf("error finding Connect Queue Quick Connect ID for Queue (%s): %w", queueID, err))
 	}
 
 	d.Set("quick_connect_ids", aws.StringValueSlice(quickConnectIds))
 
+	outboundEmailConfig, err := findQueueOutboundEmailConfig(ctx, meta.(*conns.AWSClient).ConnectClient(), instanceID, queueID)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error finding Connect Queue Outbound Email Config for Queue (%s): %w", queueID, err))
+	}
+
+	if err := d.Set("outbound_email_config", flattenOutboundEmailConfig(outboundEmailConfig)); err != nil {
+		return diagFromErr(err)
+	}
+
 	SetTagsOut(ctx, resp.Queue.Tags)
 
 	return nil
@@ -219,14 +297,15 @@ func resourceQueueUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	instanceID, queueID, err := QueueParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
-	// Queue has 6 update APIs
+	// Queue has 7 update APIs
 	// UpdateQueueHoursOfOperationWithContext: Updates the hours_of_operation_id of a queue.
 	// UpdateQueueMaxContactsWithContext: Updates the max_contacts of a queue.
 	// UpdateQueueNameWithContext: Updates the name and description of a queue.
 	// UpdateQueueOutboundCallerConfigWithContext: Updates the outbound_caller_config of a queue.
+	// UpdateQueueOutboundEmailConfig: Updates the outbound_email_config of a queue.
 	// UpdateQueueStatusWithContext: Updates the status of a queue. Valid Values: ENABLED | DISABLED
 	// AssociateQueueQuickConnectsWithContext: Associates a set of quick connects with a queue. There is also DisassociateQueueQuickConnectsWithContext
 
@@ -237,10 +316,12 @@ func resourceQueueUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			QueueId:            aws.String(queueID),
 			HoursOfOperationId: aws.String(d.Get("hours_of_operation_id").(string)),
 		}
-		_, err = conn.UpdateQueueHoursOfOperationWithContext(ctx, input)
+		_, err = withInstanceLock(instanceID, func() (*connect.UpdateQueueHoursOfOperationOutput, error) {
+			return conn.UpdateQueueHoursOfOperationWithContext(ctx, input)
+		})
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("updating Queue Hours of Operation (%s): %w", d.Id(), err))
+			return diagFromErr(fmt.Errorf("updating Queue Hours of Operation (%s): %w", d.Id(), err))
 		}
 	}
 
@@ -251,10 +332,12 @@ func resourceQueueUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			QueueId:     aws.String(queueID),
 			MaxContacts: aws.Int64(int64(d.Get("max_contacts").(int))),
 		}
-		_, err = conn.UpdateQueueMaxContactsWithContext(ctx, input)
+		_, err = withInstanceLock(instanceID, func() (*connect.UpdateQueueMaxContactsOutput, error) {
+			return conn.UpdateQueueMaxContactsWithContext(ctx, input)
+		})
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("updating Queue Max Contacts (%s): %w", d.Id(), err))
+			return diagFromErr(fmt.Errorf("updating Queue Max Contacts (%s): %w", d.Id(), err))
 		}
 	}
 
@@ -266,24 +349,55 @@ func resourceQueueUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			Name:        aws.String(d.Get("name").(string)),
 			Description: aws.String(d.Get("description").(string)),
 		}
-		_, err = conn.UpdateQueueNameWithContext(ctx, input)
+		_, err = withInstanceLock(instanceID, func() (*connect.UpdateQueueNameOutput, error) {
+			return conn.UpdateQueueNameWithContext(ctx, input)
+		})
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("updating Queue Name and/or Description (%s): %w", d.Id(), err))
+			return diagFromErr(fmt.Errorf("updating Queue Name and/or Description (%s): %w", d.Id(), err))
 		}
 	}
 
 	// updates to outbound_caller_config
 	if d.HasChange("outbound_caller_config") {
+		outboundCallerConfig, err := expandOutboundCallerConfig(d.Get("outbound_caller_config").([]interface{}))
+
+		if err != nil {
+			return diagFromErr(fmt.Errorf("expanding outbound_caller_config: %w", err))
+		}
+
 		input := &connect.UpdateQueueOutboundCallerConfigInput{
 			InstanceId:           aws.String(instanceID),
 			QueueId:              aws.String(queueID),
-			OutboundCallerConfig: expandOutboundCallerConfig(d.Get("outbound_caller_config").([]interface{})),
+			OutboundCallerConfig: outboundCallerConfig,
+		}
+		_, err = withInstanceLock(instanceID, func() (*connect.UpdateQueueOutboundCallerConfigOutput, error) {
+			return conn.UpdateQueueOutboundCallerConfigWithContext(ctx, input)
+		})
+
+		if err != nil {
+			return diagFromErr(fmt.Errorf("updating Queue Outbound Caller Config (%s): %w", d.Id(), err))
 		}
-		_, err = conn.UpdateQueueOutboundCallerConfigWithContext(ctx, input)
+	}
+
+	// updates to outbound_email_config
+	if d.HasChange("outbound_email_config") {
+		input := &connect_sdkv2.UpdateQueueOutboundEmailConfigInput{
+			InstanceId:          aws_sdkv2.String(instanceID),
+			OutboundEmailConfig: expandOutboundEmailConfig(d.Get("outbound_email_config").([]interface{})),
+			QueueId:             aws_sdkv2.String(queueID),
+		}
+
+		if input.OutboundEmailConfig == nil {
+			input.OutboundEmailConfig = &types.OutboundEmailConfig{}
+		}
+
+		_, err = withInstanceLock(instanceID, func() (*connect_sdkv2.UpdateQueueOutboundEmailConfigOutput, error) {
+			return meta.(*conns.AWSClient).ConnectClient().UpdateQueueOutboundEmailConfig(ctx, input)
+		})
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("updating Queue Outbound Caller Config (%s): %w", d.Id(), err))
+			return diagFromErr(fmt.Errorf("updating Queue Outbound Email Config (%s): %w", d.Id(), err))
 		}
 	}
 
@@ -294,104 +408,301 @@ func resourceQueueUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			QueueId:    aws.String(queueID),
 			Status:     aws.String(d.Get("status").(string)),
 		}
-		_, err = conn.UpdateQueueStatusWithContext(ctx, input)
+		_, err = withInstanceLock(instanceID, func() (*connect.UpdateQueueStatusOutput, error) {
+			return conn.UpdateQueueStatusWithContext(ctx, input)
+		})
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("updating Queue Status (%s): %w", d.Id(), err))
+			return diagFromErr(fmt.Errorf("updating Queue Status (%s): %w", d.Id(), err))
 		}
 	}
 
 	// updates to quick_connect_ids
 	if d.HasChange("quick_connect_ids") {
-		o, n := d.GetChange("quick_connect_ids")
+		quickConnectIdsUpdateAdd, quickConnectIdsUpdateRemove := flex.DiffStringSets(d.GetChange("quick_connect_ids"))
 
-		if o == nil {
-			o = new(schema.Set)
+		err = updateQueueQuickConnectIDs(ctx, conn, instanceID, queueID, quickConnectIdsUpdateAdd, quickConnectIdsUpdateRemove)
+
+		if err != nil {
+			return diagFromErr(err)
 		}
-		if n == nil {
-			n = new(schema.Set)
+	}
+
+	return resourceQueueRead(ctx, d, meta)
+}
+
+func resourceQueueDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID, queueID, err := QueueParseID(d.Id())
+
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	deleteQueue := func() (interface{}, error) {
+		return withInstanceLock(instanceID, func() (*connect_sdkv2.DeleteQueueOutput, error) {
+			return meta.(*conns.AWSClient).ConnectClient().DeleteQueue(ctx, &connect_sdkv2.DeleteQueueInput{
+				InstanceId: aws_sdkv2.String(instanceID),
+				QueueId:    aws_sdkv2.String(queueID),
+			})
+		})
+	}
+
+	log.Printf("[DEBUG] Deleting Connect Queue: %s", d.Id())
+	_, err = deleteQueue()
+
+	// A queue cannot be deleted while quick connects are associated with it or routing profiles reference it.
+	if errs.IsA[*types.ResourceInUseException](err) {
+		if err := detachQueue(ctx, conn, instanceID, queueID, d.Get("force_detach").(bool)); err != nil {
+			return diagFromErr(fmt.Errorf("deleting Connect Queue (%s): %w", d.Id(), err))
 		}
 
-		os := o.(*schema.Set)
-		ns := n.(*schema.Set)
-		quickConnectIdsUpdateAdd := ns.Difference(os)
-		quickConnectIdsUpdateRemove := os.Difference(ns)
+		// Deletion fails while routing profiles that were updated to drop the queue are still being updated.
+		_, err = tfresource.RetryWhenConflict(ctx, d.Timeout(schema.TimeoutDelete), deleteQueue)
+	}
 
-		if len(quickConnectIdsUpdateAdd.List()) > 0 { // nosemgrep:ci.semgrep.migrate.aws-api-context
-			_, err = conn.AssociateQueueQuickConnectsWithContext(ctx, &connect.AssociateQueueQuickConnectsInput{
-				InstanceId:      aws.String(instanceID),
-				QueueId:         aws.String(queueID),
-				QuickConnectIds: flex.ExpandStringSet(quickConnectIdsUpdateAdd),
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("deleting Connect Queue (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+// detachQueue disassociates the queue's quick connects and, if forceDetach is set, removes the queue from the
+// queue configuration of routing profiles. Routing profile references are checked first so that nothing is
+// changed when the queue still could not be deleted.
+func detachQueue(ctx context.Context, conn *connect.Connect, instanceID, queueID string, forceDetach bool) error {
+	queueReferences, defaultOutboundRoutingProfileIDs, err := findQueueRoutingProfileReferences(ctx, conn, instanceID, queueID)
+
+	if err != nil {
+		return fmt.Errorf("finding routing profiles referencing queue: %w", err)
+	}
+
+	if len(defaultOutboundRoutingProfileIDs) > 0 {
+		return fmt.Errorf("queue is the default outbound queue of Connect Routing Profiles (%s), change their default_outbound_queue_id first", strings.Join(defaultOutboundRoutingProfileIDs, ", "))
+	}
+
+	if len(queueReferences) > 0 && !forceDetach {
+		routingProfileIDs := maps.Keys(queueReferences)
+		sort.Strings(routingProfileIDs)
+
+		return fmt.Errorf("queue is in the queue configuration of Connect Routing Profiles (%s), remove it from them or set force_detach", strings.Join(routingProfileIDs, ", "))
+	}
+
+	quickConnectIDs, err := getQueueQuickConnectIDs(ctx, conn, instanceID, queueID)
+
+	if err != nil {
+		return fmt.Errorf("finding quick connects: %w", err)
+	}
+
+	if err := updateQueueQuickConnectIDs(ctx, conn, instanceID, queueID, nil, quickConnectIDs); err != nil {
+		return err
+	}
+
+	for routingProfileID, queueReferences := range queueReferences {
+		for _, chunk := range slices.Chunks(queueReferences, DisassociateRoutingProfileQueuesMaxItems) {
+			_, err := withInstanceLock(instanceID, func() (*connect.DisassociateRoutingProfileQueuesOutput, error) {
+				return conn.DisassociateRoutingProfileQueuesWithContext(ctx, &connect.DisassociateRoutingProfileQueuesInput{
+					InstanceId:       aws.String(instanceID),
+					QueueReferences:  chunk,
+					RoutingProfileId: aws.String(routingProfileID),
+				})
 			})
+
 			if err != nil {
-				return diag.Errorf("updating Queues Quick Connect IDs, specifically associating quick connects to queue (%s): %s", d.Id(), err)
+				return fmt.Errorf("disassociating queue from Connect Routing Profile (%s): %w", routingProfileID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// findQueueRoutingProfileReferences returns the queue's entries in the queue configuration of each routing profile
+// in the instance, keyed by routing profile ID, and the IDs of the routing profiles whose default outbound queue it is.
+func findQueueRoutingProfileReferences(ctx context.Context, conn *connect.Connect, instanceID, queueID string) (map[string][]*connect.RoutingProfileQueueReference, []string, error) {
+	var routingProfileIDs []string
+
+	input := &connect.ListRoutingProfilesInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int64(ListRoutingProfilesMaxResults),
+	}
+
+	err := conn.ListRoutingProfilesPagesWithContext(ctx, input, func(page *connect.ListRoutingProfilesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, rp := range page.RoutingProfileSummaryList {
+			if rp == nil {
+				continue
+			}
+
+			routingProfileIDs = append(routingProfileIDs, aws.StringValue(rp.Id))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing routing profiles: %w", err)
+	}
+
+	queueReferences := map[string][]*connect.RoutingProfileQueueReference{}
+	var defaultOutboundRoutingProfileIDs []string
+
+	for _, routingProfileID := range routingProfileIDs {
+		routingProfile, err := FindRoutingProfileByID(ctx, conn, instanceID, routingProfileID)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading Connect Routing Profile (%s): %w", routingProfileID, err)
+		}
+
+		if aws.StringValue(routingProfile.DefaultOutboundQueueId) == queueID {
+			defaultOutboundRoutingProfileIDs = append(defaultOutboundRoutingProfileIDs, routingProfileID)
+		}
+
+		queueConfigs, err := getRoutingProfileQueueConfigs(ctx, conn, instanceID, routingProfileID)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("finding Connect Routing Profile (%s) Queue Configs: %w", routingProfileID, err)
+		}
+
+		for _, queueConfig := range queueConfigs {
+			if tfMap := queueConfig.(map[string]interface{}); tfMap["queue_id"].(string) == queueID {
+				queueReferences[routingProfileID] = append(queueReferences[routingProfileID], &connect.RoutingProfileQueueReference{
+					Channel: aws.String(tfMap["channel"].(string)),
+					QueueId: aws.String(queueID),
+				})
 			}
 		}
+	}
+
+	return queueReferences, defaultOutboundRoutingProfileIDs, nil
+}
+
+// updateQueueQuickConnectIDs associates and disassociates the specified quick connects in batches,
+// as AssociateQueueQuickConnects and DisassociateQueueQuickConnects accept at most 50 quick connects per call.
+func updateQueueQuickConnectIDs(ctx context.Context, conn *connect.Connect, instanceID, queueID string, quickConnectIdsUpdateAdd, quickConnectIdsUpdateRemove []*string) error {
+	for _, chunk := range slices.Chunks(quickConnectIdsUpdateRemove, DisassociateQueueQuickConnectsMaxItems) {
+		_, err := withInstanceLock(instanceID, func() (*connect.DisassociateQueueQuickConnectsOutput, error) {
+			return conn.DisassociateQueueQuickConnectsWithContext(ctx, &connect.DisassociateQueueQuickConnectsInput{
+				InstanceId:      aws.String(instanceID),
+				QueueId:         aws.String(queueID),
+				QuickConnectIds: chunk,
+			})
+		})
+
+		if err != nil {
+			return fmt.Errorf("updating Queues Quick Connect IDs, specifically disassociating quick connects from queue (%s): %w", queueID, err)
+		}
+	}
 
-		if len(quickConnectIdsUpdateRemove.List()) > 0 { // nosemgrep:ci.semgrep.migrate.aws-api-context
-			_, err = conn.DisassociateQueueQuickConnectsWithContext(ctx, &connect.DisassociateQueueQuickConnectsInput{
+	for _, chunk := range slices.Chunks(quickConnectIdsUpdateAdd, AssociateQueueQuickConnectsMaxItems) {
+		_, err := withInstanceLock(instanceID, func() (*connect.AssociateQueueQuickConnectsOutput, error) {
+			return conn.AssociateQueueQuickConnectsWithContext(ctx, &connect.AssociateQueueQuickConnectsInput{
 				InstanceId:      aws.String(instanceID),
 				QueueId:         aws.String(queueID),
-				QuickConnectIds: flex.ExpandStringSet(quickConnectIdsUpdateRemove),
+				QuickConnectIds: chunk,
 			})
-			if err != nil {
-				return diag.Errorf("updating Queues Quick Connect IDs, specifically disassociating quick connects from queue (%s): %s", d.Id(), err)
-			}
+		})
+
+		if err != nil {
+			return fmt.Errorf("updating Queues Quick Connect IDs, specifically associating quick connects to queue (%s): %w", queueID, err)
 		}
 	}
 
-	return resourceQueueRead(ctx, d, meta)
+	return nil
 }
 
-func expandOutboundCallerConfig(outboundCallerConfig []interface{}) *connect.OutboundCallerConfig {
-	if len(outboundCallerConfig) == 0 || outboundCallerConfig[0] == nil {
-		return nil
+func queueOutboundCallerConfigSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"outbound_caller_id_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"outbound_caller_id_number_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidConnectID,
+			},
+			"outbound_flow_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidConnectID,
+			},
+		},
 	}
+}
 
-	tfMap, ok := outboundCallerConfig[0].(map[string]interface{})
-	if !ok {
-		return nil
-	}
+// Empty strings are omitted as passing an empty ID leads to an InvalidParameterException.
+func expandOutboundCallerConfig(tfList []interface{}) (*connect.OutboundCallerConfig, error) {
+	return flex.ExpandFirst[connect.OutboundCallerConfig](tfList, flex.OmitEmptyStrings())
+}
 
-	result := &connect.OutboundCallerConfig{}
+func flattenOutboundCallerConfig(apiObject *connect.OutboundCallerConfig) ([]interface{}, error) {
+	return flex.FlattenFirst(apiObject, queueOutboundCallerConfigSchema())
+}
 
-	if v, ok := tfMap["outbound_caller_id_name"].(string); ok && v != "" {
-		result.OutboundCallerIdName = aws.String(v)
+func expandOutboundEmailConfig(outboundEmailConfig []interface{}) *types.OutboundEmailConfig {
+	if len(outboundEmailConfig) == 0 || outboundEmailConfig[0] == nil {
+		return nil
 	}
 
-	// passing an empty string leads to an InvalidParameterException
-	if v, ok := tfMap["outbound_caller_id_number_id"].(string); ok && v != "" {
-		result.OutboundCallerIdNumberId = aws.String(v)
+	tfMap, ok := outboundEmailConfig[0].(map[string]interface{})
+	if !ok {
+		return nil
 	}
 
+	result := &types.OutboundEmailConfig{}
+
 	// passing an empty string leads to an InvalidParameterException
-	if v, ok := tfMap["outbound_flow_id"].(string); ok && v != "" {
-		result.OutboundFlowId = aws.String(v)
+	if v, ok := tfMap["outbound_email_address_id"].(string); ok && v != "" {
+		result.OutboundEmailAddressId = aws_sdkv2.String(v)
 	}
 
 	return result
 }
 
-func flattenOutboundCallerConfig(outboundCallerConfig *connect.OutboundCallerConfig) []interface{} {
-	if outboundCallerConfig == nil {
+func flattenOutboundEmailConfig(outboundEmailConfig *types.OutboundEmailConfig) []interface{} {
+	if outboundEmailConfig == nil || outboundEmailConfig.OutboundEmailAddressId == nil {
 		return []interface{}{}
 	}
 
-	values := map[string]interface{}{}
+	values := map[string]interface{}{
+		"outbound_email_address_id": aws_sdkv2.ToString(outboundEmailConfig.OutboundEmailAddressId),
+	}
+
+	return []interface{}{values}
+}
 
-	if v := outboundCallerConfig.OutboundCallerIdName; v != nil {
-		values["outbound_caller_id_name"] = aws.StringValue(v)
+// findQueueOutboundEmailConfig reads the queue's outbound email configuration, which the AWS SDK for Go v1
+// does not model. This is a second DescribeQueue call per read, made with the v2 client; it can be folded into
+// the first once the resource is migrated to the v2 client.
+func findQueueOutboundEmailConfig(ctx context.Context, client *connect_sdkv2.Client, instanceID, queueID string) (*types.OutboundEmailConfig, error) {
+	input := &connect_sdkv2.DescribeQueueInput{
+		InstanceId: aws_sdkv2.String(instanceID),
+		QueueId:    aws_sdkv2.String(queueID),
 	}
 
-	if v := outboundCallerConfig.OutboundCallerIdNumberId; v != nil {
-		values["outbound_caller_id_number_id"] = aws.StringValue(v)
+	output, err := client.DescribeQueue(ctx, input)
+
+	if err != nil {
+		return nil, err
 	}
 
-	if v := outboundCallerConfig.OutboundFlowId; v != nil {
-		values["outbound_flow_id"] = aws.StringValue(v)
+	if output == nil || output.Queue == nil {
+		return nil, tfresource.NewEmptyResultError(input)
 	}
 
-	return []interface{}{values}
+	return output.Queue.OutboundEmailConfig, nil
 }
 
 func getQueueQuickConnectIDs(ctx context.Context, conn *connect.Connect, instanceID, queueID string) ([]*string, error) {
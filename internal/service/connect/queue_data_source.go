@@ -32,7 +32,8 @@ func DataSourceQueue() *schema.Resource {
 			},
 			"instance_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(1, 100),
 			},
 			"max_contacts": {
@@ -81,6 +82,10 @@ func DataSourceQueue() *schema.Resource {
 }
 
 func dataSourceQueueRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setDefaultInstanceID(d, meta); err != nil {
+		return diagFromErr(err)
+	}
+
 	conn := meta.(*conns.AWSClient).ConnectConn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -97,11 +102,11 @@ func dataSourceQueueRead(ctx context.Context, d *schema.ResourceData, meta inter
 		queueSummary, err := dataSourceGetQueueSummaryByName(ctx, conn, instanceID, name)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error finding Connect Queue Summary by name (%s): %w", name, err))
+			return diagFromErr(fmt.Errorf("error finding Connect Queue Summary by name (%s): %w", name, err))
 		}
 
 		if queueSummary == nil {
-			return diag.FromErr(fmt.Errorf("error finding Connect Queue Summary by name (%s): not found", name))
+			return diagFromErr(fmt.Errorf("error finding Connect Queue Summary by name (%s): not found", name))
 		}
 
 		input.QueueId = queueSummary.Id
@@ -110,11 +115,11 @@ func dataSourceQueueRead(ctx context.Context, d *schema.ResourceData, meta inter
 	resp, err := conn.DescribeQueueWithContext(ctx, input)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Queue: %w", err))
+		return diagFromErr(fmt.Errorf("error getting Connect Queue: %w", err))
 	}
 
 	if resp == nil || resp.Queue == nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Queue: empty response"))
+		return diagFromErr(fmt.Errorf("error getting Connect Queue: empty response"))
 	}
 
 	queue := resp.Queue
@@ -127,12 +132,18 @@ func dataSourceQueueRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("queue_id", queue.QueueId)
 	d.Set("status", queue.Status)
 
-	if err := d.Set("outbound_caller_config", flattenOutboundCallerConfig(queue.OutboundCallerConfig)); err != nil {
-		return diag.FromErr(fmt.Errorf("error setting outbound_caller_config: %s", err))
+	outboundCallerConfig, err := flattenOutboundCallerConfig(queue.OutboundCallerConfig)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error flattening outbound_caller_config: %s", err))
+	}
+
+	if err := d.Set("outbound_caller_config", outboundCallerConfig); err != nil {
+		return diagFromErr(fmt.Errorf("error setting outbound_caller_config: %s", err))
 	}
 
 	if err := d.Set("tags", KeyValueTags(ctx, queue.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
-		return diag.FromErr(fmt.Errorf("error setting tags: %s", err))
+		return diagFromErr(fmt.Errorf("error setting tags: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(queue.QueueId)))
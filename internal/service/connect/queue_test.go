@@ -7,13 +7,13 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func testAccQueue_basic(t *testing.T) {
@@ -40,6 +40,7 @@ func testAccQueue_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "hours_of_operation_id", "data.aws_connect_hours_of_operation.test", "hours_of_operation_id"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName2),
 					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "outbound_email_config.#", "0"),
 					resource.TestCheckResourceAttrSet(resourceName, "queue_id"),
 					resource.TestCheckResourceAttr(resourceName, "quick_connect_ids.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "status", connect.QueueStatusEnabled),
@@ -60,6 +61,7 @@ func testAccQueue_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "hours_of_operation_id", "data.aws_connect_hours_of_operation.test", "hours_of_operation_id"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName2),
 					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "outbound_email_config.#", "0"),
 					resource.TestCheckResourceAttrSet(resourceName, "queue_id"),
 					resource.TestCheckResourceAttr(resourceName, "quick_connect_ids.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "status", connect.QueueStatusEnabled),
@@ -71,8 +73,6 @@ func testAccQueue_basic(t *testing.T) {
 }
 
 func testAccQueue_disappears(t *testing.T) {
-	t.Skip("Queues do not support deletion today")
-
 	ctx := acctest.Context(t)
 
 	var v connect.DescribeQueueOutput
@@ -98,13 +98,45 @@ func testAccQueue_disappears(t *testing.T) {
 	})
 }
 
-func testAccQueue_updateHoursOfOperationId(t *testing.T) {
+func testAccQueue_forceDetach(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v connect.DescribeQueueOutput
 	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
 	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
 	resourceName := "aws_connect_queue.test"
 
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_forceDetach(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "force_detach", "true"),
+					testAccCheckQueueAssociateRoutingProfile(ctx, resourceName, "data.aws_connect_routing_profile.test"),
+				),
+			},
+			{
+				// Destroying the queue detaches it from the routing profile it was added to outside of Terraform.
+				Config: testAccQueueConfig_base(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueDeleted(ctx, "aws_connect_instance.test", &v),
+				),
+			},
+		},
+	})
+}
+
+func testAccQueue_updateHoursOfOperationId(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v, v2 connect.DescribeQueueOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_queue.test"
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
@@ -134,7 +166,8 @@ func testAccQueue_updateHoursOfOperationId(t *testing.T) {
 			{
 				Config: testAccQueueConfig_hoursOfOperation(rName, rName2, "second"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckQueueExists(ctx, resourceName, &v),
+					testAccCheckQueueExists(ctx, resourceName, &v2),
+					testAccCheckQueueNotRecreated(&v, &v2),
 					resource.TestCheckResourceAttrSet(resourceName, "arn"),
 					resource.TestCheckResourceAttrSet(resourceName, "description"),
 					resource.TestCheckResourceAttrPair(resourceName, "hours_of_operation_id", "aws_connect_hours_of_operation.test", "hours_of_operation_id"),
@@ -154,7 +187,8 @@ func testAccQueue_updateHoursOfOperationId(t *testing.T) {
 			{
 				Config: testAccQueueConfig_hoursOfOperation(rName, rName2, "first"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckQueueExists(ctx, resourceName, &v),
+					testAccCheckQueueExists(ctx, resourceName, &v2),
+					testAccCheckQueueNotRecreated(&v, &v2),
 					resource.TestCheckResourceAttrSet(resourceName, "arn"),
 					resource.TestCheckResourceAttrSet(resourceName, "description"),
 					resource.TestCheckResourceAttrPair(resourceName, "hours_of_operation_id", "data.aws_connect_hours_of_operation.test", "hours_of_operation_id"),
@@ -442,6 +476,47 @@ func testAccQueue_updateQuickConnectIds(t *testing.T) {
 	})
 }
 
+func testAccQueue_updateQuickConnectIdsBatched(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.DescribeQueueOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_queue.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// more quick connects than CreateQueue accepts
+				Config: testAccQueueConfig_quickConnectCount(rName, rName2, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "quick_connect_ids.#", "60"),
+				),
+			},
+			{
+				// more quick connects to disassociate than DisassociateQueueQuickConnects accepts
+				Config: testAccQueueConfig_quickConnectCount(rName, rName2, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "quick_connect_ids.#", "5"),
+				),
+			},
+			{
+				// more quick connects to associate than AssociateQueueQuickConnects accepts
+				Config: testAccQueueConfig_quickConnectCount(rName, rName2, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "quick_connect_ids.#", "100"),
+				),
+			},
+		},
+	})
+}
+
 func testAccQueue_updateTags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v connect.DescribeQueueOutput
@@ -493,6 +568,36 @@ func testAccQueue_updateTags(t *testing.T) {
 	})
 }
 
+func testAccQueue_defaultInstanceID(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.DescribeQueueOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_queue.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// the instance must exist before it can be used to configure the provider
+				Config: testAccQueueConfig_instance(rName),
+			},
+			{
+				Config: testAccQueueConfig_defaultInstanceID(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "hours_of_operation_id", "data.aws_connect_hours_of_operation.test", "hours_of_operation_id"),
+					resource.TestCheckResourceAttrPair("data.aws_connect_hours_of_operation.test", "instance_id", "aws_connect_instance.test", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckQueueExists(ctx context.Context, resourceName string, function *connect.DescribeQueueOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -527,6 +632,72 @@ func testAccCheckQueueExists(ctx context.Context, resourceName string, function
 	}
 }
 
+func testAccCheckQueueNotRecreated(i, j *connect.DescribeQueueOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(i.Queue.QueueId), aws.StringValue(j.Queue.QueueId); before != after {
+			return fmt.Errorf("Connect Queue recreated: %s to %s", before, after)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckQueueAssociateRoutingProfile(ctx context.Context, queueResourceName, routingProfileResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		queue, ok := s.RootModule().Resources[queueResourceName]
+		if !ok {
+			return fmt.Errorf("Connect Queue not found: %s", queueResourceName)
+		}
+
+		routingProfile, ok := s.RootModule().Resources[routingProfileResourceName]
+		if !ok {
+			return fmt.Errorf("Connect Routing Profile not found: %s", routingProfileResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn()
+
+		_, err := conn.AssociateRoutingProfileQueuesWithContext(ctx, &connect.AssociateRoutingProfileQueuesInput{
+			InstanceId: aws.String(queue.Primary.Attributes["instance_id"]),
+			QueueConfigs: []*connect.RoutingProfileQueueConfig{
+				{
+					Delay:    aws.Int64(0),
+					Priority: aws.Int64(1),
+					QueueReference: &connect.RoutingProfileQueueReference{
+						Channel: aws.String(connect.ChannelVoice),
+						QueueId: aws.String(queue.Primary.Attributes["queue_id"]),
+					},
+				},
+			},
+			RoutingProfileId: aws.String(routingProfile.Primary.Attributes["routing_profile_id"]),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckQueueDeleted(ctx context.Context, instanceResourceName string, v *connect.DescribeQueueOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		instance, ok := s.RootModule().Resources[instanceResourceName]
+		if !ok {
+			return fmt.Errorf("Connect Instance not found: %s", instanceResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn()
+
+		_, err := tfconnect.FindQueueByID(ctx, conn, instance.Primary.ID, aws.StringValue(v.Queue.QueueId))
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Connect Queue %s still exists", aws.StringValue(v.Queue.QueueId))
+	}
+}
+
 func testAccCheckQueueDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -542,14 +713,9 @@ func testAccCheckQueueDestroy(ctx context.Context) resource.TestCheckFunc {
 				return err
 			}
 
-			params := &connect.DescribeQueueInput{
-				QueueId:    aws.String(queueID),
-				InstanceId: aws.String(instanceID),
-			}
-
-			_, err = conn.DescribeQueueWithContext(ctx, params)
+			_, err = tfconnect.FindQueueByID(ctx, conn, instanceID, queueID)
 
-			if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+			if tfresource.NotFound(err) {
 				continue
 			}
 
@@ -564,12 +730,6 @@ func testAccCheckQueueDestroy(ctx context.Context) resource.TestCheckFunc {
 
 func testAccQueueConfig_base(rName string) string {
 	// Use the aws_connect_hours_of_operation data source with the default "Basic Hours" that comes with connect instances.
-	// Because if a resource is used, Terraform will not be able to delete it since queues do not have support for the delete api
-	// yet but still references hours_of_operation_id. However, using the data source will result in the failure of the
-	// disppears test (removed till delete api is available) for the connect instance (We test disappears on the Connect instance
-	// instead of the queue since the queue does not support delete). The error is:
-	// Step 1/1 error: Error running post-apply plan: exit status 1
-	// Error: error finding Connect Hours of Operation Summary by name (Basic Hours): ResourceNotFoundException: Instance not found
 	return fmt.Sprintf(`
 resource "aws_connect_instance" "test" {
   identity_management_type = "CONNECT_MANAGED"
@@ -585,6 +745,42 @@ data "aws_connect_hours_of_operation" "test" {
 `, rName)
 }
 
+func testAccQueueConfig_instance(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+`, rName)
+}
+
+func testAccQueueConfig_defaultInstanceID(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccQueueConfig_instance(rName),
+		fmt.Sprintf(`
+provider "aws" {
+  alias = "connect"
+
+  connect_default_instance_id = aws_connect_instance.test.id
+}
+
+data "aws_connect_hours_of_operation" "test" {
+  provider = aws.connect
+
+  name = "Basic Hours"
+}
+
+resource "aws_connect_queue" "test" {
+  provider = aws.connect
+
+  name                  = %[1]q
+  hours_of_operation_id = data.aws_connect_hours_of_operation.test.hours_of_operation_id
+}
+`, rName2))
+}
+
 func testAccQueueConfig_basic(rName, rName2, label string) string {
 	return acctest.ConfigCompose(
 		testAccQueueConfig_base(rName),
@@ -602,6 +798,24 @@ resource "aws_connect_queue" "test" {
 `, rName2, label))
 }
 
+func testAccQueueConfig_forceDetach(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccQueueConfig_base(rName),
+		fmt.Sprintf(`
+data "aws_connect_routing_profile" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = "Basic Routing Profile"
+}
+
+resource "aws_connect_queue" "test" {
+  instance_id           = aws_connect_instance.test.id
+  name                  = %[1]q
+  force_detach          = true
+  hours_of_operation_id = data.aws_connect_hours_of_operation.test.hours_of_operation_id
+}
+`, rName2))
+}
+
 func testAccQueueConfig_hoursOfOperation(rName, rName2, selectHoursOfOperationId string) string {
 	return acctest.ConfigCompose(
 		testAccQueueConfig_base(rName),
@@ -787,6 +1001,35 @@ resource "aws_connect_queue" "test" {
 `, rName4, label))
 }
 
+func testAccQueueConfig_quickConnectCount(rName, rName2 string, count int) string {
+	return acctest.ConfigCompose(
+		testAccQueueConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_quick_connect" "test" {
+  count = 100
+
+  instance_id = aws_connect_instance.test.id
+  name        = "%[1]s-${count.index}"
+
+  quick_connect_config {
+    quick_connect_type = "PHONE_NUMBER"
+
+    phone_config {
+      phone_number = format("+1234567%%04d", count.index)
+    }
+  }
+}
+
+resource "aws_connect_queue" "test" {
+  instance_id           = aws_connect_instance.test.id
+  name                  = %[1]q
+  hours_of_operation_id = data.aws_connect_hours_of_operation.test.hours_of_operation_id
+
+  quick_connect_ids = slice(aws_connect_quick_connect.test[*].quick_connect_id, 0, %[2]d)
+}
+`, rName2, count))
+}
+
 func testAccQueueConfig_tags(rName, rName2, label string) string {
 	return acctest.ConfigCompose(
 		testAccQueueConfig_base(rName),
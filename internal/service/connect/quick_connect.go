@@ -10,9 +10,11 @@ import (
 	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -29,7 +31,11 @@ func ResourceQuickConnect() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			defaultInstanceIDCustomizeDiff,
+			resourceQuickConnectCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 		Schema: map[string]*schema.Schema{
 			"description": {
 				Type:         schema.TypeString,
@@ -46,7 +52,8 @@ func ResourceQuickConnect() *schema.Resource {
 			},
 			"instance_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -57,77 +64,7 @@ func ResourceQuickConnect() *schema.Resource {
 				Type:     schema.TypeList,
 				Required: true,
 				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"phone_config": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"phone_number": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								if v := d.Get("quick_connect_config.0.quick_connect_type").(string); v == connect.QuickConnectTypePhoneNumber {
-									return false
-								}
-								return true
-							},
-						},
-						"queue_config": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"contact_flow_id": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"queue_id": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								if v := d.Get("quick_connect_config.0.quick_connect_type").(string); v == connect.QuickConnectTypeQueue {
-									return false
-								}
-								return true
-							},
-						},
-						"quick_connect_type": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice(connect.QuickConnectType_Values(), false),
-						},
-						"user_config": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"contact_flow_id": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"user_id": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								if v := d.Get("quick_connect_config.0.quick_connect_type").(string); v == connect.QuickConnectTypeUser {
-									return false
-								}
-								return true
-							},
-						},
-					},
-				},
+				Elem:     quickConnectConfigSchema(),
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -140,7 +77,12 @@ func resourceQuickConnectCreate(ctx context.Context, d *schema.ResourceData, met
 
 	instanceID := d.Get("instance_id").(string)
 	name := d.Get("name").(string)
-	quickConnectConfig := expandQuickConnectConfig(d.Get("quick_connect_config").([]interface{}))
+	quickConnectConfig, err := expandQuickConnectConfig(d.Get("quick_connect_config").([]interface{}))
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("expanding quick_connect_config: %w", err))
+	}
+
 	input := &connect.CreateQuickConnectInput{
 		QuickConnectConfig: quickConnectConfig,
 		InstanceId:         aws.String(instanceID),
@@ -156,11 +98,11 @@ func resourceQuickConnectCreate(ctx context.Context, d *schema.ResourceData, met
 	output, err := conn.CreateQuickConnectWithContext(ctx, input)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating Connect Quick Connect (%s): %w", name, err))
+		return diagFromErr(fmt.Errorf("error creating Connect Quick Connect (%s): %w", name, err))
 	}
 
 	if output == nil {
-		return diag.FromErr(fmt.Errorf("error creating Connect Quick Connect (%s): empty output", name))
+		return diagFromErr(fmt.Errorf("error creating Connect Quick Connect (%s): empty output", name))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(output.QuickConnectId)))
@@ -174,7 +116,7 @@ func resourceQuickConnectRead(ctx context.Context, d *schema.ResourceData, meta
 	instanceID, quickConnectID, err := QuickConnectParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	resp, err := conn.DescribeQuickConnectWithContext(ctx, &connect.DescribeQuickConnectInput{
@@ -189,15 +131,21 @@ func resourceQuickConnectRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Quick Connect (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error getting Connect Quick Connect (%s): %w", d.Id(), err))
 	}
 
 	if resp == nil || resp.QuickConnect == nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Quick Connect (%s): empty response", d.Id()))
+		return diagFromErr(fmt.Errorf("error getting Connect Quick Connect (%s): empty response", d.Id()))
 	}
 
-	if err := d.Set("quick_connect_config", flattenQuickConnectConfig(resp.QuickConnect.QuickConnectConfig)); err != nil {
-		return diag.FromErr(err)
+	quickConnectConfig, err := flattenQuickConnectConfig(resp.QuickConnect.QuickConnectConfig)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("flattening quick_connect_config: %w", err))
+	}
+
+	if err := d.Set("quick_connect_config", quickConnectConfig); err != nil {
+		return diagFromErr(err)
 	}
 
 	d.Set("instance_id", instanceID)
@@ -217,7 +165,7 @@ func resourceQuickConnectUpdate(ctx context.Context, d *schema.ResourceData, met
 	instanceID, quickConnectID, err := QuickConnectParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// QuickConnect has 2 update APIs
@@ -237,7 +185,7 @@ func resourceQuickConnectUpdate(ctx context.Context, d *schema.ResourceData, met
 		_, err = conn.UpdateQuickConnectNameWithContext(ctx, inputNameDesc)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("updating QuickConnect Name (%s): %w", d.Id(), err))
+			return diagFromErr(fmt.Errorf("updating QuickConnect Name (%s): %w", d.Id(), err))
 		}
 	}
 
@@ -249,11 +197,16 @@ func resourceQuickConnectUpdate(ctx context.Context, d *schema.ResourceData, met
 
 	// QuickConnectConfig is a required field but does not require update if it is unchanged
 	if d.HasChange("quick_connect_config") {
-		quickConnectConfig := expandQuickConnectConfig(d.Get("quick_connect_config").([]interface{}))
+		quickConnectConfig, err := expandQuickConnectConfig(d.Get("quick_connect_config").([]interface{}))
+
+		if err != nil {
+			return diagFromErr(fmt.Errorf("expanding quick_connect_config: %w", err))
+		}
+
 		inputConfig.QuickConnectConfig = quickConnectConfig
 		_, err = conn.UpdateQuickConnectConfigWithContext(ctx, inputConfig)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("updating QuickConnect (%s): %w", d.Id(), err))
+			return diagFromErr(fmt.Errorf("updating QuickConnect (%s): %w", d.Id(), err))
 		}
 	}
 
@@ -266,7 +219,7 @@ func resourceQuickConnectDelete(ctx context.Context, d *schema.ResourceData, met
 	instanceID, quickConnectID, err := QuickConnectParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = conn.DeleteQuickConnectWithContext(ctx, &connect.DeleteQuickConnectInput{
@@ -275,113 +228,178 @@ func resourceQuickConnectDelete(ctx context.Context, d *schema.ResourceData, met
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting QuickConnect (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error deleting QuickConnect (%s): %w", d.Id(), err))
 	}
 
 	return nil
 }
 
-func expandQuickConnectConfig(quickConnectConfig []interface{}) *connect.QuickConnectConfig {
-	if len(quickConnectConfig) == 0 || quickConnectConfig[0] == nil {
+func resourceQuickConnectCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("quick_connect_config.0.quick_connect_type") {
 		return nil
 	}
 
-	tfMap, ok := quickConnectConfig[0].(map[string]interface{})
-	if !ok {
-		return nil
+	quickConnectType := diff.Get("quick_connect_config.0.quick_connect_type").(string)
+	configKeyByType := map[string]string{
+		connect.QuickConnectTypePhoneNumber: "phone_config",
+		connect.QuickConnectTypeQueue:       "queue_config",
+		connect.QuickConnectTypeUser:        "user_config",
 	}
 
-	quickConnectType := tfMap["quick_connect_type"].(string)
+	var configured []string
+	for _, key := range []string{"phone_config", "queue_config", "user_config"} {
+		if v, ok := diff.Get("quick_connect_config.0." + key).([]interface{}); ok && len(v) > 0 {
+			configured = append(configured, key)
+		}
+	}
 
-	result := &connect.QuickConnectConfig{
-		QuickConnectType: aws.String(quickConnectType),
+	expected, ok := configKeyByType[quickConnectType]
+	if !ok {
+		return nil
 	}
 
-	switch quickConnectType {
-	case connect.QuickConnectTypePhoneNumber:
-		tpc := tfMap["phone_config"].([]interface{})
-		if len(tpc) == 0 || tpc[0] == nil {
-			log.Printf("[ERR] 'phone_config' must be set when 'quick_connect_type' is '%s'", quickConnectType)
-			return nil
-		}
-		vpc := tpc[0].(map[string]interface{})
-		pc := connect.PhoneNumberQuickConnectConfig{
-			PhoneNumber: aws.String(vpc["phone_number"].(string)),
-		}
-		result.PhoneConfig = &pc
+	if len(configured) != 1 {
+		return fmt.Errorf("exactly one of `phone_config`, `queue_config` or `user_config` must be set in `quick_connect_config`, got %d", len(configured))
+	}
 
-	case connect.QuickConnectTypeQueue:
-		tqc := tfMap["queue_config"].([]interface{})
-		if len(tqc) == 0 || tqc[0] == nil {
-			log.Printf("[ERR] 'queue_config' must be set when 'quick_connect_type' is '%s'", quickConnectType)
-			return nil
-		}
-		vqc := tqc[0].(map[string]interface{})
-		qc := connect.QueueQuickConnectConfig{
-			ContactFlowId: aws.String(vqc["contact_flow_id"].(string)),
-			QueueId:       aws.String(vqc["queue_id"].(string)),
-		}
-		result.QueueConfig = &qc
+	if configured[0] != expected {
+		return fmt.Errorf("`%s` must be set when `quick_connect_type` is %q, got `%s`", expected, quickConnectType, configured[0])
+	}
 
-	case connect.QuickConnectTypeUser:
-		tuc := tfMap["user_config"].([]interface{})
-		if len(tuc) == 0 || tuc[0] == nil {
-			log.Printf("[ERR] 'user_config' must be set when 'quick_connect_type' is '%s'", quickConnectType)
-			return nil
-		}
-		vuc := tuc[0].(map[string]interface{})
-		uc := connect.UserQuickConnectConfig{
-			ContactFlowId: aws.String(vuc["contact_flow_id"].(string)),
-			UserId:        aws.String(vuc["user_id"].(string)),
-		}
-		result.UserConfig = &uc
+	return nil
+}
 
-	default:
-		log.Printf("[ERR] quick_connect_type is invalid")
-		return nil
+func quickConnectConfigSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"phone_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"phone_number": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidE164PhoneNumber,
+						},
+					},
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					if v := d.Get("quick_connect_config.0.quick_connect_type").(string); v == connect.QuickConnectTypePhoneNumber {
+						return false
+					}
+					return true
+				},
+			},
+			"queue_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contact_flow_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidConnectID,
+						},
+						"queue_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidConnectID,
+						},
+					},
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					if v := d.Get("quick_connect_config.0.quick_connect_type").(string); v == connect.QuickConnectTypeQueue {
+						return false
+					}
+					return true
+				},
+			},
+			"quick_connect_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(connect.QuickConnectType_Values(), false),
+			},
+			"user_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contact_flow_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidConnectID,
+						},
+						"user_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidConnectID,
+						},
+					},
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					if v := d.Get("quick_connect_config.0.quick_connect_type").(string); v == connect.QuickConnectTypeUser {
+						return false
+					}
+					return true
+				},
+			},
+		},
 	}
-
-	return result
 }
 
-func flattenQuickConnectConfig(quickConnectConfig *connect.QuickConnectConfig) []interface{} {
-	if quickConnectConfig == nil {
-		return []interface{}{}
+func expandQuickConnectConfig(tfList []interface{}) (*connect.QuickConnectConfig, error) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil, nil
 	}
 
-	quickConnectType := aws.StringValue(quickConnectConfig.QuickConnectType)
+	tfMap, ok := tfList[0].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected map[string]interface{}, got %T", tfList[0])
+	}
 
-	values := map[string]interface{}{
-		"quick_connect_type": quickConnectType,
+	quickConnectType := tfMap["quick_connect_type"].(string)
+	apiObject := &connect.QuickConnectConfig{
+		QuickConnectType: aws.String(quickConnectType),
 	}
 
+	// Only the configuration matching the quick connect type is sent.
+	var key string
+	var err error
+
 	switch quickConnectType {
 	case connect.QuickConnectTypePhoneNumber:
-		pc := map[string]interface{}{
-			"phone_number": aws.StringValue(quickConnectConfig.PhoneConfig.PhoneNumber),
-		}
-		values["phone_config"] = []interface{}{pc}
-
+		key = "phone_config"
+		apiObject.PhoneConfig, err = flex.ExpandFirst[connect.PhoneNumberQuickConnectConfig](tfMap[key].([]interface{}))
+		ok = apiObject.PhoneConfig != nil
 	case connect.QuickConnectTypeQueue:
-		qc := map[string]interface{}{
-			"contact_flow_id": aws.StringValue(quickConnectConfig.QueueConfig.ContactFlowId),
-			"queue_id":        aws.StringValue(quickConnectConfig.QueueConfig.QueueId),
-		}
-		values["queue_config"] = []interface{}{qc}
-
+		key = "queue_config"
+		apiObject.QueueConfig, err = flex.ExpandFirst[connect.QueueQuickConnectConfig](tfMap[key].([]interface{}))
+		ok = apiObject.QueueConfig != nil
 	case connect.QuickConnectTypeUser:
-		uc := map[string]interface{}{
-			"contact_flow_id": aws.StringValue(quickConnectConfig.UserConfig.ContactFlowId),
-			"user_id":         aws.StringValue(quickConnectConfig.UserConfig.UserId),
-		}
-		values["user_config"] = []interface{}{uc}
-
+		key = "user_config"
+		apiObject.UserConfig, err = flex.ExpandFirst[connect.UserQuickConnectConfig](tfMap[key].([]interface{}))
+		ok = apiObject.UserConfig != nil
 	default:
-		log.Printf("[ERR] quick_connect_type is invalid")
-		return nil
+		return nil, fmt.Errorf("quick_connect_type (%s) is invalid", quickConnectType)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", key, err)
 	}
 
-	return []interface{}{values}
+	if !ok {
+		return nil, fmt.Errorf("`%s` must be set when `quick_connect_type` is %q", key, quickConnectType)
+	}
+
+	return apiObject, nil
+}
+
+func flattenQuickConnectConfig(apiObject *connect.QuickConnectConfig) ([]interface{}, error) {
+	return flex.FlattenFirst(apiObject, quickConnectConfigSchema())
 }
 
 func QuickConnectParseID(id string) (string, string, error) {
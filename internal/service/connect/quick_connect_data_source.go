@@ -28,7 +28,8 @@ func DataSourceQuickConnect() *schema.Resource {
 			},
 			"instance_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(1, 100),
 			},
 			"name": {
@@ -105,6 +106,10 @@ func DataSourceQuickConnect() *schema.Resource {
 }
 
 func dataSourceQuickConnectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setDefaultInstanceID(d, meta); err != nil {
+		return diagFromErr(err)
+	}
+
 	conn := meta.(*conns.AWSClient).ConnectConn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -121,11 +126,11 @@ func dataSourceQuickConnectRead(ctx context.Context, d *schema.ResourceData, met
 		quickConnectSummary, err := dataSourceGetQuickConnectSummaryByName(ctx, conn, instanceID, name)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error finding Connect Quick Connect Summary by name (%s): %w", name, err))
+			return diagFromErr(fmt.Errorf("error finding Connect Quick Connect Summary by name (%s): %w", name, err))
 		}
 
 		if quickConnectSummary == nil {
-			return diag.FromErr(fmt.Errorf("error finding Connect Quick Connect Summary by name (%s): not found", name))
+			return diagFromErr(fmt.Errorf("error finding Connect Quick Connect Summary by name (%s): not found", name))
 		}
 
 		input.QuickConnectId = quickConnectSummary.Id
@@ -134,11 +139,11 @@ func dataSourceQuickConnectRead(ctx context.Context, d *schema.ResourceData, met
 	resp, err := conn.DescribeQuickConnectWithContext(ctx, input)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Quick Connect: %w", err))
+		return diagFromErr(fmt.Errorf("error getting Connect Quick Connect: %w", err))
 	}
 
 	if resp == nil || resp.QuickConnect == nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Quick Connect: empty response"))
+		return diagFromErr(fmt.Errorf("error getting Connect Quick Connect: empty response"))
 	}
 
 	quickConnect := resp.QuickConnect
@@ -148,12 +153,18 @@ func dataSourceQuickConnectRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set("name", quickConnect.Name)
 	d.Set("quick_connect_id", quickConnect.QuickConnectId)
 
-	if err := d.Set("quick_connect_config", flattenQuickConnectConfig(quickConnect.QuickConnectConfig)); err != nil {
-		return diag.FromErr(fmt.Errorf("error setting quick_connect_config: %s", err))
+	quickConnectConfig, err := flattenQuickConnectConfig(quickConnect.QuickConnectConfig)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error flattening quick_connect_config: %s", err))
+	}
+
+	if err := d.Set("quick_connect_config", quickConnectConfig); err != nil {
+		return diagFromErr(fmt.Errorf("error setting quick_connect_config: %s", err))
 	}
 
 	if err := d.Set("tags", KeyValueTags(ctx, quickConnect.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
-		return diag.FromErr(fmt.Errorf("error setting tags: %s", err))
+		return diagFromErr(fmt.Errorf("error setting tags: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(quickConnect.QuickConnectId)))
@@ -7,13 +7,13 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func testAccQuickConnect_phoneNumber(t *testing.T) {
@@ -222,14 +222,9 @@ func testAccCheckQuickConnectDestroy(ctx context.Context) resource.TestCheckFunc
 				return err
 			}
 
-			params := &connect.DescribeQuickConnectInput{
-				QuickConnectId: aws.String(quickConnectID),
-				InstanceId:     aws.String(instanceID),
-			}
-
-			_, err = conn.DescribeQuickConnectWithContext(ctx, params)
+			_, err = tfconnect.FindQuickConnectByID(ctx, conn, instanceID, quickConnectID)
 
-			if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+			if tfresource.NotFound(err) {
 				continue
 			}
 
@@ -0,0 +1,150 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_connect_quick_connects")
+func DataSourceQuickConnects() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceQuickConnectsRead,
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"quick_connect_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(connect.QuickConnectType_Values(), false),
+				},
+			},
+			"quick_connects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"quick_connect_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceQuickConnectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setDefaultInstanceID(d, meta); err != nil {
+		return diagFromErr(err)
+	}
+
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID := d.Get("instance_id").(string)
+
+	input := &connect.ListQuickConnectsInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int64(ListQuickConnectsMaxResults),
+	}
+
+	if v, ok := d.GetOk("quick_connect_types"); ok && v.(*schema.Set).Len() > 0 {
+		input.QuickConnectTypes = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	var quickConnects []*connect.QuickConnectSummary
+
+	err := conn.ListQuickConnectsPagesWithContext(ctx, input, func(page *connect.ListQuickConnectsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		quickConnects = append(quickConnects, page.QuickConnectSummaryList...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error listing Connect Quick Connects for Connect Instance (%s): %w", instanceID, err))
+	}
+
+	arns, ids := map[string]string{}, map[string]string{}
+
+	for _, v := range quickConnects {
+		if v == nil {
+			continue
+		}
+
+		// Quick connect names are unique within an instance.
+		arns[aws.StringValue(v.Name)] = aws.StringValue(v.Arn)
+		ids[aws.StringValue(v.Name)] = aws.StringValue(v.Id)
+	}
+
+	d.Set("arns", arns)
+	d.Set("ids", ids)
+
+	if err := d.Set("quick_connects", flattenQuickConnectSummaries(quickConnects)); err != nil {
+		return diagFromErr(fmt.Errorf("error setting quick_connects: %w", err))
+	}
+
+	d.SetId(instanceID)
+
+	return nil
+}
+
+func flattenQuickConnectSummaries(apiObjects []*connect.QuickConnectSummary) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"arn":                aws.StringValue(apiObject.Arn),
+			"id":                 aws.StringValue(apiObject.Id),
+			"name":               aws.StringValue(apiObject.Name),
+			"quick_connect_type": aws.StringValue(apiObject.QuickConnectType),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,56 @@
+package connect_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccQuickConnectsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_quick_connect.test"
+	datasourceName := "data.aws_connect_quick_connects.test"
+	phoneNumber := "+12345678912"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuickConnectsDataSourceConfig_basic(rName, rName2, phoneNumber),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttr(datasourceName, "quick_connects.#", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "quick_connects.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "quick_connects.0.id", resourceName, "quick_connect_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "quick_connects.0.name", resourceName, "name"),
+					resource.TestCheckResourceAttr(datasourceName, "quick_connects.0.quick_connect_type", "PHONE_NUMBER"),
+					resource.TestCheckResourceAttr(datasourceName, "arns.%", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, fmt.Sprintf("arns.%s", rName2), resourceName, "arn"),
+					resource.TestCheckResourceAttr(datasourceName, "ids.%", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, fmt.Sprintf("ids.%s", rName2), resourceName, "quick_connect_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccQuickConnectsDataSourceConfig_basic(rName, rName2, phoneNumber string) string {
+	return acctest.ConfigCompose(
+		testAccQuickConnectDataSourceConfig_base(rName, rName2, phoneNumber),
+		`
+data "aws_connect_quick_connects" "test" {
+  instance_id         = aws_connect_instance.test.id
+  quick_connect_types = ["PHONE_NUMBER"]
+
+  depends_on = [aws_connect_quick_connect.test]
+}
+`)
+}
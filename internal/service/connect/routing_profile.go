@@ -1,19 +1,30 @@
 package connect
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	connect_sdkv2 "github.com/aws/aws-sdk-go-v2/service/connect"
+	"github.com/aws/aws-sdk-go-v2/service/connect/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -21,6 +32,7 @@ import (
 const (
 	AssociateRoutingProfileQueuesMaxItems    = 10
 	DisassociateRoutingProfileQueuesMaxItems = 10
+	UpdateRoutingProfileQueuesMaxItems       = 10
 	CreateRoutingProfileQueuesMaxItems       = 10
 )
 
@@ -31,17 +43,28 @@ func ResourceRoutingProfile() *schema.Resource {
 		CreateWithoutTimeout: resourceRoutingProfileCreate,
 		ReadWithoutTimeout:   resourceRoutingProfileRead,
 		UpdateWithoutTimeout: resourceRoutingProfileUpdate,
-		// Routing profiles do not support deletion today. NoOp the Delete method.
-		// Users can rename their routing profiles manually if they want.
-		DeleteWithoutTimeout: schema.NoopContext,
+		DeleteWithoutTimeout: resourceRoutingProfileDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(routingProfileDeletedTimeout),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			defaultInstanceIDCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
+			"agent_availability_timer": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[types.AgentAvailabilityTimer](), // Valid values: TIME_SINCE_LAST_ACTIVITY | TIME_SINCE_LAST_INBOUND
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -57,13 +80,15 @@ func ResourceRoutingProfile() *schema.Resource {
 			},
 			"instance_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(1, 100),
 			},
 			"media_concurrencies": {
 				Type:     schema.TypeSet,
 				MinItems: 1,
 				Required: true,
+				Set:      routingProfileMediaConcurrencyHash,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"channel": {
@@ -76,6 +101,20 @@ func ResourceRoutingProfile() *schema.Resource {
 							Required:     true,
 							ValidateFunc: validation.IntBetween(1, 10),
 						},
+						"cross_channel_behavior": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"behavior_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(connect.BehaviorType_Values(), false), // Valid values: ROUTE_CURRENT_CHANNEL_ONLY | ROUTE_ANY_CHANNEL
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -152,25 +191,38 @@ func resourceRoutingProfileCreate(ctx context.Context, d *schema.ResourceData, m
 	output, err := conn.CreateRoutingProfileWithContext(ctx, input)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating Connect Routing Profile (%s): %w", name, err))
+		return diagFromErr(fmt.Errorf("error creating Connect Routing Profile (%s): %w", name, err))
 	}
 
 	if output == nil {
-		return diag.FromErr(fmt.Errorf("error creating Connect Routing Profile (%s): empty output", name))
+		return diagFromErr(fmt.Errorf("error creating Connect Routing Profile (%s): empty output", name))
 	}
 
 	// call the batched association API if the number of queues to associate with the routing profile is > CreateRoutingProfileQueuesMaxItems
 	if v, ok := d.GetOk("queue_configs"); ok && v.(*schema.Set).Len() > CreateRoutingProfileQueuesMaxItems {
-		queueConfigsUpdateRemove := make([]interface{}, 0)
-		err = updateQueueConfigs(ctx, conn, instanceID, aws.StringValue(output.RoutingProfileId), v.(*schema.Set).List(), queueConfigsUpdateRemove)
+		err = updateQueueConfigs(ctx, conn, instanceID, aws.StringValue(output.RoutingProfileId), v.(*schema.Set).List(), nil, nil)
 
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(output.RoutingProfileId)))
 
+	if v, ok := d.GetOk("agent_availability_timer"); ok {
+		input := &connect_sdkv2.UpdateRoutingProfileAgentAvailabilityTimerInput{
+			AgentAvailabilityTimer: types.AgentAvailabilityTimer(v.(string)),
+			InstanceId:             aws_sdkv2.String(instanceID),
+			RoutingProfileId:       output.RoutingProfileId,
+		}
+
+		_, err = meta.(*conns.AWSClient).ConnectClient().UpdateRoutingProfileAgentAvailabilityTimer(ctx, input)
+
+		if err != nil {
+			return diagFromErr(fmt.Errorf("setting Connect Routing Profile (%s) Agent Availability Timer: %w", d.Id(), err))
+		}
+	}
+
 	return resourceRoutingProfileRead(ctx, d, meta)
 }
 
@@ -180,7 +232,7 @@ func resourceRoutingProfileRead(ctx context.Context, d *schema.ResourceData, met
 	instanceID, routingProfileID, err := RoutingProfileParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	resp, err := conn.DescribeRoutingProfileWithContext(ctx, &connect.DescribeRoutingProfileInput{
@@ -195,17 +247,20 @@ func resourceRoutingProfileRead(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Routing Profile (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error getting Connect Routing Profile (%s): %w", d.Id(), err))
 	}
 
 	if resp == nil || resp.RoutingProfile == nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Routing Profile (%s): empty response", d.Id()))
+		return diagFromErr(fmt.Errorf("error getting Connect Routing Profile (%s): empty response", d.Id()))
 	}
 
 	routingProfile := resp.RoutingProfile
 
-	if err := d.Set("media_concurrencies", flattenRoutingProfileMediaConcurrencies(routingProfile.MediaConcurrencies)); err != nil {
-		return diag.FromErr(err)
+	mediaConcurrencies := flattenRoutingProfileMediaConcurrencies(routingProfile.MediaConcurrencies)
+	mediaConcurrencies = omitDefaultCrossChannelBehaviors(mediaConcurrencies, d.Get("media_concurrencies").(*schema.Set).List())
+
+	if err := d.Set("media_concurrencies", mediaConcurrencies); err != nil {
+		return diagFromErr(err)
 	}
 
 	d.Set("arn", routingProfile.RoutingProfileArn)
@@ -220,11 +275,19 @@ func resourceRoutingProfileRead(ctx context.Context, d *schema.ResourceData, met
 	queueConfigs, err := getRoutingProfileQueueConfigs(ctx, conn, instanceID, routingProfileID)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error finding Connect Routing Profile Queue Configs Summary by Routing Profile ID (%s): %w", routingProfileID, err))
+		return diagFromErr(fmt.Errorf("error finding Connect Routing Profile Queue Configs Summary by Routing Profile ID (%s): %w", routingProfileID, err))
 	}
 
 	d.Set("queue_configs", queueConfigs)
 
+	agentAvailabilityTimer, err := findRoutingProfileAgentAvailabilityTimer(ctx, meta.(*conns.AWSClient).ConnectClient(), instanceID, routingProfileID)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error finding Connect Routing Profile Agent Availability Timer for Routing Profile (%s): %w", routingProfileID, err))
+	}
+
+	d.Set("agent_availability_timer", agentAvailabilityTimer)
+
 	SetTagsOut(ctx, resp.RoutingProfile.Tags)
 
 	return nil
@@ -236,15 +299,31 @@ func resourceRoutingProfileUpdate(ctx context.Context, d *schema.ResourceData, m
 	instanceID, routingProfileID, err := RoutingProfileParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
-	// RoutingProfile has 4 update APIs
+	// RoutingProfile has 5 update APIs
+	// UpdateRoutingProfileAgentAvailabilityTimer: Updates whether agents are ordered by time since last activity or last inbound contact.
 	// UpdateRoutingProfileConcurrency: Updates the channels that agents can handle in the Contact Control Panel (CCP) for a routing profile.
 	// UpdateRoutingProfileDefaultOutboundQueue: Updates the default outbound queue of a routing profile.
 	// UpdateRoutingProfileName: Updates the name and description of a routing profile.
 	// UpdateRoutingProfileQueues: Updates the properties associated with a set of queues for a routing profile.
 
+	// updates to agent availability timer
+	if d.HasChange("agent_availability_timer") {
+		input := &connect_sdkv2.UpdateRoutingProfileAgentAvailabilityTimerInput{
+			AgentAvailabilityTimer: types.AgentAvailabilityTimer(d.Get("agent_availability_timer").(string)),
+			InstanceId:             aws_sdkv2.String(instanceID),
+			RoutingProfileId:       aws_sdkv2.String(routingProfileID),
+		}
+
+		_, err = meta.(*conns.AWSClient).ConnectClient().UpdateRoutingProfileAgentAvailabilityTimer(ctx, input)
+
+		if err != nil {
+			return diagFromErr(fmt.Errorf("updating RoutingProfile Agent Availability Timer (%s): %w", d.Id(), err))
+		}
+	}
+
 	// updates to concurrency
 	inputConcurrency := &connect.UpdateRoutingProfileConcurrencyInput{
 		InstanceId:       aws.String(instanceID),
@@ -256,7 +335,7 @@ func resourceRoutingProfileUpdate(ctx context.Context, d *schema.ResourceData, m
 		inputConcurrency.MediaConcurrencies = mediaConcurrencies
 		_, err = conn.UpdateRoutingProfileConcurrencyWithContext(ctx, inputConcurrency)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("updating RoutingProfile Media Concurrency (%s): %w", d.Id(), err))
+			return diagFromErr(fmt.Errorf("updating RoutingProfile Media Concurrency (%s): %w", d.Id(), err))
 		}
 	}
 
@@ -271,7 +350,7 @@ func resourceRoutingProfileUpdate(ctx context.Context, d *schema.ResourceData, m
 		_, err = conn.UpdateRoutingProfileDefaultOutboundQueueWithContext(ctx, inputDefaultOutboundQueue)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("updating RoutingProfile Default Outbound Queue ID (%s): %w", d.Id(), err))
+			return diagFromErr(fmt.Errorf("updating RoutingProfile Default Outbound Queue ID (%s): %w", d.Id(), err))
 		}
 	}
 
@@ -287,7 +366,7 @@ func resourceRoutingProfileUpdate(ctx context.Context, d *schema.ResourceData, m
 		_, err = conn.UpdateRoutingProfileNameWithContext(ctx, inputNameDesc)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("updating RoutingProfile Name (%s): %w", d.Id(), err))
+			return diagFromErr(fmt.Errorf("updating RoutingProfile Name (%s): %w", d.Id(), err))
 		}
 	}
 
@@ -296,8 +375,7 @@ func resourceRoutingProfileUpdate(ctx context.Context, d *schema.ResourceData, m
 	// AssociateRoutingProfileQueues - Associates a set of queues with a routing profile.
 	// DisassociateRoutingProfileQueues - Disassociates a set of queues from a routing profile.
 	// UpdateRoutingProfileQueues - Updates the properties associated with a set of queues for a routing profile.
-	// since the update only updates the existing queues that are associated, we will instead disassociate and associate
-	// the respective queues based on the diff detected
+	// queues are matched on queue and channel, so only changed delays and priorities of associated queues are updated in place
 	if d.HasChange("queue_configs") {
 		o, n := d.GetChange("queue_configs")
 
@@ -308,88 +386,97 @@ func resourceRoutingProfileUpdate(ctx context.Context, d *schema.ResourceData, m
 			n = new(schema.Set)
 		}
 
-		os := o.(*schema.Set)
-		ns := n.(*schema.Set)
-		queueConfigsUpdateAdd := ns.Difference(os).List()
-		queueConfigsUpdateRemove := os.Difference(ns).List()
+		queueConfigsUpdateAdd, queueConfigsUpdateRemove, queueConfigsUpdate := flex.DiffSlices(o.(*schema.Set).List(), n.(*schema.Set).List(), routingProfileQueueConfigKey, routingProfileQueueConfigEqual)
 
-		err = updateQueueConfigs(ctx, conn, instanceID, routingProfileID, queueConfigsUpdateAdd, queueConfigsUpdateRemove)
+		err = updateQueueConfigs(ctx, conn, instanceID, routingProfileID, queueConfigsUpdateAdd, queueConfigsUpdateRemove, queueConfigsUpdate)
 
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	return resourceRoutingProfileRead(ctx, d, meta)
 }
 
-func updateQueueConfigs(ctx context.Context, conn *connect.Connect, instanceID, routingProfileID string, queueConfigsUpdateAdd, queueConfigsUpdateRemove []interface{}) error {
-	// updates to queue configs
-	// There are 3 APIs for this
-	// AssociateRoutingProfileQueues - Associates a set of queues with a routing profile.
-	// DisassociateRoutingProfileQueues - Disassociates a set of queues from a routing profile.
-	// UpdateRoutingProfileQueues - Updates the properties associated with a set of queues for a routing profile.
-	// since the update only updates the existing queues that are associated, we will instead disassociate and associate
-	// the respective queues based on the diff detected
+// routingProfileQueueConfigKey identifies a queue config, as a queue can only be associated once per channel.
+func routingProfileQueueConfigKey(v interface{}) string {
+	tfMap := v.(map[string]interface{})
 
+	return tfMap["queue_id"].(string) + ":" + tfMap["channel"].(string)
+}
+
+func routingProfileQueueConfigEqual(a, b interface{}) bool {
+	tfMapA, tfMapB := a.(map[string]interface{}), b.(map[string]interface{})
+
+	return tfMapA["delay"] == tfMapB["delay"] && tfMapA["priority"] == tfMapB["priority"]
+}
+
+func updateQueueConfigs(ctx context.Context, conn *connect.Connect, instanceID, routingProfileID string, queueConfigsUpdateAdd, queueConfigsUpdateRemove, queueConfigsUpdate []interface{}) error {
 	// disassociate first since Queue and channel type combination cannot be duplicated
-	if len(queueConfigsUpdateRemove) > 0 {
-		for i := 0; i < len(queueConfigsUpdateRemove); i += DisassociateRoutingProfileQueuesMaxItems {
-			j := i + DisassociateRoutingProfileQueuesMaxItems
-			if j > len(queueConfigsUpdateRemove) {
-				j = len(queueConfigsUpdateRemove)
-			}
-			_, err := conn.DisassociateRoutingProfileQueuesWithContext(ctx, &connect.DisassociateRoutingProfileQueuesInput{
-				InstanceId:       aws.String(instanceID),
-				QueueReferences:  expandRoutingProfileQueueReferences(queueConfigsUpdateRemove[i:j]),
-				RoutingProfileId: aws.String(routingProfileID),
-			})
-			if err != nil {
-				return fmt.Errorf("updating RoutingProfile Queue Configs, specifically disassociating queues from routing profile (%s): %s", routingProfileID, err)
-			}
+	for _, chunk := range slices.Chunks(queueConfigsUpdateRemove, DisassociateRoutingProfileQueuesMaxItems) {
+		_, err := conn.DisassociateRoutingProfileQueuesWithContext(ctx, &connect.DisassociateRoutingProfileQueuesInput{
+			InstanceId:       aws.String(instanceID),
+			QueueReferences:  expandRoutingProfileQueueReferences(chunk),
+			RoutingProfileId: aws.String(routingProfileID),
+		})
+		if err != nil {
+			return fmt.Errorf("updating RoutingProfile Queue Configs, specifically disassociating queues from routing profile (%s): %s", routingProfileID, err)
 		}
 	}
 
-	if len(queueConfigsUpdateAdd) > 0 {
-		for i := 0; i < len(queueConfigsUpdateAdd); i += AssociateRoutingProfileQueuesMaxItems {
-			j := i + AssociateRoutingProfileQueuesMaxItems
-			if j > len(queueConfigsUpdateAdd) {
-				j = len(queueConfigsUpdateAdd)
-			}
-			_, err := conn.AssociateRoutingProfileQueuesWithContext(ctx, &connect.AssociateRoutingProfileQueuesInput{
-				InstanceId:       aws.String(instanceID),
-				QueueConfigs:     expandRoutingProfileQueueConfigs(queueConfigsUpdateAdd[i:j]),
-				RoutingProfileId: aws.String(routingProfileID),
-			})
-			if err != nil {
-				return fmt.Errorf("updating RoutingProfile Queue Configs, specifically associating queues to routing profile (%s): %s", routingProfileID, err)
-			}
+	for _, chunk := range slices.Chunks(queueConfigsUpdate, UpdateRoutingProfileQueuesMaxItems) {
+		_, err := conn.UpdateRoutingProfileQueuesWithContext(ctx, &connect.UpdateRoutingProfileQueuesInput{
+			InstanceId:       aws.String(instanceID),
+			QueueConfigs:     expandRoutingProfileQueueConfigs(chunk),
+			RoutingProfileId: aws.String(routingProfileID),
+		})
+		if err != nil {
+			return fmt.Errorf("updating RoutingProfile Queue Configs, specifically updating queues of routing profile (%s): %s", routingProfileID, err)
+		}
+	}
+
+	for _, chunk := range slices.Chunks(queueConfigsUpdateAdd, AssociateRoutingProfileQueuesMaxItems) {
+		_, err := conn.AssociateRoutingProfileQueuesWithContext(ctx, &connect.AssociateRoutingProfileQueuesInput{
+			InstanceId:       aws.String(instanceID),
+			QueueConfigs:     expandRoutingProfileQueueConfigs(chunk),
+			RoutingProfileId: aws.String(routingProfileID),
+		})
+		if err != nil {
+			return fmt.Errorf("updating RoutingProfile Queue Configs, specifically associating queues to routing profile (%s): %s", routingProfileID, err)
 		}
 	}
 
 	return nil
 }
 
-// func resourceRoutingProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-// 	conn := meta.(*conns.AWSClient).ConnectConn()
+func resourceRoutingProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).ConnectClient()
+
+	instanceID, routingProfileID, err := RoutingProfileParseID(d.Id())
 
-// 	instanceID, routingProfileID, err := RoutingProfileParseID(d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
 
-// 	if err != nil {
-// 		return diag.FromErr(err)
-// 	}
+	log.Printf("[DEBUG] Deleting Connect Routing Profile: %s", d.Id())
+	// Deletion fails while users that were assigned the routing profile are still being updated.
+	_, err = tfresource.RetryWhenConflict(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return client.DeleteRoutingProfile(ctx, &connect_sdkv2.DeleteRoutingProfileInput{
+			InstanceId:       aws_sdkv2.String(instanceID),
+			RoutingProfileId: aws_sdkv2.String(routingProfileID),
+		})
+	})
 
-// 	_, err = conn.DeleteRoutingProfileWithContext(ctx, &connect.DeleteRoutingProfileInput{
-// 		InstanceId:       aws.String(instanceID),
-// 		RoutingProfileId: aws.String(routingProfileID),
-// 	})
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
 
-// 	if err != nil {
-// 		return diag.FromErr(fmt.Errorf("error deleting RoutingProfile (%s): %w", d.Id(), err))
-// 	}
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error deleting RoutingProfile (%s): %w", d.Id(), err))
+	}
 
-// 	return nil
-// }
+	return nil
+}
 
 func expandRoutingProfileMediaConcurrencies(mediaConcurrencies []interface{}) []*connect.MediaConcurrency {
 	if len(mediaConcurrencies) == 0 {
@@ -404,12 +491,68 @@ func expandRoutingProfileMediaConcurrencies(mediaConcurrencies []interface{}) []
 			Channel:     aws.String(data["channel"].(string)),
 			Concurrency: aws.Int64(int64(data["concurrency"].(int))),
 		}
+
+		if v, ok := data["cross_channel_behavior"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			mediaConcurrencyExpanded.CrossChannelBehavior = &connect.CrossChannelBehavior{
+				BehaviorType: aws.String(v[0].(map[string]interface{})["behavior_type"].(string)),
+			}
+		}
 		mediaConcurrenciesExpanded = append(mediaConcurrenciesExpanded, mediaConcurrencyExpanded)
 	}
 
 	return mediaConcurrenciesExpanded
 }
 
+// routingProfileMediaConcurrencyHash hashes a media_concurrencies element. An omitted cross_channel_behavior
+// hashes as the ROUTE_CURRENT_CHANNEL_ONLY behavior the API returns by default, so that it does not show a diff.
+func routingProfileMediaConcurrencyHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	tfMap := v.(map[string]interface{})
+	behaviorType := connect.BehaviorTypeRouteCurrentChannelOnly
+
+	if v, ok := tfMap["cross_channel_behavior"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v, ok := v[0].(map[string]interface{})["behavior_type"].(string); ok && v != "" {
+			behaviorType = v
+		}
+	}
+
+	buf.WriteString(fmt.Sprintf("%s-", tfMap["channel"].(string)))
+	buf.WriteString(fmt.Sprintf("%v-", tfMap["concurrency"]))
+	buf.WriteString(behaviorType)
+
+	return create.StringHashcode(buf.String())
+}
+
+// omitDefaultCrossChannelBehaviors removes the ROUTE_CURRENT_CHANNEL_ONLY cross_channel_behavior that the API
+// returns when none is configured from the flattened media_concurrencies, unless the prior state of the
+// channel's element has a cross_channel_behavior. An omitted block then stays omitted in state.
+func omitDefaultCrossChannelBehaviors(tfList, prior []interface{}) []interface{} {
+	configured := make(map[string]bool)
+
+	for _, v := range prior {
+		if tfMap, ok := v.(map[string]interface{}); ok {
+			if v, ok := tfMap["cross_channel_behavior"].([]interface{}); ok && len(v) > 0 {
+				configured[tfMap["channel"].(string)] = true
+			}
+		}
+	}
+
+	for _, v := range tfList {
+		tfMap := v.(map[string]interface{})
+
+		if configured[tfMap["channel"].(string)] {
+			continue
+		}
+
+		if v, ok := tfMap["cross_channel_behavior"].([]interface{}); ok && len(v) > 0 && v[0].(map[string]interface{})["behavior_type"] == connect.BehaviorTypeRouteCurrentChannelOnly {
+			delete(tfMap, "cross_channel_behavior")
+		}
+	}
+
+	return tfList
+}
+
 func flattenRoutingProfileMediaConcurrencies(mediaConcurrencies []*connect.MediaConcurrency) []interface{} {
 	mediaConcurrenciesList := []interface{}{}
 
@@ -419,6 +562,14 @@ func flattenRoutingProfileMediaConcurrencies(mediaConcurrencies []*connect.Media
 			"concurrency": aws.Int64Value(mediaConcurrency.Concurrency),
 		}
 
+		if v := mediaConcurrency.CrossChannelBehavior; v != nil {
+			values["cross_channel_behavior"] = []interface{}{
+				map[string]interface{}{
+					"behavior_type": aws.StringValue(v.BehaviorType),
+				},
+			}
+		}
+
 		mediaConcurrenciesList = append(mediaConcurrenciesList, values)
 	}
 	return mediaConcurrenciesList
@@ -470,6 +621,25 @@ func expandRoutingProfileQueueReferences(queueConfigs []interface{}) []*connect.
 	return queueReferencesExpanded
 }
 
+func findRoutingProfileAgentAvailabilityTimer(ctx context.Context, client *connect_sdkv2.Client, instanceID, routingProfileID string) (string, error) {
+	input := &connect_sdkv2.DescribeRoutingProfileInput{
+		InstanceId:       aws_sdkv2.String(instanceID),
+		RoutingProfileId: aws_sdkv2.String(routingProfileID),
+	}
+
+	output, err := client.DescribeRoutingProfile(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.RoutingProfile == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return string(output.RoutingProfile.AgentAvailabilityTimer), nil
+}
+
 func getRoutingProfileQueueConfigs(ctx context.Context, conn *connect.Connect, instanceID, routingProfileID string) ([]interface{}, error) {
 	queueConfigsList := []interface{}{}
 
@@ -32,7 +32,8 @@ func DataSourceRoutingProfile() *schema.Resource {
 			},
 			"instance_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(1, 100),
 			},
 			"media_concurrencies": {
@@ -48,6 +49,18 @@ func DataSourceRoutingProfile() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
+						"cross_channel_behavior": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"behavior_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -101,46 +114,42 @@ func DataSourceRoutingProfile() *schema.Resource {
 }
 
 func dataSourceRoutingProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setDefaultInstanceID(d, meta); err != nil {
+		return diagFromErr(err)
+	}
+
 	conn := meta.(*conns.AWSClient).ConnectConn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	instanceID := d.Get("instance_id").(string)
 
-	input := &connect.DescribeRoutingProfileInput{
-		InstanceId: aws.String(instanceID),
-	}
+	var routingProfileID string
 
 	if v, ok := d.GetOk("routing_profile_id"); ok {
-		input.RoutingProfileId = aws.String(v.(string))
+		routingProfileID = v.(string)
 	} else if v, ok := d.GetOk("name"); ok {
 		name := v.(string)
 		routingProfileSummary, err := dataSourceGetRoutingProfileSummaryByName(ctx, conn, instanceID, name)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error finding Connect Routing Profile Summary by name (%s): %w", name, err))
+			return diagFromErr(fmt.Errorf("error finding Connect Routing Profile Summary by name (%s): %w", name, err))
 		}
 
 		if routingProfileSummary == nil {
-			return diag.FromErr(fmt.Errorf("error finding Connect Routing Profile Summary by name (%s): not found", name))
+			return diagFromErr(fmt.Errorf("error finding Connect Routing Profile Summary by name (%s): not found", name))
 		}
 
-		input.RoutingProfileId = routingProfileSummary.Id
+		routingProfileID = aws.StringValue(routingProfileSummary.Id)
 	}
 
-	resp, err := conn.DescribeRoutingProfileWithContext(ctx, input)
+	routingProfile, err := FindRoutingProfileByID(ctx, conn, instanceID, routingProfileID)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Routing Profile: %w", err))
+		return diagFromErr(fmt.Errorf("error getting Connect Routing Profile (%s): %w", routingProfileID, err))
 	}
 
-	if resp == nil || resp.RoutingProfile == nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Routing Profile: empty response"))
-	}
-
-	routingProfile := resp.RoutingProfile
-
 	if err := d.Set("media_concurrencies", flattenRoutingProfileMediaConcurrencies(routingProfile.MediaConcurrencies)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.Set("arn", routingProfile.RoutingProfileArn)
@@ -151,16 +160,16 @@ func dataSourceRoutingProfileRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("routing_profile_id", routingProfile.RoutingProfileId)
 
 	// getting the routing profile queues uses a separate API: ListRoutingProfileQueues
-	queueConfigs, err := getRoutingProfileQueueConfigs(ctx, conn, instanceID, *routingProfile.RoutingProfileId)
+	queueConfigs, err := getRoutingProfileQueueConfigs(ctx, conn, instanceID, routingProfileID)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error finding Connect Routing Profile Queue Configs Summary by Routing Profile ID (%s): %w", *routingProfile.RoutingProfileId, err))
+		return diagFromErr(fmt.Errorf("error finding Connect Routing Profile Queue Configs Summary by Routing Profile ID (%s): %w", routingProfileID, err))
 	}
 
 	d.Set("queue_configs", queueConfigs)
 
 	if err := d.Set("tags", KeyValueTags(ctx, routingProfile.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
-		return diag.FromErr(fmt.Errorf("error setting tags: %s", err))
+		return diagFromErr(fmt.Errorf("error setting tags: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(routingProfile.RoutingProfileId)))
@@ -7,13 +7,13 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func testAccRoutingProfile_basic(t *testing.T) {
@@ -53,6 +53,11 @@ func testAccRoutingProfile_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				// The cross_channel_behavior the API returns by default does not show a diff when omitted.
+				Config:   testAccRoutingProfileConfig_basic(rName, rName2, rName3, originalDescription),
+				PlanOnly: true,
+			},
 			{
 				Config: testAccRoutingProfileConfig_basic(rName, rName2, rName3, updatedDescription),
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -74,8 +79,6 @@ func testAccRoutingProfile_basic(t *testing.T) {
 }
 
 func testAccRoutingProfile_disappears(t *testing.T) {
-	t.Skip("Routing Profiles do not support deletion today")
-
 	ctx := acctest.Context(t)
 
 	var v connect.DescribeRoutingProfileOutput
@@ -156,6 +159,60 @@ func testAccRoutingProfile_updateConcurrency(t *testing.T) {
 	})
 }
 
+func testAccRoutingProfile_updateAgentAvailabilityTimer(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.DescribeRoutingProfileOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName3 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_routing_profile.test"
+	description := "testAgentAvailabilityTimer"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoutingProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoutingProfileConfig_agentAvailabilityTimer(rName, rName2, rName3, description, "TIME_SINCE_LAST_INBOUND", connect.BehaviorTypeRouteCurrentChannelOnly),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoutingProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "agent_availability_timer", "TIME_SINCE_LAST_INBOUND"),
+					resource.TestCheckResourceAttr(resourceName, "media_concurrencies.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "media_concurrencies.*", map[string]string{
+						"channel":                                connect.ChannelChat,
+						"concurrency":                            "2",
+						"cross_channel_behavior.#":               "1",
+						"cross_channel_behavior.0.behavior_type": connect.BehaviorTypeRouteCurrentChannelOnly,
+					}),
+				),
+			},
+			{
+				Config: testAccRoutingProfileConfig_agentAvailabilityTimer(rName, rName2, rName3, description, "TIME_SINCE_LAST_ACTIVITY", connect.BehaviorTypeRouteAnyChannel),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRoutingProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "agent_availability_timer", "TIME_SINCE_LAST_ACTIVITY"),
+					resource.TestCheckResourceAttr(resourceName, "media_concurrencies.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "media_concurrencies.*", map[string]string{
+						"channel":                                connect.ChannelChat,
+						"concurrency":                            "2",
+						"cross_channel_behavior.#":               "1",
+						"cross_channel_behavior.0.behavior_type": connect.BehaviorTypeRouteAnyChannel,
+					}),
+				),
+			},
+			{
+				// An imported ROUTE_CURRENT_CHANNEL_ONLY behavior is indistinguishable from an omitted one,
+				// so the import is verified with a behavior that is not the default.
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccRoutingProfile_updateDefaultOutboundQueue(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v connect.DescribeRoutingProfileOutput
@@ -655,6 +712,43 @@ func testAccRoutingProfile_updateQueueConfigsBatchedAssociateDisassociate(t *tes
 	})
 }
 
+func testAccRoutingProfile_queueConfigsDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.DescribeRoutingProfileOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName3 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_routing_profile.test"
+	description := "testQueueConfigsDrift"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoutingProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// Disassociating the queue outside of Terraform must show up in the plan
+				Config: testAccRoutingProfileConfig_queue1(rName, rName2, rName3, description),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoutingProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "queue_configs.#", "1"),
+					testAccCheckRoutingProfileDisassociateQueues(ctx, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRoutingProfileConfig_queue1(rName, rName2, rName3, description),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoutingProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "queue_configs.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "queue_configs.0.queue_id", "aws_connect_queue.default_outbound_queue", "queue_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckRoutingProfileExists(ctx context.Context, resourceName string, function *connect.DescribeRoutingProfileOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -689,6 +783,55 @@ func testAccCheckRoutingProfileExists(ctx context.Context, resourceName string,
 	}
 }
 
+// testAccCheckRoutingProfileDisassociateQueues removes all queue associations from the routing profile
+// outside of Terraform, the way a change made in the Amazon Connect console would.
+func testAccCheckRoutingProfileDisassociateQueues(ctx context.Context, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Connect Routing Profile not found: %s", resourceName)
+		}
+
+		instanceID, routingProfileID, err := tfconnect.RoutingProfileParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn()
+
+		output, err := conn.ListRoutingProfileQueuesWithContext(ctx, &connect.ListRoutingProfileQueuesInput{
+			InstanceId:       aws.String(instanceID),
+			RoutingProfileId: aws.String(routingProfileID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		var queueReferences []*connect.RoutingProfileQueueReference
+
+		for _, qc := range output.RoutingProfileQueueConfigSummaryList {
+			queueReferences = append(queueReferences, &connect.RoutingProfileQueueReference{
+				Channel: qc.Channel,
+				QueueId: qc.QueueId,
+			})
+		}
+
+		if len(queueReferences) == 0 {
+			return fmt.Errorf("Connect Routing Profile (%s) has no associated queues", rs.Primary.ID)
+		}
+
+		_, err = conn.DisassociateRoutingProfileQueuesWithContext(ctx, &connect.DisassociateRoutingProfileQueuesInput{
+			InstanceId:       aws.String(instanceID),
+			QueueReferences:  queueReferences,
+			RoutingProfileId: aws.String(routingProfileID),
+		})
+
+		return err
+	}
+}
+
 func testAccCheckRoutingProfileDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -704,20 +847,17 @@ func testAccCheckRoutingProfileDestroy(ctx context.Context) resource.TestCheckFu
 				return err
 			}
 
-			params := &connect.DescribeRoutingProfileInput{
-				InstanceId:       aws.String(instanceID),
-				RoutingProfileId: aws.String(routingProfileID),
-			}
-
-			_, err = conn.DescribeRoutingProfileWithContext(ctx, params)
+			_, err = tfconnect.FindRoutingProfileByID(ctx, conn, instanceID, routingProfileID)
 
-			if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+			if tfresource.NotFound(err) {
 				continue
 			}
 
 			if err != nil {
 				return err
 			}
+
+			return fmt.Errorf("Connect Routing Profile %s still exists", rs.Primary.ID)
 		}
 
 		return nil
@@ -796,6 +936,38 @@ resource "aws_connect_routing_profile" "test" {
 `, rName3, label))
 }
 
+func testAccRoutingProfileConfig_agentAvailabilityTimer(rName, rName2, rName3, label, agentAvailabilityTimer, behaviorType string) string {
+	return acctest.ConfigCompose(
+		testAccRoutingProfileConfig_base(rName, rName2),
+		fmt.Sprintf(`
+resource "aws_connect_routing_profile" "test" {
+  instance_id               = aws_connect_instance.test.id
+  name                      = %[1]q
+  default_outbound_queue_id = aws_connect_queue.default_outbound_queue.queue_id
+  description               = %[2]q
+  agent_availability_timer  = %[3]q
+
+  media_concurrencies {
+    channel     = "VOICE"
+    concurrency = 1
+  }
+
+  media_concurrencies {
+    channel     = "CHAT"
+    concurrency = 2
+
+    cross_channel_behavior {
+      behavior_type = %[4]q
+    }
+  }
+
+  tags = {
+    "Name" = "Test Routing Profile",
+  }
+}
+`, rName3, label, agentAvailabilityTimer, behaviorType))
+}
+
 func testAccRoutingProfileConfig_defaultOutboundQueue(rName, rName2, rName3, rName4, selectDefaultOutboundQueue string) string {
 	return acctest.ConfigCompose(
 		testAccRoutingProfileConfig_base(rName, rName2),
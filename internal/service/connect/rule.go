@@ -0,0 +1,918 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	connect_sdkv2 "github.com/aws/aws-sdk-go-v2/service/connect"
+	"github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ruleActionEventSources lists the trigger event sources that each action type is supported for.
+// Action types that are not listed are supported for all event sources.
+var ruleActionEventSources = map[string][]types.EventSourceName{
+	"assign_contact_category_action": {
+		types.EventSourceNameOnPostCallAnalysisAvailable,
+		types.EventSourceNameOnRealTimeCallAnalysisAvailable,
+		types.EventSourceNameOnRealTimeChatAnalysisAvailable,
+		types.EventSourceNameOnPostChatAnalysisAvailable,
+		types.EventSourceNameOnZendeskTicketCreate,
+		types.EventSourceNameOnZendeskTicketStatusUpdate,
+		types.EventSourceNameOnSalesforceCaseCreate,
+	},
+	"create_case_action": {
+		types.EventSourceNameOnPostCallAnalysisAvailable,
+		types.EventSourceNameOnPostChatAnalysisAvailable,
+	},
+	"end_associated_tasks_action": {
+		types.EventSourceNameOnCaseUpdate,
+	},
+	"event_bridge_action": {
+		types.EventSourceNameOnPostCallAnalysisAvailable,
+		types.EventSourceNameOnRealTimeCallAnalysisAvailable,
+		types.EventSourceNameOnRealTimeChatAnalysisAvailable,
+		types.EventSourceNameOnPostChatAnalysisAvailable,
+		types.EventSourceNameOnContactEvaluationSubmit,
+		types.EventSourceNameOnMetricDataUpdate,
+	},
+	"send_notification_action": {
+		types.EventSourceNameOnPostCallAnalysisAvailable,
+		types.EventSourceNameOnRealTimeCallAnalysisAvailable,
+		types.EventSourceNameOnRealTimeChatAnalysisAvailable,
+		types.EventSourceNameOnPostChatAnalysisAvailable,
+		types.EventSourceNameOnContactEvaluationSubmit,
+		types.EventSourceNameOnMetricDataUpdate,
+	},
+	"update_case_action": {
+		types.EventSourceNameOnCaseCreate,
+		types.EventSourceNameOnCaseUpdate,
+	},
+}
+
+// @SDKResource("aws_connect_rule", name="Rule")
+// @Tags(identifierAttribute="arn")
+func ResourceRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRuleCreate,
+		ReadWithoutTimeout:   resourceRuleRead,
+		UpdateWithoutTimeout: resourceRuleUpdate,
+		DeleteWithoutTimeout: resourceRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customdiff.Sequence(
+			defaultInstanceIDCustomizeDiff,
+			resourceRuleCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+		Schema: map[string]*schema.Schema{
+			"actions": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"assign_contact_category_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     &schema.Resource{Schema: map[string]*schema.Schema{}},
+						},
+						"create_case_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field": ruleFieldValueSchema(),
+									"template_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 500),
+									},
+								},
+							},
+						},
+						"create_task_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"contact_flow_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 500),
+									},
+									"description": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 4096),
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+									"reference": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"type": {
+													Type:             schema.TypeString,
+													Required:         true,
+													ValidateDiagFunc: enum.Validate[types.ReferenceType](),
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"end_associated_tasks_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     &schema.Resource{Schema: map[string]*schema.Schema{}},
+						},
+						"event_bridge_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 100),
+									},
+								},
+							},
+						},
+						"send_notification_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"content": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"content_type": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.NotificationContentType](), // Valid values: PLAIN_TEXT
+									},
+									"delivery_method": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.NotificationDeliveryType](), // Valid values: EMAIL
+									},
+									"recipient": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"user_ids": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													MaxItems: 20,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"user_tags": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"subject": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 200),
+									},
+								},
+							},
+						},
+						"submit_auto_evaluation_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"evaluation_form_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 500),
+									},
+								},
+							},
+						},
+						"update_case_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field": ruleFieldValueSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+			"publish_status": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.RulePublishStatus](), // Valid values: DRAFT | PUBLISHED
+			},
+			"rule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"trigger_event_source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_source_name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.EventSourceName](),
+						},
+						"integration_association_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 200),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ruleFieldValueSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 500),
+				},
+				"value": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"boolean_value": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"double_value": {
+								Type:     schema.TypeFloat,
+								Optional: true,
+							},
+							"empty_value": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"string_value": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+	client := meta.(*conns.AWSClient).ConnectClient()
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+
+	input := &connect_sdkv2.CreateRuleInput{
+		Actions:            expandRuleActions(d.Get("actions").([]interface{})),
+		Function:           aws_sdkv2.String(d.Get("function").(string)),
+		InstanceId:         aws_sdkv2.String(instanceID),
+		Name:               aws_sdkv2.String(name),
+		PublishStatus:      types.RulePublishStatus(d.Get("publish_status").(string)),
+		TriggerEventSource: expandRuleTriggerEventSource(d.Get("trigger_event_source").([]interface{})),
+	}
+
+	output, err := client.CreateRule(ctx, input)
+
+	if err != nil {
+		return diagErrorf("creating Connect Rule (%s): %s", name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws_sdkv2.ToString(output.RuleId)))
+
+	// CreateRule does not accept tags.
+	if tags := GetTagsIn(ctx); len(tags) > 0 {
+		if err := UpdateTags(ctx, conn, aws_sdkv2.ToString(output.RuleArn), nil, tags); err != nil {
+			return diagErrorf("setting Connect Rule (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceRuleRead(ctx, d, meta)
+}
+
+func resourceRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).ConnectClient()
+
+	instanceID, ruleID, err := RuleParseID(d.Id())
+
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	rule, err := FindRuleByID(ctx, client, instanceID, ruleID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diagErrorf("reading Connect Rule (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("actions", flattenRuleActions(rule.Actions)); err != nil {
+		return diagErrorf("setting actions: %s", err)
+	}
+	d.Set("arn", rule.RuleArn)
+	d.Set("function", rule.Function)
+	d.Set("instance_id", instanceID)
+	d.Set("name", rule.Name)
+	d.Set("publish_status", rule.PublishStatus)
+	d.Set("rule_id", rule.RuleId)
+	if err := d.Set("trigger_event_source", flattenRuleTriggerEventSource(rule.TriggerEventSource)); err != nil {
+		return diagErrorf("setting trigger_event_source: %s", err)
+	}
+
+	SetTagsOut(ctx, aws_sdkv2.StringMap(rule.Tags))
+
+	return nil
+}
+
+func resourceRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).ConnectClient()
+
+	instanceID, ruleID, err := RuleParseID(d.Id())
+
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	if d.HasChanges("actions", "function", "name", "publish_status") {
+		input := &connect_sdkv2.UpdateRuleInput{
+			Actions:       expandRuleActions(d.Get("actions").([]interface{})),
+			Function:      aws_sdkv2.String(d.Get("function").(string)),
+			InstanceId:    aws_sdkv2.String(instanceID),
+			Name:          aws_sdkv2.String(d.Get("name").(string)),
+			PublishStatus: types.RulePublishStatus(d.Get("publish_status").(string)),
+			RuleId:        aws_sdkv2.String(ruleID),
+		}
+
+		_, err := client.UpdateRule(ctx, input)
+
+		if err != nil {
+			return diagErrorf("updating Connect Rule (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceRuleRead(ctx, d, meta)
+}
+
+func resourceRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).ConnectClient()
+
+	instanceID, ruleID, err := RuleParseID(d.Id())
+
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Connect Rule: %s", d.Id())
+	_, err = client.DeleteRule(ctx, &connect_sdkv2.DeleteRuleInput{
+		InstanceId: aws_sdkv2.String(instanceID),
+		RuleId:     aws_sdkv2.String(ruleID),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diagErrorf("deleting Connect Rule (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceRuleCustomizeDiff validates the configured actions against the trigger event source,
+// so that unsupported combinations are reported at plan time.
+func resourceRuleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	eventSourceName := types.EventSourceName(diff.Get("trigger_event_source.0.event_source_name").(string))
+
+	if eventSourceName == "" {
+		return nil
+	}
+
+	var invalid []string
+
+	for action, eventSourceNames := range ruleActionEventSources {
+		if v, ok := diff.GetOk("actions.0." + action); !ok || len(v.([]interface{})) == 0 {
+			continue
+		}
+
+		supported := false
+
+		for _, v := range eventSourceNames {
+			if v == eventSourceName {
+				supported = true
+				break
+			}
+		}
+
+		if !supported {
+			invalid = append(invalid, fmt.Sprintf("%s is not supported for trigger event source %s", action, eventSourceName))
+		}
+	}
+
+	switch eventSourceName {
+	case types.EventSourceNameOnZendeskTicketCreate, types.EventSourceNameOnZendeskTicketStatusUpdate, types.EventSourceNameOnSalesforceCaseCreate:
+		if v, ok := diff.GetOk("actions.0.create_task_action"); !ok || len(v.([]interface{})) == 0 {
+			invalid = append(invalid, fmt.Sprintf("create_task_action is required for trigger event source %s", eventSourceName))
+		}
+	}
+
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid actions: %s", strings.Join(invalid, "; "))
+	}
+
+	return nil
+}
+
+func FindRuleByID(ctx context.Context, client *connect_sdkv2.Client, instanceID, ruleID string) (*types.Rule, error) {
+	input := &connect_sdkv2.DescribeRuleInput{
+		InstanceId: aws_sdkv2.String(instanceID),
+		RuleId:     aws_sdkv2.String(ruleID),
+	}
+
+	output, err := client.DescribeRule(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Rule == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Rule, nil
+}
+
+func RuleParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected instanceID:ruleID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func expandRuleTriggerEventSource(tfList []interface{}) *types.RuleTriggerEventSource {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &types.RuleTriggerEventSource{
+		EventSourceName: types.EventSourceName(tfMap["event_source_name"].(string)),
+	}
+
+	if v, ok := tfMap["integration_association_id"].(string); ok && v != "" {
+		apiObject.IntegrationAssociationId = aws_sdkv2.String(v)
+	}
+
+	return apiObject
+}
+
+// expandRuleActions returns the actions grouped by action type.
+func expandRuleActions(tfList []interface{}) []types.RuleAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObjects := []types.RuleAction{}
+
+	if v, ok := tfMap["assign_contact_category_action"].([]interface{}); ok && len(v) > 0 {
+		apiObjects = append(apiObjects, types.RuleAction{
+			ActionType:                  types.ActionTypeAssignContactCategory,
+			AssignContactCategoryAction: &types.AssignContactCategoryActionDefinition{},
+		})
+	}
+
+	for _, tfMapRaw := range tfMap["create_case_action"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.RuleAction{
+			ActionType: types.ActionTypeCreateCase,
+			CreateCaseAction: &types.CreateCaseActionDefinition{
+				Fields:     expandRuleFieldValues(tfMap["field"].(*schema.Set).List()),
+				TemplateId: aws_sdkv2.String(tfMap["template_id"].(string)),
+			},
+		})
+	}
+
+	for _, tfMapRaw := range tfMap["create_task_action"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &types.TaskActionDefinition{
+			ContactFlowId: aws_sdkv2.String(tfMap["contact_flow_id"].(string)),
+			Name:          aws_sdkv2.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws_sdkv2.String(v)
+		}
+
+		if v, ok := tfMap["reference"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.References = expandRuleTaskReferences(v.List())
+		}
+
+		apiObjects = append(apiObjects, types.RuleAction{
+			ActionType: types.ActionTypeCreateTask,
+			TaskAction: apiObject,
+		})
+	}
+
+	if v, ok := tfMap["end_associated_tasks_action"].([]interface{}); ok && len(v) > 0 {
+		apiObjects = append(apiObjects, types.RuleAction{
+			ActionType:               types.ActionTypeEndAssociatedTasks,
+			EndAssociatedTasksAction: &types.EndAssociatedTasksActionDefinition{},
+		})
+	}
+
+	for _, tfMapRaw := range tfMap["event_bridge_action"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.RuleAction{
+			ActionType: types.ActionTypeGenerateEventbridgeEvent,
+			EventBridgeAction: &types.EventBridgeActionDefinition{
+				Name: aws_sdkv2.String(tfMap["name"].(string)),
+			},
+		})
+	}
+
+	for _, tfMapRaw := range tfMap["send_notification_action"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &types.SendNotificationActionDefinition{
+			Content:        aws_sdkv2.String(tfMap["content"].(string)),
+			ContentType:    types.NotificationContentType(tfMap["content_type"].(string)),
+			DeliveryMethod: types.NotificationDeliveryType(tfMap["delivery_method"].(string)),
+			Recipient:      expandRuleNotificationRecipient(tfMap["recipient"].([]interface{})),
+		}
+
+		if v, ok := tfMap["subject"].(string); ok && v != "" {
+			apiObject.Subject = aws_sdkv2.String(v)
+		}
+
+		apiObjects = append(apiObjects, types.RuleAction{
+			ActionType:             types.ActionTypeSendNotification,
+			SendNotificationAction: apiObject,
+		})
+	}
+
+	for _, tfMapRaw := range tfMap["submit_auto_evaluation_action"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.RuleAction{
+			ActionType: types.ActionTypeSubmitAutoEvaluation,
+			SubmitAutoEvaluationAction: &types.SubmitAutoEvaluationActionDefinition{
+				EvaluationFormId: aws_sdkv2.String(tfMap["evaluation_form_id"].(string)),
+			},
+		})
+	}
+
+	for _, tfMapRaw := range tfMap["update_case_action"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.RuleAction{
+			ActionType: types.ActionTypeUpdateCase,
+			UpdateCaseAction: &types.UpdateCaseActionDefinition{
+				Fields: expandRuleFieldValues(tfMap["field"].(*schema.Set).List()),
+			},
+		})
+	}
+
+	return apiObjects
+}
+
+func expandRuleTaskReferences(tfList []interface{}) map[string]types.Reference {
+	apiObjects := map[string]types.Reference{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects[tfMap["name"].(string)] = types.Reference{
+			Type:  types.ReferenceType(tfMap["type"].(string)),
+			Value: aws_sdkv2.String(tfMap["value"].(string)),
+		}
+	}
+
+	return apiObjects
+}
+
+func expandRuleNotificationRecipient(tfList []interface{}) *types.NotificationRecipientType {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return &types.NotificationRecipientType{}
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.NotificationRecipientType{}
+
+	if v, ok := tfMap["user_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.UserIds = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["user_tags"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.UserTags = flex.ExpandStringValueMap(v)
+	}
+
+	return apiObject
+}
+
+func expandRuleFieldValues(tfList []interface{}) []types.FieldValue {
+	apiObjects := []types.FieldValue{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.FieldValue{
+			Id:    aws_sdkv2.String(tfMap["id"].(string)),
+			Value: &types.FieldValueUnion{},
+		}
+
+		if v, ok := tfMap["value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Value.BooleanValue = tfMap["boolean_value"].(bool)
+
+			if v, ok := tfMap["double_value"].(float64); ok && v != 0 {
+				apiObject.Value.DoubleValue = aws_sdkv2.Float64(v)
+			}
+
+			if v, ok := tfMap["empty_value"].(bool); ok && v {
+				apiObject.Value.EmptyValue = &types.EmptyFieldValue{}
+			}
+
+			if v, ok := tfMap["string_value"].(string); ok && v != "" {
+				apiObject.Value.StringValue = aws_sdkv2.String(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenRuleTriggerEventSource(apiObject *types.RuleTriggerEventSource) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"event_source_name":          string(apiObject.EventSourceName),
+		"integration_association_id": aws_sdkv2.ToString(apiObject.IntegrationAssociationId),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRuleActions(apiObjects []types.RuleAction) []interface{} {
+	var assignContactCategoryActions, createCaseActions, createTaskActions, endAssociatedTasksActions, eventBridgeActions []interface{}
+	var sendNotificationActions, submitAutoEvaluationActions, updateCaseActions []interface{}
+
+	for _, apiObject := range apiObjects {
+		switch apiObject.ActionType {
+		case types.ActionTypeAssignContactCategory:
+			assignContactCategoryActions = append(assignContactCategoryActions, map[string]interface{}{})
+		case types.ActionTypeCreateCase:
+			if v := apiObject.CreateCaseAction; v != nil {
+				createCaseActions = append(createCaseActions, map[string]interface{}{
+					"field":       flattenRuleFieldValues(v.Fields),
+					"template_id": aws_sdkv2.ToString(v.TemplateId),
+				})
+			}
+		case types.ActionTypeCreateTask:
+			if v := apiObject.TaskAction; v != nil {
+				createTaskActions = append(createTaskActions, map[string]interface{}{
+					"contact_flow_id": aws_sdkv2.ToString(v.ContactFlowId),
+					"description":     aws_sdkv2.ToString(v.Description),
+					"name":            aws_sdkv2.ToString(v.Name),
+					"reference":       flattenRuleTaskReferences(v.References),
+				})
+			}
+		case types.ActionTypeEndAssociatedTasks:
+			endAssociatedTasksActions = append(endAssociatedTasksActions, map[string]interface{}{})
+		case types.ActionTypeGenerateEventbridgeEvent:
+			if v := apiObject.EventBridgeAction; v != nil {
+				eventBridgeActions = append(eventBridgeActions, map[string]interface{}{
+					"name": aws_sdkv2.ToString(v.Name),
+				})
+			}
+		case types.ActionTypeSendNotification:
+			if v := apiObject.SendNotificationAction; v != nil {
+				sendNotificationActions = append(sendNotificationActions, map[string]interface{}{
+					"content":         aws_sdkv2.ToString(v.Content),
+					"content_type":    string(v.ContentType),
+					"delivery_method": string(v.DeliveryMethod),
+					"recipient":       flattenRuleNotificationRecipient(v.Recipient),
+					"subject":         aws_sdkv2.ToString(v.Subject),
+				})
+			}
+		case types.ActionTypeSubmitAutoEvaluation:
+			if v := apiObject.SubmitAutoEvaluationAction; v != nil {
+				submitAutoEvaluationActions = append(submitAutoEvaluationActions, map[string]interface{}{
+					"evaluation_form_id": aws_sdkv2.ToString(v.EvaluationFormId),
+				})
+			}
+		case types.ActionTypeUpdateCase:
+			if v := apiObject.UpdateCaseAction; v != nil {
+				updateCaseActions = append(updateCaseActions, map[string]interface{}{
+					"field": flattenRuleFieldValues(v.Fields),
+				})
+			}
+		}
+	}
+
+	tfMap := map[string]interface{}{
+		"assign_contact_category_action": assignContactCategoryActions,
+		"create_case_action":             createCaseActions,
+		"create_task_action":             createTaskActions,
+		"end_associated_tasks_action":    endAssociatedTasksActions,
+		"event_bridge_action":            eventBridgeActions,
+		"send_notification_action":       sendNotificationActions,
+		"submit_auto_evaluation_action":  submitAutoEvaluationActions,
+		"update_case_action":             updateCaseActions,
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRuleTaskReferences(apiObjects map[string]types.Reference) []interface{} {
+	tfList := []interface{}{}
+
+	for k, v := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"name":  k,
+			"type":  string(v.Type),
+			"value": aws_sdkv2.ToString(v.Value),
+		})
+	}
+
+	return tfList
+}
+
+func flattenRuleNotificationRecipient(apiObject *types.NotificationRecipientType) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"user_ids":  flex.FlattenStringValueSet(apiObject.UserIds),
+		"user_tags": aws_sdkv2.StringMap(apiObject.UserTags),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRuleFieldValues(apiObjects []types.FieldValue) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"id": aws_sdkv2.ToString(apiObject.Id),
+		}
+
+		if v := apiObject.Value; v != nil {
+			tfMap["value"] = []interface{}{map[string]interface{}{
+				"boolean_value": v.BooleanValue,
+				"double_value":  aws_sdkv2.ToFloat64(v.DoubleValue),
+				"empty_value":   v.EmptyValue != nil,
+				"string_value":  aws_sdkv2.ToString(v.StringValue),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,226 @@
+package connect_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.Rule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_connect_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleConfig_basic(rName, "DRAFT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.assign_contact_category_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.event_bridge_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.event_bridge_action.0.name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "function"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "publish_status", "DRAFT"),
+					resource.TestCheckResourceAttrSet(resourceName, "rule_id"),
+					resource.TestCheckResourceAttr(resourceName, "trigger_event_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger_event_source.0.event_source_name", "OnPostCallAnalysisAvailable"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRuleConfig_basic(rName, "PUBLISHED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "publish_status", "PUBLISHED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.Rule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_connect_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleConfig_basic(rName, "DRAFT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfconnect.ResourceRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRule_unsupportedAction(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleConfig_unsupportedAction(rName),
+				ExpectError: regexp.MustCompile(`end_associated_tasks_action is not supported for trigger event source OnPostCallAnalysisAvailable`),
+			},
+		},
+	})
+}
+
+func testAccCheckRuleExists(ctx context.Context, resourceName string, v *types.Rule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Connect Rule not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Connect Rule ID not set")
+		}
+
+		instanceID, ruleID, err := tfconnect.RuleParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		client := acctest.Provider.Meta().(*conns.AWSClient).ConnectClient()
+
+		output, err := tfconnect.FindRuleByID(ctx, client, instanceID, ruleID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_connect_rule" {
+				continue
+			}
+
+			instanceID, ruleID, err := tfconnect.RuleParseID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			client := acctest.Provider.Meta().(*conns.AWSClient).ConnectClient()
+
+			_, err = tfconnect.FindRuleByID(ctx, client, instanceID, ruleID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Rule %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccRuleConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+`, rName)
+}
+
+func testAccRuleConfig_basic(rName, publishStatus string) string {
+	return acctest.ConfigCompose(
+		testAccRuleConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_rule" "test" {
+  instance_id    = aws_connect_instance.test.id
+  name           = %[1]q
+  function       = "CONTAINS_ANY($.ContactLens.PostCall.Transcript.Customer, [\"refund\"])"
+  publish_status = %[2]q
+
+  trigger_event_source {
+    event_source_name = "OnPostCallAnalysisAvailable"
+  }
+
+  actions {
+    assign_contact_category_action {}
+
+    event_bridge_action {
+      name = %[1]q
+    }
+  }
+}
+`, rName, publishStatus))
+}
+
+func testAccRuleConfig_unsupportedAction(rName string) string {
+	return acctest.ConfigCompose(
+		testAccRuleConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_rule" "test" {
+  instance_id    = aws_connect_instance.test.id
+  name           = %[1]q
+  function       = "CONTAINS_ANY($.ContactLens.PostCall.Transcript.Customer, [\"refund\"])"
+  publish_status = "DRAFT"
+
+  trigger_event_source {
+    event_source_name = "OnPostCallAnalysisAvailable"
+  }
+
+  actions {
+    end_associated_tasks_action {}
+  }
+}
+`, rName))
+}
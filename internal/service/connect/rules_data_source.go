@@ -0,0 +1,166 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// @SDKDataSource("aws_connect_rules")
+func DataSourceRules() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRulesRead,
+		Schema: map[string]*schema.Schema{
+			"event_source_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(connect.EventSourceName_Values(), false),
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"publish_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(connect.RulePublishStatus_Values(), false),
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_types": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_source_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_updated_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"publish_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setDefaultInstanceID(d, meta); err != nil {
+		return diagFromErr(err)
+	}
+
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID := d.Get("instance_id").(string)
+
+	input := &connect.ListRulesInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int64(ListRulesMaxResults),
+	}
+
+	if v, ok := d.GetOk("event_source_name"); ok {
+		input.EventSourceName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("publish_status"); ok {
+		input.PublishStatus = aws.String(v.(string))
+	}
+
+	var rules []*connect.RuleSummary
+
+	err := conn.ListRulesPagesWithContext(ctx, input, func(page *connect.ListRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		rules = append(rules, page.RuleSummaryList...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error listing Connect Rules for Connect Instance (%s): %w", instanceID, err))
+	}
+
+	if err := d.Set("rules", flattenRuleSummaries(rules)); err != nil {
+		return diagFromErr(fmt.Errorf("error setting rules: %w", err))
+	}
+
+	d.SetId(instanceID)
+
+	return nil
+}
+
+func flattenRuleSummaries(apiObjects []*connect.RuleSummary) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		var actionTypes []string
+
+		for _, v := range apiObject.ActionSummaries {
+			if v != nil {
+				actionTypes = append(actionTypes, aws.StringValue(v.ActionType))
+			}
+		}
+
+		tfMap := map[string]interface{}{
+			"action_types":      actionTypes,
+			"arn":               aws.StringValue(apiObject.RuleArn),
+			"event_source_name": aws.StringValue(apiObject.EventSourceName),
+			"name":              aws.StringValue(apiObject.Name),
+			"publish_status":    aws.StringValue(apiObject.PublishStatus),
+			"rule_id":           aws.StringValue(apiObject.RuleId),
+		}
+
+		if v := apiObject.CreatedTime; v != nil {
+			tfMap["created_time"] = v.Format(time.RFC3339)
+		}
+
+		if v := apiObject.LastUpdatedTime; v != nil {
+			tfMap["last_updated_time"] = v.Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -4,21 +4,31 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	connect_sdkv2 "github.com/aws/aws-sdk-go-v2/service/connect"
+	"github.com/aws/aws-sdk-go-v2/service/connect/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// securityProfileNameAdmin is the name of the security profile that every instance is created with.
+const securityProfileNameAdmin = "Admin"
+
 // @SDKResource("aws_connect_security_profile", name="Security Profile")
 // @Tags(identifierAttribute="arn")
 func ResourceSecurityProfile() *schema.Resource {
@@ -30,8 +40,43 @@ func ResourceSecurityProfile() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(securityProfileDeletedTimeout),
+		},
+		CustomizeDiff: customdiff.Sequence(
+			defaultInstanceIDCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 		Schema: map[string]*schema.Schema{
+			"allowed_access_control_hierarchy_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			"applications": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_permissions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+						},
+						"namespace": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+					},
+				},
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -41,9 +86,19 @@ func ResourceSecurityProfile() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 250),
 			},
+			"hierarchy_restricted_resources": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
 			"instance_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(1, 100),
 			},
 			"name": {
@@ -85,6 +140,10 @@ func resourceSecurityProfileCreate(ctx context.Context, d *schema.ResourceData,
 		Tags:                GetTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("applications"); ok && v.(*schema.Set).Len() > 0 {
+		input.Applications = expandSecurityProfileApplications(v.(*schema.Set).List())
+	}
+
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -97,15 +156,22 @@ func resourceSecurityProfileCreate(ctx context.Context, d *schema.ResourceData,
 	output, err := conn.CreateSecurityProfileWithContext(ctx, input)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating Connect Security Profile (%s): %w", securityProfileName, err))
+		err = securityProfilePermissionsError(ctx, conn, instanceID, d.Get("permissions").(*schema.Set), err)
+		return diagFromErr(fmt.Errorf("error creating Connect Security Profile (%s): %w", securityProfileName, err))
 	}
 
 	if output == nil {
-		return diag.FromErr(fmt.Errorf("error creating Connect Security Profile (%s): empty output", securityProfileName))
+		return diagFromErr(fmt.Errorf("error creating Connect Security Profile (%s): empty output", securityProfileName))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(output.SecurityProfileId)))
 
+	if d.Get("allowed_access_control_hierarchy_group_id").(string) != "" || d.Get("hierarchy_restricted_resources").(*schema.Set).Len() > 0 {
+		if err := updateSecurityProfileHierarchyAccessControl(ctx, meta.(*conns.AWSClient).ConnectClient(), d, instanceID, aws.StringValue(output.SecurityProfileId)); err != nil {
+			return diagFromErr(fmt.Errorf("setting Connect Security Profile (%s) hierarchy access control: %w", d.Id(), err))
+		}
+	}
+
 	return resourceSecurityProfileRead(ctx, d, meta)
 }
 
@@ -115,7 +181,7 @@ func resourceSecurityProfileRead(ctx context.Context, d *schema.ResourceData, me
 	instanceID, securityProfileID, err := SecurityProfileParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	resp, err := conn.DescribeSecurityProfileWithContext(ctx, &connect.DescribeSecurityProfileInput{
@@ -130,11 +196,11 @@ func resourceSecurityProfileRead(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Security Profile (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error getting Connect Security Profile (%s): %w", d.Id(), err))
 	}
 
 	if resp == nil || resp.SecurityProfile == nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Security Profile (%s): empty response", d.Id()))
+		return diagFromErr(fmt.Errorf("error getting Connect Security Profile (%s): empty response", d.Id()))
 	}
 
 	d.Set("arn", resp.SecurityProfile.Arn)
@@ -148,13 +214,33 @@ func resourceSecurityProfileRead(ctx context.Context, d *schema.ResourceData, me
 	permissions, err := getSecurityProfilePermissions(ctx, conn, instanceID, securityProfileID)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error finding Connect Security Profile Permissions for Security Profile (%s): %w", securityProfileID, err))
+		return diagFromErr(fmt.Errorf("error finding Connect Security Profile Permissions for Security Profile (%s): %w", securityProfileID, err))
 	}
 
 	if permissions != nil {
 		d.Set("permissions", flex.FlattenStringSet(permissions))
 	}
 
+	// as are the applications
+	applications, err := getSecurityProfileApplications(ctx, conn, instanceID, securityProfileID)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error finding Connect Security Profile Applications for Security Profile (%s): %w", securityProfileID, err))
+	}
+
+	if err := d.Set("applications", flattenSecurityProfileApplications(applications)); err != nil {
+		return diagFromErr(fmt.Errorf("setting applications: %w", err))
+	}
+
+	securityProfile, err := findSecurityProfileV2(ctx, meta.(*conns.AWSClient).ConnectClient(), instanceID, securityProfileID)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error finding Connect Security Profile hierarchy access control for Security Profile (%s): %w", securityProfileID, err))
+	}
+
+	d.Set("allowed_access_control_hierarchy_group_id", securityProfile.AllowedAccessControlHierarchyGroupId)
+	d.Set("hierarchy_restricted_resources", flex.FlattenStringValueSet(securityProfile.HierarchyRestrictedResources))
+
 	SetTagsOut(ctx, resp.SecurityProfile.AllowedAccessControlTags)
 
 	return nil
@@ -166,7 +252,7 @@ func resourceSecurityProfileUpdate(ctx context.Context, d *schema.ResourceData,
 	instanceID, securityProfileID, err := SecurityProfileParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	input := &connect.UpdateSecurityProfileInput{
@@ -174,6 +260,11 @@ func resourceSecurityProfileUpdate(ctx context.Context, d *schema.ResourceData,
 		SecurityProfileId: aws.String(securityProfileID),
 	}
 
+	if d.HasChange("applications") {
+		// An empty list removes all applications.
+		input.Applications = expandSecurityProfileApplications(d.Get("applications").(*schema.Set).List())
+	}
+
 	if d.HasChange("description") {
 		input.Description = aws.String(d.Get("description").(string))
 	}
@@ -185,7 +276,16 @@ func resourceSecurityProfileUpdate(ctx context.Context, d *schema.ResourceData,
 	_, err = conn.UpdateSecurityProfileWithContext(ctx, input)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("updating SecurityProfile (%s): %w", d.Id(), err))
+		if input.Permissions != nil {
+			err = securityProfilePermissionsError(ctx, conn, instanceID, d.Get("permissions").(*schema.Set), err)
+		}
+		return diagFromErr(fmt.Errorf("updating SecurityProfile (%s): %w", d.Id(), err))
+	}
+
+	if d.HasChanges("allowed_access_control_hierarchy_group_id", "hierarchy_restricted_resources") {
+		if err := updateSecurityProfileHierarchyAccessControl(ctx, meta.(*conns.AWSClient).ConnectClient(), d, instanceID, securityProfileID); err != nil {
+			return diagFromErr(fmt.Errorf("updating SecurityProfile (%s) hierarchy access control: %w", d.Id(), err))
+		}
 	}
 
 	return resourceSecurityProfileRead(ctx, d, meta)
@@ -197,21 +297,56 @@ func resourceSecurityProfileDelete(ctx context.Context, d *schema.ResourceData,
 	instanceID, securityProfileID, err := SecurityProfileParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
-	_, err = conn.DeleteSecurityProfileWithContext(ctx, &connect.DeleteSecurityProfileInput{
-		InstanceId:        aws.String(instanceID),
-		SecurityProfileId: aws.String(securityProfileID),
+	_, err = tfresource.RetryWhenConflict(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteSecurityProfileWithContext(ctx, &connect.DeleteSecurityProfileInput{
+			InstanceId:        aws.String(instanceID),
+			SecurityProfileId: aws.String(securityProfileID),
+		})
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting SecurityProfile (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error deleting SecurityProfile (%s): %w", d.Id(), err))
 	}
 
 	return nil
 }
 
+// securityProfilePermissionsError adds the permissions that are not granted to the instance's Admin security profile
+// to a CreateSecurityProfile or UpdateSecurityProfile error, as the API does not say which permissions it rejected.
+// The Admin security profile can be modified, so these permissions are only reported as likely causes;
+// they are not validated at plan time. err is returned unchanged if the Admin security profile cannot be read.
+func securityProfilePermissionsError(ctx context.Context, conn *connect.Connect, instanceID string, permissions *schema.Set, err error) error {
+	if permissions.Len() == 0 {
+		return err
+	}
+
+	knownPermissions, lerr := getInstancePermissions(ctx, conn, instanceID)
+
+	if lerr != nil {
+		log.Printf("[WARN] Unable to read Connect Security Profile permissions of Connect Instance (%s): %s", instanceID, lerr)
+		return err
+	}
+
+	var unknown []string
+
+	for _, v := range permissions.List() {
+		if permission := v.(string); !knownPermissions[permission] {
+			unknown = append(unknown, permission)
+		}
+	}
+
+	if len(unknown) == 0 {
+		return err
+	}
+
+	sort.Strings(unknown)
+
+	return fmt.Errorf("%w; permissions not granted to the %s security profile of Connect Instance (%s), which may be invalid: %s", err, securityProfileNameAdmin, instanceID, strings.Join(unknown, ", "))
+}
+
 func SecurityProfileParseID(id string) (string, string, error) {
 	parts := strings.SplitN(id, ":", 2)
 
@@ -222,6 +357,42 @@ func SecurityProfileParseID(id string) (string, string, error) {
 	return parts[0], parts[1], nil
 }
 
+func updateSecurityProfileHierarchyAccessControl(ctx context.Context, client *connect_sdkv2.Client, d *schema.ResourceData, instanceID, securityProfileID string) error {
+	input := &connect_sdkv2.UpdateSecurityProfileInput{
+		InstanceId:                   aws_sdkv2.String(instanceID),
+		HierarchyRestrictedResources: flex.ExpandStringValueSet(d.Get("hierarchy_restricted_resources").(*schema.Set)),
+		SecurityProfileId:            aws_sdkv2.String(securityProfileID),
+	}
+
+	// an empty value is only sent to remove a previously configured hierarchy group
+	if v := d.Get("allowed_access_control_hierarchy_group_id").(string); v != "" || (!d.IsNewResource() && d.HasChange("allowed_access_control_hierarchy_group_id")) {
+		input.AllowedAccessControlHierarchyGroupId = aws_sdkv2.String(v)
+	}
+
+	_, err := client.UpdateSecurityProfile(ctx, input)
+
+	return err
+}
+
+func findSecurityProfileV2(ctx context.Context, client *connect_sdkv2.Client, instanceID, securityProfileID string) (*types.SecurityProfile, error) {
+	input := &connect_sdkv2.DescribeSecurityProfileInput{
+		InstanceId:        aws_sdkv2.String(instanceID),
+		SecurityProfileId: aws_sdkv2.String(securityProfileID),
+	}
+
+	output, err := client.DescribeSecurityProfile(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SecurityProfile == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SecurityProfile, nil
+}
+
 func getSecurityProfilePermissions(ctx context.Context, conn *connect.Connect, instanceID, securityProfileID string) ([]*string, error) {
 	var result []*string
 
@@ -247,3 +418,126 @@ func getSecurityProfilePermissions(ctx context.Context, conn *connect.Connect, i
 
 	return result, nil
 }
+
+func getSecurityProfileApplications(ctx context.Context, conn *connect.Connect, instanceID, securityProfileID string) ([]*connect.Application, error) {
+	var result []*connect.Application
+
+	input := &connect.ListSecurityProfileApplicationsInput{
+		InstanceId:        aws.String(instanceID),
+		MaxResults:        aws.Int64(ListSecurityProfileApplicationsMaxResults),
+		SecurityProfileId: aws.String(securityProfileID),
+	}
+
+	err := conn.ListSecurityProfileApplicationsPagesWithContext(ctx, input, func(page *connect.ListSecurityProfileApplicationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		result = append(result, page.Applications...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func expandSecurityProfileApplications(tfList []interface{}) []*connect.Application {
+	apiObjects := make([]*connect.Application, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &connect.Application{
+			ApplicationPermissions: flex.ExpandStringSet(tfMap["application_permissions"].(*schema.Set)),
+			Namespace:              aws.String(tfMap["namespace"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenSecurityProfileApplications(apiObjects []*connect.Application) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"application_permissions": aws.StringValueSlice(apiObject.ApplicationPermissions),
+			"namespace":               aws.StringValue(apiObject.Namespace),
+		})
+	}
+
+	return tfList
+}
+
+// findAdminSecurityProfileID returns the identifier of the instance's Admin security profile.
+func findAdminSecurityProfileID(ctx context.Context, conn *connect.Connect, instanceID string) (string, error) {
+	var adminSecurityProfileID string
+
+	input := &connect.ListSecurityProfilesInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int64(ListSecurityProfilesMaxResults),
+	}
+
+	err := conn.ListSecurityProfilesPagesWithContext(ctx, input, func(page *connect.ListSecurityProfilesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SecurityProfileSummaryList {
+			if v != nil && aws.StringValue(v.Name) == securityProfileNameAdmin {
+				adminSecurityProfileID = aws.StringValue(v.Id)
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if adminSecurityProfileID == "" {
+		return "", &retry.NotFoundError{
+			Message: fmt.Sprintf("security profile %q not found in Connect Instance (%s)", securityProfileNameAdmin, instanceID),
+		}
+	}
+
+	return adminSecurityProfileID, nil
+}
+
+// getInstancePermissions returns the permissions granted to the instance's Admin security profile.
+// The Admin security profile is created with every permission, but it can be modified,
+// so the result is a best-effort list of the permissions that exist in the instance.
+func getInstancePermissions(ctx context.Context, conn *connect.Connect, instanceID string) (map[string]bool, error) {
+	adminSecurityProfileID, err := findAdminSecurityProfileID(ctx, conn, instanceID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := getSecurityProfilePermissions(ctx, conn, instanceID, adminSecurityProfileID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]bool, len(permissions))
+
+	for _, v := range permissions {
+		result[aws.StringValue(v)] = true
+	}
+
+	return result, nil
+}
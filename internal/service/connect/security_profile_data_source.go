@@ -29,7 +29,8 @@ func DataSourceSecurityProfile() *schema.Resource {
 			},
 			"instance_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(1, 100),
 			},
 			"name": {
@@ -61,56 +62,52 @@ func DataSourceSecurityProfile() *schema.Resource {
 }
 
 func dataSourceSecurityProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setDefaultInstanceID(d, meta); err != nil {
+		return diagFromErr(err)
+	}
+
 	conn := meta.(*conns.AWSClient).ConnectConn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	instanceID := d.Get("instance_id").(string)
 
-	input := &connect.DescribeSecurityProfileInput{
-		InstanceId: aws.String(instanceID),
-	}
+	var securityProfileID string
 
 	if v, ok := d.GetOk("security_profile_id"); ok {
-		input.SecurityProfileId = aws.String(v.(string))
+		securityProfileID = v.(string)
 	} else if v, ok := d.GetOk("name"); ok {
 		name := v.(string)
 		securityProfileSummary, err := dataSourceGetSecurityProfileSummaryByName(ctx, conn, instanceID, name)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error finding Connect Security Profile Summary by name (%s): %w", name, err))
+			return diagFromErr(fmt.Errorf("error finding Connect Security Profile Summary by name (%s): %w", name, err))
 		}
 
 		if securityProfileSummary == nil {
-			return diag.FromErr(fmt.Errorf("error finding Connect Security Profile Summary by name (%s): not found", name))
+			return diagFromErr(fmt.Errorf("error finding Connect Security Profile Summary by name (%s): not found", name))
 		}
 
-		input.SecurityProfileId = securityProfileSummary.Id
+		securityProfileID = aws.StringValue(securityProfileSummary.Id)
 	}
 
-	resp, err := conn.DescribeSecurityProfileWithContext(ctx, input)
+	securityProfile, err := FindSecurityProfileByID(ctx, conn, instanceID, securityProfileID)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Security Profile: %w", err))
-	}
-
-	if resp == nil || resp.SecurityProfile == nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Security Profile: empty response"))
+		return diagFromErr(fmt.Errorf("error getting Connect Security Profile (%s): %w", securityProfileID, err))
 	}
 
-	securityProfile := resp.SecurityProfile
-
-	d.Set("arn", resp.SecurityProfile.Arn)
-	d.Set("description", resp.SecurityProfile.Description)
+	d.Set("arn", securityProfile.Arn)
+	d.Set("description", securityProfile.Description)
 	d.Set("instance_id", instanceID)
-	d.Set("organization_resource_id", resp.SecurityProfile.OrganizationResourceId)
-	d.Set("security_profile_id", resp.SecurityProfile.Id)
-	d.Set("name", resp.SecurityProfile.SecurityProfileName)
+	d.Set("organization_resource_id", securityProfile.OrganizationResourceId)
+	d.Set("security_profile_id", securityProfile.Id)
+	d.Set("name", securityProfile.SecurityProfileName)
 
 	// reading permissions requires a separate API call
-	permissions, err := getSecurityProfilePermissions(ctx, conn, instanceID, *resp.SecurityProfile.Id)
+	permissions, err := getSecurityProfilePermissions(ctx, conn, instanceID, securityProfileID)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error finding Connect Security Profile Permissions for Security Profile (%s): %w", *resp.SecurityProfile.Id, err))
+		return diagFromErr(fmt.Errorf("error finding Connect Security Profile Permissions for Security Profile (%s): %w", securityProfileID, err))
 	}
 
 	if permissions != nil {
@@ -118,10 +115,10 @@ func dataSourceSecurityProfileRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if err := d.Set("tags", KeyValueTags(ctx, securityProfile.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
-		return diag.FromErr(fmt.Errorf("error setting tags: %s", err))
+		return diagFromErr(fmt.Errorf("error setting tags: %s", err))
 	}
 
-	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(resp.SecurityProfile.Id)))
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(securityProfile.Id)))
 
 	return nil
 }
@@ -0,0 +1,77 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_connect_security_profile_permissions")
+func DataSourceSecurityProfilePermissions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSecurityProfilePermissionsRead,
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"security_profile_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSecurityProfilePermissionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setDefaultInstanceID(d, meta); err != nil {
+		return diagFromErr(err)
+	}
+
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID := d.Get("instance_id").(string)
+
+	var securityProfileID string
+
+	if v, ok := d.GetOk("security_profile_id"); ok {
+		securityProfileID = v.(string)
+	} else {
+		// There is no API listing every permission, so those granted to the Admin security profile are returned, as in getInstancePermissions.
+		v, err := findAdminSecurityProfileID(ctx, conn, instanceID)
+
+		if err != nil {
+			return diagErrorf("finding Connect Security Profile (%s): %s", securityProfileNameAdmin, err)
+		}
+
+		securityProfileID = v
+	}
+
+	permissions, err := getSecurityProfilePermissions(ctx, conn, instanceID, securityProfileID)
+
+	if err != nil {
+		return diagErrorf("reading Connect Security Profile (%s) permissions: %s", securityProfileID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, securityProfileID))
+	d.Set("instance_id", instanceID)
+	d.Set("permissions", flex.FlattenStringSet(permissions))
+	d.Set("security_profile_id", securityProfileID)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package connect_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccSecurityProfilePermissionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_security_profile.test"
+	datasourceName := "data.aws_connect_security_profile_permissions.test"
+	adminDatasourceName := "data.aws_connect_security_profile_permissions.admin"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityProfilePermissionsDataSourceConfig_basic(rName, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "instance_id", resourceName, "instance_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "security_profile_id", resourceName, "security_profile_id"),
+					resource.TestCheckResourceAttr(datasourceName, "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(datasourceName, "permissions.*", "BasicAgentAccess"),
+					resource.TestCheckTypeSetElemAttr(datasourceName, "permissions.*", "OutboundCallAccess"),
+					resource.TestCheckResourceAttrPair(adminDatasourceName, "instance_id", resourceName, "instance_id"),
+					resource.TestCheckResourceAttrSet(adminDatasourceName, "security_profile_id"),
+					resource.TestCheckTypeSetElemAttr(adminDatasourceName, "permissions.*", "BasicAgentAccess"),
+					resource.TestCheckTypeSetElemAttr(adminDatasourceName, "permissions.*", "OutboundCallAccess"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSecurityProfilePermissionsDataSourceConfig_basic(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccSecurityProfileBaseDataSourceConfig(rName, rName2),
+		`
+data "aws_connect_security_profile_permissions" "test" {
+  instance_id         = aws_connect_instance.test.id
+  security_profile_id = aws_connect_security_profile.test.security_profile_id
+}
+
+data "aws_connect_security_profile_permissions" "admin" {
+  instance_id = aws_connect_instance.test.id
+}
+`)
+}
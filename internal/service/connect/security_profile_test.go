@@ -3,17 +3,18 @@ package connect_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func testAccSecurityProfile_basic(t *testing.T) {
@@ -113,6 +114,11 @@ func testAccSecurityProfile_updatePermissions(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
 				),
 			},
+			{
+				// Permissions that do not exist in the instance are listed in the API error.
+				Config:      testAccSecurityProfileConfig_invalidPermissions(rName, rName2, "TestPermissionsUpdate"),
+				ExpectError: regexp.MustCompile(`permissions not granted to the Admin security profile of Connect Instance \(.+\), which may be invalid: NotAPermission, NotAPermissionEither`),
+			},
 		},
 	})
 }
@@ -168,6 +174,88 @@ func testAccSecurityProfile_updateTags(t *testing.T) {
 	})
 }
 
+func testAccSecurityProfile_hierarchyRestrictedResources(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.DescribeSecurityProfileOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName3 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_security_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityProfileConfig_hierarchyRestrictedResources(rName, rName2, rName3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "allowed_access_control_hierarchy_group_id", "aws_connect_user_hierarchy_group.test", "hierarchy_group_id"),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy_restricted_resources.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "hierarchy_restricted_resources.*", "User"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSecurityProfileConfig_basic(rName, rName2, "TestHierarchyRestrictedResources"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "allowed_access_control_hierarchy_group_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy_restricted_resources.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSecurityProfile_applications(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.DescribeSecurityProfileOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName3 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_security_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityProfileConfig_applications(rName, rName2, rName3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "applications.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "applications.*", map[string]string{
+						"namespace":                 rName3,
+						"application_permissions.#": "1",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "applications.*.application_permissions.*", "ACCESS"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSecurityProfileConfig_basic(rName, rName2, "TestApplications"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "applications.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccSecurityProfile_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v connect.DescribeSecurityProfileOutput
@@ -242,14 +330,9 @@ func testAccCheckSecurityProfileDestroy(ctx context.Context) resource.TestCheckF
 				return err
 			}
 
-			params := &connect.DescribeSecurityProfileInput{
-				InstanceId:        aws.String(instanceID),
-				SecurityProfileId: aws.String(securityProfileID),
-			}
-
-			_, err = conn.DescribeSecurityProfileWithContext(ctx, params)
+			_, err = tfconnect.FindSecurityProfileByID(ctx, conn, instanceID, securityProfileID)
 
-			if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+			if tfresource.NotFound(err) {
 				continue
 			}
 
@@ -310,6 +393,107 @@ resource "aws_connect_security_profile" "test" {
 `, rName2, label))
 }
 
+func testAccSecurityProfileConfig_invalidPermissions(rName, rName2, label string) string {
+	return acctest.ConfigCompose(
+		testAccSecurityProfileConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_security_profile" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  description = %[2]q
+
+  permissions = [
+    "BasicAgentAccess",
+    "NotAPermission",
+    "NotAPermissionEither",
+  ]
+
+  tags = {
+    "Name" = "Test Security Profile"
+  }
+}
+`, rName2, label))
+}
+
+func testAccSecurityProfileConfig_hierarchyRestrictedResources(rName, rName2, rName3 string) string {
+	return acctest.ConfigCompose(
+		testAccSecurityProfileConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_user_hierarchy_structure" "test" {
+  instance_id = aws_connect_instance.test.id
+
+  hierarchy_structure {
+    level_one {
+      name = "levelone"
+    }
+  }
+}
+
+resource "aws_connect_user_hierarchy_group" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[2]q
+
+  depends_on = [
+    aws_connect_user_hierarchy_structure.test,
+  ]
+}
+
+resource "aws_connect_security_profile" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  description = "TestHierarchyRestrictedResources"
+
+  allowed_access_control_hierarchy_group_id = aws_connect_user_hierarchy_group.test.hierarchy_group_id
+  hierarchy_restricted_resources            = ["User"]
+
+  tags = {
+    "Name" = "Test Security Profile"
+  }
+}
+`, rName2, rName3))
+}
+
+func testAccSecurityProfileConfig_applications(rName, rName2, rName3 string) string {
+	return acctest.ConfigCompose(
+		testAccSecurityProfileConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_appintegrations_application" "test" {
+  name      = %[2]q
+  namespace = %[2]q
+
+  application_source_config {
+    external_url_config {
+      access_url       = "https://example.com/app"
+      approved_origins = ["https://example.com"]
+    }
+  }
+}
+
+resource "aws_connect_integration_association" "test" {
+  instance_id      = aws_connect_instance.test.id
+  integration_arn  = aws_appintegrations_application.test.arn
+  integration_type = "APPLICATION"
+}
+
+resource "aws_connect_security_profile" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  description = "TestApplications"
+
+  applications {
+    namespace               = aws_appintegrations_application.test.namespace
+    application_permissions = ["ACCESS"]
+  }
+
+  tags = {
+    "Name" = "Test Security Profile"
+  }
+
+  depends_on = [aws_connect_integration_association.test]
+}
+`, rName2, rName3))
+}
+
 func testAccSecurityProfileConfig_tags(rName, rName2, label string) string {
 	return acctest.ConfigCompose(
 		testAccSecurityProfileConfig_base(rName),
@@ -33,6 +33,14 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceContactFlowModule,
 			TypeName: "aws_connect_contact_flow_module",
 		},
+		{
+			Factory:  DataSourceEvaluationForm,
+			TypeName: "aws_connect_evaluation_form",
+		},
+		{
+			Factory:  DataSourceEvaluationForms,
+			TypeName: "aws_connect_evaluation_forms",
+		},
 		{
 			Factory:  DataSourceHoursOfOperation,
 			TypeName: "aws_connect_hours_of_operation",
@@ -41,6 +49,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceInstance,
 			TypeName: "aws_connect_instance",
 		},
+		{
+			Factory:  DataSourceInstanceAttributes,
+			TypeName: "aws_connect_instance_attributes",
+		},
 		{
 			Factory:  DataSourceInstanceStorageConfig,
 			TypeName: "aws_connect_instance_storage_config",
@@ -49,6 +61,14 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceLambdaFunctionAssociation,
 			TypeName: "aws_connect_lambda_function_association",
 		},
+		{
+			Factory:  DataSourcePhoneNumber,
+			TypeName: "aws_connect_phone_number",
+		},
+		{
+			Factory:  DataSourcePredefinedAttributes,
+			TypeName: "aws_connect_predefined_attributes",
+		},
 		{
 			Factory:  DataSourcePrompt,
 			TypeName: "aws_connect_prompt",
@@ -61,14 +81,38 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceQuickConnect,
 			TypeName: "aws_connect_quick_connect",
 		},
+		{
+			Factory:  DataSourceQuickConnects,
+			TypeName: "aws_connect_quick_connects",
+		},
 		{
 			Factory:  DataSourceRoutingProfile,
 			TypeName: "aws_connect_routing_profile",
 		},
+		{
+			Factory:  DataSourceRules,
+			TypeName: "aws_connect_rules",
+		},
 		{
 			Factory:  DataSourceSecurityProfile,
 			TypeName: "aws_connect_security_profile",
 		},
+		{
+			Factory:  DataSourceSecurityProfilePermissions,
+			TypeName: "aws_connect_security_profile_permissions",
+		},
+		{
+			Factory:  DataSourceTaskTemplate,
+			TypeName: "aws_connect_task_template",
+		},
+		{
+			Factory:  DataSourceTaskTemplates,
+			TypeName: "aws_connect_task_templates",
+		},
+		{
+			Factory:  DataSourceTrafficDistributionGroup,
+			TypeName: "aws_connect_traffic_distribution_group",
+		},
 		{
 			Factory:  DataSourceUser,
 			TypeName: "aws_connect_user",
@@ -77,10 +121,18 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceUserHierarchyGroup,
 			TypeName: "aws_connect_user_hierarchy_group",
 		},
+		{
+			Factory:  DataSourceUserHierarchyGroups,
+			TypeName: "aws_connect_user_hierarchy_groups",
+		},
 		{
 			Factory:  DataSourceUserHierarchyStructure,
 			TypeName: "aws_connect_user_hierarchy_structure",
 		},
+		{
+			Factory:  DataSourceViews,
+			TypeName: "aws_connect_views",
+		},
 		{
 			Factory:  DataSourceVocabulary,
 			TypeName: "aws_connect_vocabulary",
@@ -110,6 +162,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceEvaluationForm,
+			TypeName: "aws_connect_evaluation_form",
+			Name:     "Evaluation Form",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceHoursOfOperation,
 			TypeName: "aws_connect_hours_of_operation",
@@ -118,14 +178,34 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceImportedPhoneNumber,
+			TypeName: "aws_connect_imported_phone_number",
+			Name:     "Imported Phone Number",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceInstance,
 			TypeName: "aws_connect_instance",
+			Name:     "Instance",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
 		},
 		{
 			Factory:  ResourceInstanceStorageConfig,
 			TypeName: "aws_connect_instance_storage_config",
 		},
+		{
+			Factory:  ResourceIntegrationAssociation,
+			TypeName: "aws_connect_integration_association",
+			Name:     "Integration Association",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceLambdaFunctionAssociation,
 			TypeName: "aws_connect_lambda_function_association",
@@ -138,6 +218,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourcePredefinedAttribute,
+			TypeName: "aws_connect_predefined_attribute",
+			Name:     "Predefined Attribute",
+		},
+		{
+			Factory:  ResourcePrompt,
+			TypeName: "aws_connect_prompt",
+			Name:     "Prompt",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceQueue,
 			TypeName: "aws_connect_queue",
@@ -162,6 +255,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceRule,
+			TypeName: "aws_connect_rule",
+			Name:     "Rule",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceSecurityProfile,
 			TypeName: "aws_connect_security_profile",
@@ -170,6 +271,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceTaskTemplate,
+			TypeName: "aws_connect_task_template",
+			Name:     "Task Template",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceTrafficDistribution,
+			TypeName: "aws_connect_traffic_distribution",
+			Name:     "Traffic Distribution",
+		},
 		{
 			Factory:  ResourceUser,
 			TypeName: "aws_connect_user",
@@ -3,29 +3,27 @@ package connect
 import (
 	"context"
 
+	connect_sdkv2 "github.com/aws/aws-sdk-go-v2/service/connect"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func statusInstance(ctx context.Context, conn *connect.Connect, instanceId string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		input := &connect.DescribeInstanceInput{
-			InstanceId: aws.String(instanceId),
-		}
-
-		output, err := conn.DescribeInstanceWithContext(ctx, input)
+		output, err := FindInstanceByID(ctx, conn, instanceId)
 
-		if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
-			return output, connect.ErrCodeResourceNotFoundException, nil
+		if tfresource.NotFound(err) {
+			return nil, "", nil
 		}
 
 		if err != nil {
 			return nil, "", err
 		}
 
-		return output, aws.StringValue(output.Instance.InstanceStatus), nil
+		return output, aws.StringValue(output.InstanceStatus), nil
 	}
 }
 
@@ -51,21 +49,32 @@ func statusPhoneNumber(ctx context.Context, conn *connect.Connect, phoneNumberId
 
 func statusVocabulary(ctx context.Context, conn *connect.Connect, instanceId, vocabularyId string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		input := &connect.DescribeVocabularyInput{
-			InstanceId:   aws.String(instanceId),
-			VocabularyId: aws.String(vocabularyId),
+		output, err := FindVocabularyByID(ctx, conn, instanceId, vocabularyId)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
 		}
 
-		output, err := conn.DescribeVocabularyWithContext(ctx, input)
+		if err != nil {
+			return nil, "", err
+		}
 
-		if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
-			return output, connect.ErrCodeResourceNotFoundException, nil
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+func statusTrafficDistributionGroup(ctx context.Context, client *connect_sdkv2.Client, trafficDistributionGroupID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTrafficDistributionGroupByID(ctx, client, trafficDistributionGroupID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
 		}
 
 		if err != nil {
 			return nil, "", err
 		}
 
-		return output, aws.StringValue(output.Vocabulary.State), nil
+		return output, string(output.Status), nil
 	}
 }
@@ -0,0 +1,541 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_connect_task_template", name="Task Template")
+// @Tags(identifierAttribute="arn")
+func ResourceTaskTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTaskTemplateCreate,
+		ReadWithoutTimeout:   resourceTaskTemplateRead,
+		UpdateWithoutTimeout: resourceTaskTemplateUpdate,
+		DeleteWithoutTimeout: resourceTaskTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			defaultInstanceIDCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// The API returns constraints, defaults and fields in whatever order it chooses,
+			// so defaults, fields and the field names within constraints are modelled as sets rather than as ordered lists.
+			"constraints": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"invisible_fields": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 100),
+							},
+						},
+						"read_only_fields": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 100),
+							},
+						},
+						"required_fields": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 100),
+							},
+						},
+					},
+				},
+			},
+			"contact_flow_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 500),
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"defaults": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      taskTemplateFieldNameHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 4096),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"fields": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Set:      taskTemplateFieldNameHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+						"single_select_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 100),
+							},
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(connect.TaskTemplateFieldType_Values(), false),
+						},
+					},
+				},
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      connect.TaskTemplateStatusActive,
+				ValidateFunc: validation.StringInSlice(connect.TaskTemplateStatus_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"task_template_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTaskTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	input := &connect.CreateTaskTemplateInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Constraints: expandTaskTemplateConstraints(d.Get("constraints").([]interface{})),
+		Defaults:    expandTaskTemplateDefaults(d.Get("defaults").(*schema.Set).List()),
+		Fields:      expandTaskTemplateFields(d.Get("fields").(*schema.Set).List()),
+		InstanceId:  aws.String(instanceID),
+		Name:        aws.String(name),
+		Status:      aws.String(d.Get("status").(string)),
+	}
+
+	if v, ok := d.GetOk("contact_flow_id"); ok {
+		input.ContactFlowId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Connect Task Template (%s) for Connect Instance (%s)", name, instanceID)
+	output, err := conn.CreateTaskTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error creating Connect Task Template (%s): %w", name, err))
+	}
+
+	if output == nil {
+		return diagFromErr(fmt.Errorf("error creating Connect Task Template (%s): empty output", name))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(output.Id)))
+
+	// CreateTaskTemplate does not accept tags.
+	if tags := GetTagsIn(ctx); len(tags) > 0 {
+		if err := UpdateTags(ctx, conn, aws.StringValue(output.Arn), nil, tags); err != nil {
+			return diagFromErr(fmt.Errorf("error setting Connect Task Template (%s) tags: %w", d.Id(), err))
+		}
+	}
+
+	return resourceTaskTemplateRead(ctx, d, meta)
+}
+
+func resourceTaskTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID, taskTemplateID, err := TaskTemplateParseID(d.Id())
+
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	output, err := FindTaskTemplateByID(ctx, conn, instanceID, taskTemplateID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Task Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error getting Connect Task Template (%s): %w", d.Id(), err))
+	}
+
+	d.Set("arn", output.Arn)
+	if err := d.Set("constraints", flattenTaskTemplateConstraints(output.Constraints)); err != nil {
+		return diagFromErr(fmt.Errorf("error setting constraints: %w", err))
+	}
+	d.Set("contact_flow_id", output.ContactFlowId)
+	if output.CreatedTime != nil {
+		d.Set("created_time", output.CreatedTime.Format(time.RFC3339))
+	}
+	if err := d.Set("defaults", flattenTaskTemplateDefaults(output.Defaults)); err != nil {
+		return diagFromErr(fmt.Errorf("error setting defaults: %w", err))
+	}
+	d.Set("description", output.Description)
+	if err := d.Set("fields", flattenTaskTemplateFields(output.Fields)); err != nil {
+		return diagFromErr(fmt.Errorf("error setting fields: %w", err))
+	}
+	d.Set("instance_id", instanceID)
+	if output.LastModifiedTime != nil {
+		d.Set("last_modified_time", output.LastModifiedTime.Format(time.RFC3339))
+	}
+	d.Set("name", output.Name)
+	d.Set("status", output.Status)
+	d.Set("task_template_id", output.Id)
+
+	SetTagsOut(ctx, output.Tags)
+
+	return nil
+}
+
+func resourceTaskTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID, taskTemplateID, err := TaskTemplateParseID(d.Id())
+
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		// UpdateTaskTemplate replaces the template's fields, constraints and defaults as a whole.
+		input := &connect.UpdateTaskTemplateInput{
+			Constraints:    expandTaskTemplateConstraints(d.Get("constraints").([]interface{})),
+			Defaults:       expandTaskTemplateDefaults(d.Get("defaults").(*schema.Set).List()),
+			Fields:         expandTaskTemplateFields(d.Get("fields").(*schema.Set).List()),
+			InstanceId:     aws.String(instanceID),
+			Name:           aws.String(d.Get("name").(string)),
+			Status:         aws.String(d.Get("status").(string)),
+			TaskTemplateId: aws.String(taskTemplateID),
+		}
+
+		if v, ok := d.GetOk("contact_flow_id"); ok {
+			input.ContactFlowId = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		_, err = conn.UpdateTaskTemplateWithContext(ctx, input)
+
+		if err != nil {
+			return diagFromErr(fmt.Errorf("error updating Connect Task Template (%s): %w", d.Id(), err))
+		}
+	}
+
+	return resourceTaskTemplateRead(ctx, d, meta)
+}
+
+func resourceTaskTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID, taskTemplateID, err := TaskTemplateParseID(d.Id())
+
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Connect Task Template: %s", d.Id())
+	_, err = conn.DeleteTaskTemplateWithContext(ctx, &connect.DeleteTaskTemplateInput{
+		InstanceId:     aws.String(instanceID),
+		TaskTemplateId: aws.String(taskTemplateID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error deleting Connect Task Template (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func TaskTemplateParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected instanceID:taskTemplateID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// taskTemplateFieldNameHash keys task template fields and default values on the field name,
+// so that reordering by the API, or by the practitioner, does not produce a diff.
+func taskTemplateFieldNameHash(v interface{}) int {
+	m, ok := v.(map[string]interface{})
+
+	if !ok {
+		return 0
+	}
+
+	name, _ := m["name"].(string)
+
+	return create.StringHashcode(name)
+}
+
+func expandTaskTemplateFieldIdentifier(name string) *connect.TaskTemplateFieldIdentifier {
+	return &connect.TaskTemplateFieldIdentifier{
+		Name: aws.String(name),
+	}
+}
+
+func expandTaskTemplateConstraints(tfList []interface{}) *connect.TaskTemplateConstraints {
+	apiObject := &connect.TaskTemplateConstraints{}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["invisible_fields"].(*schema.Set); ok {
+		for _, name := range flex.ExpandStringValueSet(v) {
+			apiObject.InvisibleFields = append(apiObject.InvisibleFields, &connect.InvisibleFieldInfo{
+				Id: expandTaskTemplateFieldIdentifier(name),
+			})
+		}
+	}
+
+	if v, ok := tfMap["read_only_fields"].(*schema.Set); ok {
+		for _, name := range flex.ExpandStringValueSet(v) {
+			apiObject.ReadOnlyFields = append(apiObject.ReadOnlyFields, &connect.ReadOnlyFieldInfo{
+				Id: expandTaskTemplateFieldIdentifier(name),
+			})
+		}
+	}
+
+	if v, ok := tfMap["required_fields"].(*schema.Set); ok {
+		for _, name := range flex.ExpandStringValueSet(v) {
+			apiObject.RequiredFields = append(apiObject.RequiredFields, &connect.RequiredFieldInfo{
+				Id: expandTaskTemplateFieldIdentifier(name),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func expandTaskTemplateDefaults(tfList []interface{}) *connect.TaskTemplateDefaults {
+	apiObject := &connect.TaskTemplateDefaults{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject.DefaultFieldValues = append(apiObject.DefaultFieldValues, &connect.TaskTemplateDefaultFieldValue{
+			DefaultValue: aws.String(tfMap["default_value"].(string)),
+			Id:           expandTaskTemplateFieldIdentifier(tfMap["name"].(string)),
+		})
+	}
+
+	return apiObject
+}
+
+func expandTaskTemplateFields(tfList []interface{}) []*connect.TaskTemplateField {
+	var apiObjects []*connect.TaskTemplateField
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &connect.TaskTemplateField{
+			Id:   expandTaskTemplateFieldIdentifier(tfMap["name"].(string)),
+			Type: aws.String(tfMap["type"].(string)),
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap["single_select_options"].([]interface{}); ok && len(v) > 0 {
+			apiObject.SingleSelectOptions = flex.ExpandStringList(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenTaskTemplateFieldIdentifierName(apiObject *connect.TaskTemplateFieldIdentifier) string {
+	if apiObject == nil {
+		return ""
+	}
+
+	return aws.StringValue(apiObject.Name)
+}
+
+func flattenTaskTemplateConstraints(apiObject *connect.TaskTemplateConstraints) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var invisibleFields, readOnlyFields, requiredFields []string
+
+	for _, v := range apiObject.InvisibleFields {
+		if v != nil {
+			invisibleFields = append(invisibleFields, flattenTaskTemplateFieldIdentifierName(v.Id))
+		}
+	}
+
+	for _, v := range apiObject.ReadOnlyFields {
+		if v != nil {
+			readOnlyFields = append(readOnlyFields, flattenTaskTemplateFieldIdentifierName(v.Id))
+		}
+	}
+
+	for _, v := range apiObject.RequiredFields {
+		if v != nil {
+			requiredFields = append(requiredFields, flattenTaskTemplateFieldIdentifierName(v.Id))
+		}
+	}
+
+	if len(invisibleFields) == 0 && len(readOnlyFields) == 0 && len(requiredFields) == 0 {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"invisible_fields": invisibleFields,
+		"read_only_fields": readOnlyFields,
+		"required_fields":  requiredFields,
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTaskTemplateDefaults(apiObject *connect.TaskTemplateDefaults) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, v := range apiObject.DefaultFieldValues {
+		if v == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"default_value": aws.StringValue(v.DefaultValue),
+			"name":          flattenTaskTemplateFieldIdentifierName(v.Id),
+		})
+	}
+
+	return tfList
+}
+
+func flattenTaskTemplateFields(apiObjects []*connect.TaskTemplateField) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"description":           aws.StringValue(apiObject.Description),
+			"name":                  flattenTaskTemplateFieldIdentifierName(apiObject.Id),
+			"single_select_options": aws.StringValueSlice(apiObject.SingleSelectOptions),
+			"type":                  aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,235 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// @SDKDataSource("aws_connect_task_template")
+func DataSourceTaskTemplate() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTaskTemplateRead,
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"constraints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"invisible_fields": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"read_only_fields": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"required_fields": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"contact_flow_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"defaults": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Set:      taskTemplateFieldNameHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fields": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Set:      taskTemplateFieldNameHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"single_select_options": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "task_template_id"},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"task_template_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"task_template_id", "name"},
+			},
+		},
+	}
+}
+
+func dataSourceTaskTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setDefaultInstanceID(d, meta); err != nil {
+		return diagFromErr(err)
+	}
+
+	conn := meta.(*conns.AWSClient).ConnectConn()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	instanceID := d.Get("instance_id").(string)
+
+	var taskTemplateID string
+
+	if v, ok := d.GetOk("task_template_id"); ok {
+		taskTemplateID = v.(string)
+	} else if v, ok := d.GetOk("name"); ok {
+		name := v.(string)
+		taskTemplateMetadata, err := dataSourceGetTaskTemplateMetadataByName(ctx, conn, instanceID, name)
+
+		if err != nil {
+			return diagFromErr(fmt.Errorf("error finding Connect Task Template Summary by name (%s): %w", name, err))
+		}
+
+		if taskTemplateMetadata == nil {
+			return diagFromErr(fmt.Errorf("error finding Connect Task Template Summary by name (%s): not found", name))
+		}
+
+		taskTemplateID = aws.StringValue(taskTemplateMetadata.Id)
+	}
+
+	output, err := FindTaskTemplateByID(ctx, conn, instanceID, taskTemplateID)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error getting Connect Task Template (%s): %w", taskTemplateID, err))
+	}
+
+	d.Set("arn", output.Arn)
+	if err := d.Set("constraints", flattenTaskTemplateConstraints(output.Constraints)); err != nil {
+		return diagFromErr(fmt.Errorf("error setting constraints: %w", err))
+	}
+	d.Set("contact_flow_id", output.ContactFlowId)
+	if output.CreatedTime != nil {
+		d.Set("created_time", output.CreatedTime.Format(time.RFC3339))
+	}
+	if err := d.Set("defaults", flattenTaskTemplateDefaults(output.Defaults)); err != nil {
+		return diagFromErr(fmt.Errorf("error setting defaults: %w", err))
+	}
+	d.Set("description", output.Description)
+	if err := d.Set("fields", flattenTaskTemplateFields(output.Fields)); err != nil {
+		return diagFromErr(fmt.Errorf("error setting fields: %w", err))
+	}
+	d.Set("instance_id", instanceID)
+	if output.LastModifiedTime != nil {
+		d.Set("last_modified_time", output.LastModifiedTime.Format(time.RFC3339))
+	}
+	d.Set("name", output.Name)
+	d.Set("status", output.Status)
+	d.Set("task_template_id", output.Id)
+
+	if err := d.Set("tags", KeyValueTags(ctx, output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diagFromErr(fmt.Errorf("error setting tags: %s", err))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(output.Id)))
+
+	return nil
+}
+
+func dataSourceGetTaskTemplateMetadataByName(ctx context.Context, conn *connect.Connect, instanceID, name string) (*connect.TaskTemplateMetadata, error) {
+	var result *connect.TaskTemplateMetadata
+
+	input := &connect.ListTaskTemplatesInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int64(ListTaskTemplatesMaxResults),
+		Name:       aws.String(name),
+	}
+
+	err := conn.ListTaskTemplatesPagesWithContext(ctx, input, func(page *connect.ListTaskTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TaskTemplates {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.Name) == name {
+				result = v
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,91 @@
+package connect_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccTaskTemplateDataSource_taskTemplateID(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_task_template.test"
+	datasourceName := "data.aws_connect_task_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskTemplateDataSourceConfig_id(rName, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "constraints.#", resourceName, "constraints.#"),
+					resource.TestCheckResourceAttrPair(datasourceName, "constraints.0.required_fields.#", resourceName, "constraints.0.required_fields.#"),
+					resource.TestCheckResourceAttrPair(datasourceName, "defaults.#", resourceName, "defaults.#"),
+					resource.TestCheckResourceAttrPair(datasourceName, "fields.#", resourceName, "fields.#"),
+					resource.TestCheckResourceAttrPair(datasourceName, "instance_id", resourceName, "instance_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(datasourceName, "status", resourceName, "status"),
+					resource.TestCheckResourceAttrPair(datasourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(datasourceName, "task_template_id", resourceName, "task_template_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTaskTemplateDataSource_name(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_task_template.test"
+	datasourceName := "data.aws_connect_task_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskTemplateDataSourceConfig_name(rName, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "fields.#", resourceName, "fields.#"),
+					resource.TestCheckResourceAttrPair(datasourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(datasourceName, "status", resourceName, "status"),
+					resource.TestCheckResourceAttrPair(datasourceName, "task_template_id", resourceName, "task_template_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTaskTemplateDataSourceConfig_id(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccTaskTemplateConfig_basic(rName, rName2),
+		`
+data "aws_connect_task_template" "test" {
+  instance_id      = aws_connect_instance.test.id
+  task_template_id = aws_connect_task_template.test.task_template_id
+}
+`)
+}
+
+func testAccTaskTemplateDataSourceConfig_name(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccTaskTemplateConfig_basic(rName, rName2),
+		`
+data "aws_connect_task_template" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = aws_connect_task_template.test.name
+}
+`)
+}
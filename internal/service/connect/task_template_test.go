@@ -0,0 +1,319 @@
+package connect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccTaskTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.GetTaskTemplateOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_task_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskTemplateConfig_basic(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "constraints.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "constraints.0.required_fields.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "constraints.0.required_fields.*", "Name"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "defaults.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "defaults.*", map[string]string{
+						"name":          "Description",
+						"default_value": "Created by Terraform",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "fields.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "fields.*", map[string]string{
+						"name": "Name",
+						"type": connect.TaskTemplateFieldTypeName,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "fields.*", map[string]string{
+						"name": "Description",
+						"type": connect.TaskTemplateFieldTypeDescription,
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+					resource.TestCheckResourceAttr(resourceName, "status", connect.TaskTemplateStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "Value1"),
+					resource.TestCheckResourceAttrSet(resourceName, "task_template_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTaskTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.GetTaskTemplateOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_task_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskTemplateConfig_basic(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskTemplateExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfconnect.ResourceTaskTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccTaskTemplate_updateFields(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.GetTaskTemplateOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_task_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskTemplateConfig_basic(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "fields.#", "2"),
+				),
+			},
+			{
+				// Same fields, declared in a different order.
+				Config:   testAccTaskTemplateConfig_reordered(rName, rName2),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccTaskTemplateConfig_updated(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "constraints.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "constraints.0.read_only_fields.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "constraints.0.read_only_fields.*", "Priority"),
+					resource.TestCheckResourceAttr(resourceName, "defaults.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "fields.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "fields.*", map[string]string{
+						"name":                    "Priority",
+						"type":                    connect.TaskTemplateFieldTypeSingleSelect,
+						"single_select_options.#": "2",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "status", connect.TaskTemplateStatusInactive),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTaskTemplateExists(ctx context.Context, resourceName string, v *connect.GetTaskTemplateOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Connect Task Template not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Connect Task Template ID not set")
+		}
+
+		instanceID, taskTemplateID, err := tfconnect.TaskTemplateParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn()
+
+		output, err := tfconnect.FindTaskTemplateByID(ctx, conn, instanceID, taskTemplateID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckTaskTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_connect_task_template" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn()
+
+			instanceID, taskTemplateID, err := tfconnect.TaskTemplateParseID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfconnect.FindTaskTemplateByID(ctx, conn, instanceID, taskTemplateID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Task Template %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccTaskTemplateConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[1]q
+  outbound_calls_enabled   = true
+}
+`, rName)
+}
+
+func testAccTaskTemplateConfig_basic(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccTaskTemplateConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_task_template" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+
+  fields {
+    name = "Name"
+    type = "NAME"
+  }
+
+  fields {
+    name = "Description"
+    type = "DESCRIPTION"
+  }
+
+  constraints {
+    required_fields = ["Name"]
+  }
+
+  defaults {
+    name          = "Description"
+    default_value = "Created by Terraform"
+  }
+
+  tags = {
+    "Key1" = "Value1"
+  }
+}
+`, rName2))
+}
+
+func testAccTaskTemplateConfig_reordered(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccTaskTemplateConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_task_template" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+
+  fields {
+    name = "Description"
+    type = "DESCRIPTION"
+  }
+
+  fields {
+    name = "Name"
+    type = "NAME"
+  }
+
+  constraints {
+    required_fields = ["Name"]
+  }
+
+  defaults {
+    name          = "Description"
+    default_value = "Created by Terraform"
+  }
+
+  tags = {
+    "Key1" = "Value1"
+  }
+}
+`, rName2))
+}
+
+func testAccTaskTemplateConfig_updated(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccTaskTemplateConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_task_template" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  description = "Updated"
+  status      = "INACTIVE"
+
+  fields {
+    name = "Name"
+    type = "NAME"
+  }
+
+  fields {
+    name = "Description"
+    type = "DESCRIPTION"
+  }
+
+  fields {
+    name                  = "Priority"
+    type                  = "SINGLE_SELECT"
+    single_select_options = ["High", "Low"]
+  }
+
+  constraints {
+    read_only_fields = ["Priority"]
+  }
+
+  tags = {
+    "Key1" = "Value1"
+  }
+}
+`, rName2))
+}
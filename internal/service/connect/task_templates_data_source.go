@@ -0,0 +1,140 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// @SDKDataSource("aws_connect_task_templates")
+func DataSourceTaskTemplates() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTaskTemplatesRead,
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(connect.TaskTemplateStatus_Values(), false),
+			},
+			"task_templates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTaskTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setDefaultInstanceID(d, meta); err != nil {
+		return diagFromErr(err)
+	}
+
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID := d.Get("instance_id").(string)
+
+	input := &connect.ListTaskTemplatesInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int64(ListTaskTemplatesMaxResults),
+	}
+
+	if v, ok := d.GetOk("status"); ok {
+		input.Status = aws.String(v.(string))
+	}
+
+	var nameRegex *regexp.Regexp
+
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	var taskTemplates []*connect.TaskTemplateMetadata
+
+	err := conn.ListTaskTemplatesPagesWithContext(ctx, input, func(page *connect.ListTaskTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TaskTemplates {
+			if v == nil || (nameRegex != nil && !nameRegex.MatchString(aws.StringValue(v.Name))) {
+				continue
+			}
+
+			taskTemplates = append(taskTemplates, v)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error listing Connect Task Templates for Connect Instance (%s): %w", instanceID, err))
+	}
+
+	if err := d.Set("task_templates", flattenTaskTemplateMetadatas(taskTemplates)); err != nil {
+		return diagFromErr(fmt.Errorf("error setting task_templates: %w", err))
+	}
+
+	d.SetId(instanceID)
+
+	return nil
+}
+
+func flattenTaskTemplateMetadatas(apiObjects []*connect.TaskTemplateMetadata) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"arn":         aws.StringValue(apiObject.Arn),
+			"description": aws.StringValue(apiObject.Description),
+			"id":          aws.StringValue(apiObject.Id),
+			"name":        aws.StringValue(apiObject.Name),
+			"status":      aws.StringValue(apiObject.Status),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
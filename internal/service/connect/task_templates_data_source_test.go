@@ -0,0 +1,59 @@
+package connect_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccTaskTemplatesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_task_template.test"
+	datasourceName := "data.aws_connect_task_templates.test"
+	noMatchDatasourceName := "data.aws_connect_task_templates.no_match"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskTemplatesDataSourceConfig_basic(rName, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttr(datasourceName, "task_templates.#", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "task_templates.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "task_templates.0.id", resourceName, "task_template_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "task_templates.0.name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(datasourceName, "task_templates.0.status", resourceName, "status"),
+					resource.TestCheckResourceAttr(noMatchDatasourceName, "task_templates.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTaskTemplatesDataSourceConfig_basic(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccTaskTemplateConfig_basic(rName, rName2),
+		fmt.Sprintf(`
+data "aws_connect_task_templates" "test" {
+  instance_id = aws_connect_instance.test.id
+  name_regex  = "^%[1]s"
+  status      = aws_connect_task_template.test.status
+}
+
+data "aws_connect_task_templates" "no_match" {
+  instance_id = aws_connect_instance.test.id
+  name_regex  = "^no-match-"
+
+  depends_on = [aws_connect_task_template.test]
+}
+`, rName2))
+}
@@ -0,0 +1,376 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	connect_sdkv2 "github.com/aws/aws-sdk-go-v2/service/connect"
+	"github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_connect_traffic_distribution", name="Traffic Distribution")
+func ResourceTrafficDistribution() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTrafficDistributionCreate,
+		ReadWithoutTimeout:   resourceTrafficDistributionRead,
+		UpdateWithoutTimeout: resourceTrafficDistributionUpdate,
+		// The traffic distribution of a traffic distribution group cannot be deleted.
+		// NoOp the Delete method, leaving the last applied distribution in place.
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(trafficDistributionUpdatedTimeout),
+			Update: schema.DefaultTimeout(trafficDistributionUpdatedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"agent_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem:     trafficDistributionConfigSchema(),
+			},
+			"sign_in_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"distribution": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"region": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidRegionName,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"telephony_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem:     trafficDistributionConfigSchema(),
+			},
+			"traffic_distribution_group_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"traffic_distribution_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func trafficDistributionConfigSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"distribution": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"percentage": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"region": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidRegionName,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceTrafficDistributionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).ConnectClient()
+
+	trafficDistributionGroupID := d.Get("traffic_distribution_group_id").(string)
+	input := &connect_sdkv2.UpdateTrafficDistributionInput{
+		Id:              aws_sdkv2.String(trafficDistributionGroupID),
+		TelephonyConfig: expandTelephonyConfig(d.Get("telephony_config").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("agent_config"); ok {
+		input.AgentConfig = expandAgentConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("sign_in_config"); ok {
+		input.SignInConfig = expandSignInConfig(v.([]interface{}))
+	}
+
+	err := updateTrafficDistribution(ctx, client, d.Timeout(schema.TimeoutCreate), input)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error creating Connect Traffic Distribution (%s): %w", trafficDistributionGroupID, err))
+	}
+
+	d.SetId(trafficDistributionGroupID)
+
+	return resourceTrafficDistributionRead(ctx, d, meta)
+}
+
+func resourceTrafficDistributionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).ConnectClient()
+
+	output, err := FindTrafficDistributionByID(ctx, client, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Traffic Distribution (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error getting Connect Traffic Distribution (%s): %w", d.Id(), err))
+	}
+
+	if err := d.Set("agent_config", flattenAgentConfig(output.AgentConfig)); err != nil {
+		return diagFromErr(fmt.Errorf("error setting agent_config: %w", err))
+	}
+	if err := d.Set("sign_in_config", flattenSignInConfig(output.SignInConfig)); err != nil {
+		return diagFromErr(fmt.Errorf("error setting sign_in_config: %w", err))
+	}
+	if err := d.Set("telephony_config", flattenTelephonyConfig(output.TelephonyConfig)); err != nil {
+		return diagFromErr(fmt.Errorf("error setting telephony_config: %w", err))
+	}
+	d.Set("traffic_distribution_group_arn", output.Arn)
+	d.Set("traffic_distribution_group_id", d.Id())
+
+	return nil
+}
+
+func resourceTrafficDistributionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).ConnectClient()
+
+	input := &connect_sdkv2.UpdateTrafficDistributionInput{
+		Id: aws_sdkv2.String(d.Id()),
+	}
+
+	if d.HasChange("agent_config") {
+		input.AgentConfig = expandAgentConfig(d.Get("agent_config").([]interface{}))
+	}
+
+	if d.HasChange("sign_in_config") {
+		input.SignInConfig = expandSignInConfig(d.Get("sign_in_config").([]interface{}))
+	}
+
+	if d.HasChange("telephony_config") {
+		input.TelephonyConfig = expandTelephonyConfig(d.Get("telephony_config").([]interface{}))
+	}
+
+	err := updateTrafficDistribution(ctx, client, d.Timeout(schema.TimeoutUpdate), input)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error updating Connect Traffic Distribution (%s): %w", d.Id(), err))
+	}
+
+	return resourceTrafficDistributionRead(ctx, d, meta)
+}
+
+// updateTrafficDistribution updates the traffic distribution and waits for the traffic distribution group to become active again.
+// Updates are rejected while a previous update of the traffic distribution group is still in progress.
+func updateTrafficDistribution(ctx context.Context, client *connect_sdkv2.Client, timeout time.Duration, input *connect_sdkv2.UpdateTrafficDistributionInput) error {
+	trafficDistributionGroupID := aws_sdkv2.ToString(input.Id)
+
+	if _, err := waitTrafficDistributionGroupUpdated(ctx, client, timeout, trafficDistributionGroupID); err != nil {
+		return fmt.Errorf("waiting for Traffic Distribution Group to become active: %w", err)
+	}
+
+	if _, err := client.UpdateTrafficDistribution(ctx, input); err != nil {
+		return err
+	}
+
+	if _, err := waitTrafficDistributionGroupUpdated(ctx, client, timeout, trafficDistributionGroupID); err != nil {
+		return fmt.Errorf("waiting for Traffic Distribution Group update: %w", err)
+	}
+
+	return nil
+}
+
+func FindTrafficDistributionByID(ctx context.Context, client *connect_sdkv2.Client, trafficDistributionGroupID string) (*connect_sdkv2.GetTrafficDistributionOutput, error) {
+	input := &connect_sdkv2.GetTrafficDistributionInput{
+		Id: aws_sdkv2.String(trafficDistributionGroupID),
+	}
+
+	output, err := client.GetTrafficDistribution(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandAgentConfig(tfList []interface{}) *types.AgentConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &types.AgentConfig{
+		Distributions: expandTrafficDistributions(tfMap["distribution"].(*schema.Set).List()),
+	}
+}
+
+func expandTelephonyConfig(tfList []interface{}) *types.TelephonyConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &types.TelephonyConfig{
+		Distributions: expandTrafficDistributions(tfMap["distribution"].(*schema.Set).List()),
+	}
+}
+
+func expandTrafficDistributions(tfList []interface{}) []types.Distribution {
+	apiObjects := []types.Distribution{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.Distribution{
+			Percentage: int32(tfMap["percentage"].(int)),
+			Region:     aws_sdkv2.String(tfMap["region"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandSignInConfig(tfList []interface{}) *types.SignInConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.SignInConfig{}
+
+	for _, tfMapRaw := range tfMap["distribution"].(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject.Distributions = append(apiObject.Distributions, types.SignInDistribution{
+			Enabled: tfMap["enabled"].(bool),
+			Region:  aws_sdkv2.String(tfMap["region"].(string)),
+		})
+	}
+
+	return apiObject
+}
+
+func flattenAgentConfig(apiObject *types.AgentConfig) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"distribution": flattenTrafficDistributions(apiObject.Distributions),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTelephonyConfig(apiObject *types.TelephonyConfig) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"distribution": flattenTrafficDistributions(apiObject.Distributions),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTrafficDistributions(apiObjects []types.Distribution) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"percentage": int(apiObject.Percentage),
+			"region":     aws_sdkv2.ToString(apiObject.Region),
+		})
+	}
+
+	return tfList
+}
+
+func flattenSignInConfig(apiObject *types.SignInConfig) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfList := []interface{}{}
+
+	for _, v := range apiObject.Distributions {
+		tfList = append(tfList, map[string]interface{}{
+			"enabled": v.Enabled,
+			"region":  aws_sdkv2.ToString(v.Region),
+		})
+	}
+
+	tfMap := map[string]interface{}{
+		"distribution": tfList,
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,167 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	connect_sdkv2 "github.com/aws/aws-sdk-go-v2/service/connect"
+	"github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_connect_traffic_distribution_group")
+func DataSourceTrafficDistributionGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTrafficDistributionGroupRead,
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"name"},
+			},
+			"is_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "traffic_distribution_group_id"},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"traffic_distribution_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"traffic_distribution_group_id", "name"},
+			},
+		},
+	}
+}
+
+func dataSourceTrafficDistributionGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).ConnectClient()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	var trafficDistributionGroupID string
+
+	if v, ok := d.GetOk("traffic_distribution_group_id"); ok {
+		trafficDistributionGroupID = v.(string)
+	} else if v, ok := d.GetOk("name"); ok {
+		name := v.(string)
+		trafficDistributionGroupSummary, err := dataSourceGetTrafficDistributionGroupSummaryByName(ctx, client, d.Get("instance_id").(string), name)
+
+		if err != nil {
+			return diagFromErr(fmt.Errorf("error finding Connect Traffic Distribution Group Summary by name (%s): %w", name, err))
+		}
+
+		if trafficDistributionGroupSummary == nil {
+			return diagFromErr(fmt.Errorf("error finding Connect Traffic Distribution Group Summary by name (%s): not found", name))
+		}
+
+		trafficDistributionGroupID = aws_sdkv2.ToString(trafficDistributionGroupSummary.Id)
+	}
+
+	trafficDistributionGroup, err := FindTrafficDistributionGroupByID(ctx, client, trafficDistributionGroupID)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error getting Connect Traffic Distribution Group (%s): %w", trafficDistributionGroupID, err))
+	}
+
+	d.Set("arn", trafficDistributionGroup.Arn)
+	d.Set("description", trafficDistributionGroup.Description)
+	d.Set("instance_arn", trafficDistributionGroup.InstanceArn)
+	d.Set("is_default", trafficDistributionGroup.IsDefault)
+	d.Set("name", trafficDistributionGroup.Name)
+	d.Set("status", trafficDistributionGroup.Status)
+	d.Set("traffic_distribution_group_id", trafficDistributionGroup.Id)
+
+	if err := d.Set("tags", tftags.New(ctx, trafficDistributionGroup.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diagFromErr(fmt.Errorf("error setting tags: %s", err))
+	}
+
+	d.SetId(aws_sdkv2.ToString(trafficDistributionGroup.Id))
+
+	return nil
+}
+
+func FindTrafficDistributionGroupByID(ctx context.Context, client *connect_sdkv2.Client, trafficDistributionGroupID string) (*types.TrafficDistributionGroup, error) {
+	input := &connect_sdkv2.DescribeTrafficDistributionGroupInput{
+		TrafficDistributionGroupId: aws_sdkv2.String(trafficDistributionGroupID),
+	}
+
+	output, err := client.DescribeTrafficDistributionGroup(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TrafficDistributionGroup == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TrafficDistributionGroup, nil
+}
+
+// dataSourceGetTrafficDistributionGroupSummaryByName returns the traffic distribution group with the specified name.
+// If instanceID is not empty, only traffic distribution groups of that instance are considered.
+func dataSourceGetTrafficDistributionGroupSummaryByName(ctx context.Context, client *connect_sdkv2.Client, instanceID, name string) (*types.TrafficDistributionGroupSummary, error) {
+	input := &connect_sdkv2.ListTrafficDistributionGroupsInput{
+		MaxResults: aws_sdkv2.Int32(ListTrafficDistributionGroupsMaxResults),
+	}
+
+	if instanceID != "" {
+		input.InstanceId = aws_sdkv2.String(instanceID)
+	}
+
+	pages := connect_sdkv2.NewListTrafficDistributionGroupsPaginator(client, input)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.TrafficDistributionGroupSummaryList {
+			if aws_sdkv2.ToString(v.Name) == name {
+				v := v
+
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
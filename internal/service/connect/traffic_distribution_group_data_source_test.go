@@ -0,0 +1,94 @@
+package connect_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+// Traffic distribution groups require an instance replicated to another Region,
+// so the tests use an existing traffic distribution group.
+
+func testAccTrafficDistributionGroupDataSource_trafficDistributionGroupID(t *testing.T) {
+	ctx := acctest.Context(t)
+	trafficDistributionGroupID := os.Getenv("CONNECT_TRAFFIC_DISTRIBUTION_GROUP_ID")
+	if trafficDistributionGroupID == "" {
+		t.Skip("Environment variable CONNECT_TRAFFIC_DISTRIBUTION_GROUP_ID is not set")
+	}
+	datasourceName := "data.aws_connect_traffic_distribution_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficDistributionGroupDataSourceConfig_id(trafficDistributionGroupID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "id", trafficDistributionGroupID),
+					resource.TestCheckResourceAttrSet(datasourceName, "arn"),
+					resource.TestCheckResourceAttrSet(datasourceName, "instance_arn"),
+					resource.TestCheckResourceAttrSet(datasourceName, "is_default"),
+					resource.TestCheckResourceAttrSet(datasourceName, "name"),
+					resource.TestCheckResourceAttrSet(datasourceName, "status"),
+					resource.TestCheckResourceAttr(datasourceName, "traffic_distribution_group_id", trafficDistributionGroupID),
+				),
+			},
+		},
+	})
+}
+
+func testAccTrafficDistributionGroupDataSource_name(t *testing.T) {
+	ctx := acctest.Context(t)
+	trafficDistributionGroupID := os.Getenv("CONNECT_TRAFFIC_DISTRIBUTION_GROUP_ID")
+	if trafficDistributionGroupID == "" {
+		t.Skip("Environment variable CONNECT_TRAFFIC_DISTRIBUTION_GROUP_ID is not set")
+	}
+	datasourceName := "data.aws_connect_traffic_distribution_group.test"
+	datasourceName2 := "data.aws_connect_traffic_distribution_group.by_name"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficDistributionGroupDataSourceConfig_name(trafficDistributionGroupID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName2, "id", datasourceName, "id"),
+					resource.TestCheckResourceAttrPair(datasourceName2, "arn", datasourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName2, "description", datasourceName, "description"),
+					resource.TestCheckResourceAttrPair(datasourceName2, "instance_arn", datasourceName, "instance_arn"),
+					resource.TestCheckResourceAttrPair(datasourceName2, "is_default", datasourceName, "is_default"),
+					resource.TestCheckResourceAttrPair(datasourceName2, "name", datasourceName, "name"),
+					resource.TestCheckResourceAttrPair(datasourceName2, "status", datasourceName, "status"),
+					resource.TestCheckResourceAttrPair(datasourceName2, "tags.%", datasourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(datasourceName2, "traffic_distribution_group_id", datasourceName, "traffic_distribution_group_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTrafficDistributionGroupDataSourceConfig_id(trafficDistributionGroupID string) string {
+	return fmt.Sprintf(`
+data "aws_connect_traffic_distribution_group" "test" {
+  traffic_distribution_group_id = %[1]q
+}
+`, trafficDistributionGroupID)
+}
+
+func testAccTrafficDistributionGroupDataSourceConfig_name(trafficDistributionGroupID string) string {
+	return acctest.ConfigCompose(
+		testAccTrafficDistributionGroupDataSourceConfig_id(trafficDistributionGroupID),
+		`
+data "aws_connect_traffic_distribution_group" "by_name" {
+  instance_id = split("/", data.aws_connect_traffic_distribution_group.test.instance_arn)[1]
+  name        = data.aws_connect_traffic_distribution_group.test.name
+}
+`)
+}
@@ -0,0 +1,116 @@
+package connect_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+)
+
+// Traffic distribution groups require an instance replicated to another Region,
+// so the tests use an existing traffic distribution group of an instance replicated
+// to the alternate Region.
+
+func testAccTrafficDistribution_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	trafficDistributionGroupID := os.Getenv("CONNECT_TRAFFIC_DISTRIBUTION_GROUP_ID")
+	if trafficDistributionGroupID == "" {
+		t.Skip("Environment variable CONNECT_TRAFFIC_DISTRIBUTION_GROUP_ID is not set")
+	}
+	resourceName := "aws_connect_traffic_distribution.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficDistributionConfig_basic(trafficDistributionGroupID, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficDistributionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "id", trafficDistributionGroupID),
+					resource.TestCheckResourceAttrSet(resourceName, "traffic_distribution_group_arn"),
+					resource.TestCheckResourceAttr(resourceName, "telephony_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "telephony_config.0.distribution.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "telephony_config.0.distribution.*", map[string]string{
+						"percentage": "100",
+						"region":     acctest.Region(),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "telephony_config.0.distribution.*", map[string]string{
+						"percentage": "0",
+						"region":     acctest.AlternateRegion(),
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTrafficDistributionConfig_basic(trafficDistributionGroupID, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficDistributionExists(ctx, resourceName),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "telephony_config.0.distribution.*", map[string]string{
+						"percentage": "50",
+						"region":     acctest.Region(),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "telephony_config.0.distribution.*", map[string]string{
+						"percentage": "50",
+						"region":     acctest.AlternateRegion(),
+					}),
+				),
+			},
+			{
+				Config: testAccTrafficDistributionConfig_basic(trafficDistributionGroupID, 100),
+			},
+		},
+	})
+}
+
+func testAccCheckTrafficDistributionExists(ctx context.Context, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Connect Traffic Distribution not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Connect Traffic Distribution ID not set")
+		}
+
+		client := acctest.Provider.Meta().(*conns.AWSClient).ConnectClient()
+
+		_, err := tfconnect.FindTrafficDistributionByID(ctx, client, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccTrafficDistributionConfig_basic(trafficDistributionGroupID string, percentage int) string {
+	return fmt.Sprintf(`
+resource "aws_connect_traffic_distribution" "test" {
+  traffic_distribution_group_id = %[1]q
+
+  telephony_config {
+    distribution {
+      percentage = %[2]d
+      region     = %[3]q
+    }
+
+    distribution {
+      percentage = %[4]d
+      region     = %[5]q
+    }
+  }
+}
+`, trafficDistributionGroupID, percentage, acctest.Region(), 100-percentage, acctest.AlternateRegion())
+}
@@ -5,16 +5,20 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -30,7 +34,15 @@ func ResourceUser() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(userCreatedTimeout),
+		},
+		CustomizeDiff: customdiff.Sequence(
+			defaultInstanceIDCustomizeDiff,
+			resourceUserCustomizeDiff,
+			resourceUserReferencesCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -42,35 +54,27 @@ func ResourceUser() *schema.Resource {
 				Computed: true,
 			},
 			"hierarchy_group_id": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"hierarchy_group_path"},
+			},
+			"hierarchy_group_path": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"hierarchy_group_id"},
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
 			},
 			"identity_info": {
 				Type:     schema.TypeList,
 				Optional: true,
 				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"email": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"first_name": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringLenBetween(1, 100),
-						},
-						"last_name": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringLenBetween(1, 100),
-						},
-					},
-				},
+				Elem:     userIdentityInfoSchema(),
 			},
 			"instance_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 100),
 			},
@@ -90,49 +94,42 @@ func ResourceUser() *schema.Resource {
 				Type:     schema.TypeList,
 				Required: true,
 				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"after_contact_work_time_limit": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntAtLeast(0),
-						},
-						"auto_accept": {
-							Type:     schema.TypeBool,
-							Optional: true,
-						},
-						"desk_phone_number": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validDeskPhoneNumber,
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								if v := d.Get("phone_config.0.phone_type").(string); v == connect.PhoneTypeDeskPhone {
-									return false
-								}
-								return true
-							},
-						},
-						"phone_type": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice(connect.PhoneType_Values(), false),
-						},
-					},
-				},
+				Elem:     userPhoneConfigSchema(),
 			},
 			"routing_profile_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"routing_profile_id", "routing_profile_name"},
+			},
+			"routing_profile_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"routing_profile_id", "routing_profile_name"},
+				ValidateFunc: validation.StringLenBetween(1, 127),
 			},
 			"security_profile_ids": {
-				Type:     schema.TypeSet,
-				Required: true,
-				MinItems: 1,
-				MaxItems: 10,
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Computed:     true,
+				MinItems:     1,
+				MaxItems:     10,
+				ExactlyOneOf: []string{"security_profile_ids", "security_profile_names"},
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
+			"security_profile_names": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				MinItems:     1,
+				MaxItems:     10,
+				ExactlyOneOf: []string{"security_profile_ids", "security_profile_names"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 127),
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"user_id": {
@@ -143,49 +140,232 @@ func ResourceUser() *schema.Resource {
 	}
 }
 
+func resourceUserCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// passwords cannot be set for instances using SAML identity management, and users of other instances need
+	// either a password or a directory user
+	if !diff.NewValueKnown("instance_id") {
+		return nil
+	}
+
+	if diff.Id() != "" && !diff.HasChanges("instance_id", "password") {
+		return nil
+	}
+
+	// values that are not known yet, e.g. a password generated by random_password, count as set
+	passwordSet := !diff.NewValueKnown("password") || diff.Get("password").(string) != ""
+	directoryUserIDSet := !diff.NewValueKnown("directory_user_id") || diff.Get("directory_user_id").(string) != ""
+
+	// the password of an existing user is not read back, so either is only required when the user is created
+	if !passwordSet && (diff.Id() != "" || directoryUserIDSet) {
+		return nil
+	}
+
+	instanceID := diff.Get("instance_id").(string)
+
+	// the identity management type cannot change, so a cached lookup is shared by all users of the instance
+	instance, err := findInstanceByIDMemoized(ctx, meta.(*conns.AWSClient), instanceID)
+
+	// the instance may not exist yet, e.g. when it is created in the same configuration
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeAccessDeniedException) {
+		log.Printf("[WARN] skipping identity management validation of Connect User, reading Connect Instance (%s): %s", instanceID, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading Connect Instance (%s): %w", instanceID, err)
+	}
+
+	switch v := aws.StringValue(instance.IdentityManagementType); v {
+	case connect.DirectoryTypeSaml:
+		// SAML instances authenticate users outside of Amazon Connect
+		if passwordSet {
+			return fmt.Errorf("`password` cannot be set for Connect Instances using %q identity management, Connect Instance (%s)", v, instanceID)
+		}
+	default:
+		if !passwordSet && !directoryUserIDSet {
+			return fmt.Errorf("`password` or `directory_user_id` is required for Connect Instances using %q identity management, Connect Instance (%s)", v, instanceID)
+		}
+	}
+
+	return nil
+}
+
+// resourceUserReferencesCustomizeDiff resolves routing_profile_name, security_profile_names and hierarchy_group_path
+// to IDs so that the plan shows the IDs that will be assigned to the user.
+// Names that cannot be resolved yet, e.g. of profiles created in the same apply, are resolved during apply.
+func resourceUserReferencesCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	client := meta.(*conns.AWSClient)
+	instanceID := diff.Get("instance_id").(string)
+
+	setNewID := func(key, nameKey string, resolve func() (interface{}, error)) error {
+		if !diff.NewValueKnown("instance_id") || !diff.NewValueKnown(nameKey) {
+			return diff.SetNewComputed(key)
+		}
+
+		v, err := resolve()
+
+		if tfresource.NotFound(err) {
+			return diff.SetNewComputed(key)
+		}
+
+		if err != nil {
+			return fmt.Errorf("resolving %s: %w", nameKey, err)
+		}
+
+		return diff.SetNew(key, v)
+	}
+
+	if v := diff.Get("routing_profile_name").(string); v != "" || !diff.NewValueKnown("routing_profile_name") {
+		if err := setNewID("routing_profile_id", "routing_profile_name", func() (interface{}, error) {
+			return findRoutingProfileIDByName(ctx, client, instanceID, v)
+		}); err != nil {
+			return err
+		}
+	}
+
+	if v := diff.Get("security_profile_names").(*schema.Set); v.Len() > 0 || !diff.NewValueKnown("security_profile_names") {
+		if err := setNewID("security_profile_ids", "security_profile_names", func() (interface{}, error) {
+			return findSecurityProfileIDsByNames(ctx, client, instanceID, flex.ExpandStringValueSet(v))
+		}); err != nil {
+			return err
+		}
+	}
+
+	if v := diff.Get("hierarchy_group_path").(string); v != "" || !diff.NewValueKnown("hierarchy_group_path") {
+		if err := setNewID("hierarchy_group_id", "hierarchy_group_path", func() (interface{}, error) {
+			return findUserHierarchyGroupIDByPath(ctx, client, instanceID, v)
+		}); err != nil {
+			return err
+		}
+	} else if c := diff.GetRawConfig(); !c.IsNull() && c.GetAttr("hierarchy_group_id").IsNull() && diff.Get("hierarchy_group_id").(string) != "" {
+		// hierarchy_group_id is Computed, so removing both it and hierarchy_group_path from configuration must be planned explicitly.
+		if err := diff.SetNew("hierarchy_group_id", ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ConnectConn()
 
 	instanceID := d.Get("instance_id").(string)
 	name := d.Get("name").(string)
+
+	phoneConfig, err := expandPhoneConfig(d.Get("phone_config").([]interface{}))
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("expanding phone_config: %w", err))
+	}
+
 	input := &connect.CreateUserInput{
-		InstanceId:         aws.String(instanceID),
-		PhoneConfig:        expandPhoneConfig(d.Get("phone_config").([]interface{})),
-		RoutingProfileId:   aws.String(d.Get("routing_profile_id").(string)),
-		SecurityProfileIds: flex.ExpandStringSet(d.Get("security_profile_ids").(*schema.Set)),
-		Tags:               GetTagsIn(ctx),
-		Username:           aws.String(name),
+		InstanceId:  aws.String(instanceID),
+		PhoneConfig: phoneConfig,
+		Tags:        GetTagsIn(ctx),
+		Username:    aws.String(name),
 	}
 
-	if v, ok := d.GetOk("directory_user_id"); ok {
-		input.DirectoryUserId = aws.String(v.(string))
+	input.RoutingProfileId, input.SecurityProfileIds, input.HierarchyGroupId, err = expandUserReferences(ctx, meta.(*conns.AWSClient), d)
+
+	if err != nil {
+		return diagFromErr(err)
 	}
 
-	if v, ok := d.GetOk("hierarchy_group_id"); ok {
-		input.HierarchyGroupId = aws.String(v.(string))
+	if v, ok := d.GetOk("directory_user_id"); ok {
+		input.DirectoryUserId = aws.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("identity_info"); ok {
-		input.IdentityInfo = expandIdentityInfo(v.([]interface{}))
+		identityInfo, err := expandIdentityInfo(v.([]interface{}))
+
+		if err != nil {
+			return diagFromErr(fmt.Errorf("expanding identity_info: %w", err))
+		}
+
+		input.IdentityInfo = identityInfo
 	}
 
 	if v, ok := d.GetOk("password"); ok {
 		input.Password = aws.String(v.(string))
 	}
 
-	output, err := conn.CreateUserWithContext(ctx, input)
+	output, err := createUser(ctx, conn, d.Timeout(schema.TimeoutCreate), input)
+
+	// Tag-on-create requires connect:TagResource in addition to connect:CreateUser.
+	// If the caller may not tag, create the user untagged and attempt to tag it afterwards.
+	if input.Tags != nil && tfawserr.ErrCodeEquals(err, connect.ErrCodeAccessDeniedException) {
+		input.Tags = nil
+
+		output, err = createUser(ctx, conn, d.Timeout(schema.TimeoutCreate), input)
+	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating Connect User (%s): %w", name, err))
+		return diagFromErr(fmt.Errorf("error creating Connect User (%s): %w", name, err))
 	}
 
 	if output == nil {
-		return diag.FromErr(fmt.Errorf("error creating Connect User (%s): empty output", name))
+		return diagFromErr(fmt.Errorf("error creating Connect User (%s): empty output", name))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(output.UserId)))
 
-	return resourceUserRead(ctx, d, meta)
+	var diags diag.Diagnostics
+
+	if tags := GetTagsIn(ctx); input.Tags == nil && len(tags) > 0 {
+		// The new user may not be visible to TagResource straight away.
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+			return nil, createTags(ctx, conn, aws.StringValue(output.UserArn), tags)
+		}, connect.ErrCodeResourceNotFoundException)
+
+		// If default tags only, continue with a warning. Otherwise, error.
+		// The user has been created either way, so it remains in state.
+		if v, ok := d.GetOk("tags"); (!ok || len(v.(map[string]interface{})) == 0) && err != nil {
+			diags = sdkdiag.AppendWarningf(diags, "setting Connect User (%s) default tags: %s", d.Id(), err)
+		} else if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting Connect User (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceUserRead(ctx, d, meta)...)
+}
+
+// createUser retries while a user with the same username is still being deleted, e.g. during a replacement.
+// A deleted user is no longer listed, but its username cannot be reused for a while.
+// If a user with the username is listed, it is not being deleted, so the error is returned straight away.
+func createUser(ctx context.Context, conn *connect.Connect, timeout time.Duration, input *connect.CreateUserInput) (*connect.CreateUserOutput, error) {
+	outputRaw, err := tfresource.RetryWhen(ctx, timeout,
+		func() (interface{}, error) {
+			return conn.CreateUserWithContext(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if !tfawserr.ErrCodeEquals(err, connect.ErrCodeDuplicateResourceException) {
+				return false, err
+			}
+
+			user, lerr := dataSourceGetUserSummaryByName(ctx, conn, aws.StringValue(input.InstanceId), aws.StringValue(input.Username))
+
+			if lerr != nil {
+				return false, fmt.Errorf("%w; listing users: %s", err, lerr)
+			}
+
+			if user != nil {
+				return false, fmt.Errorf("username %q is already in use by Connect User (%s): %w", aws.StringValue(input.Username), aws.StringValue(user.Id), err)
+			}
+
+			return true, err
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outputRaw.(*connect.CreateUserOutput), nil
 }
 
 func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -194,7 +374,7 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	instanceID, userID, err := UserParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	resp, err := conn.DescribeUserWithContext(ctx, &connect.DescribeUserInput{
@@ -209,11 +389,11 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect User (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error getting Connect User (%s): %w", d.Id(), err))
 	}
 
 	if resp == nil || resp.User == nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect User (%s): empty response", d.Id()))
+		return diagFromErr(fmt.Errorf("error getting Connect User (%s): empty response", d.Id()))
 	}
 
 	user := resp.User
@@ -227,12 +407,24 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	d.Set("security_profile_ids", flex.FlattenStringSet(user.SecurityProfileIds))
 	d.Set("user_id", user.Id)
 
-	if err := d.Set("identity_info", flattenIdentityInfo(user.IdentityInfo)); err != nil {
-		return diag.FromErr(fmt.Errorf("error setting identity_info: %w", err))
+	identityInfo, err := flattenIdentityInfo(user.IdentityInfo)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("flattening identity_info: %w", err))
+	}
+
+	if err := d.Set("identity_info", identityInfo); err != nil {
+		return diagFromErr(fmt.Errorf("error setting identity_info: %w", err))
 	}
 
-	if err := d.Set("phone_config", flattenPhoneConfig(user.PhoneConfig)); err != nil {
-		return diag.FromErr(fmt.Errorf("error setting phone_config: %w", err))
+	phoneConfig, err := flattenPhoneConfig(user.PhoneConfig)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("flattening phone_config: %w", err))
+	}
+
+	if err := d.Set("phone_config", phoneConfig); err != nil {
+		return diagFromErr(fmt.Errorf("error setting phone_config: %w", err))
 	}
 
 	SetTagsOut(ctx, resp.User.Tags)
@@ -246,7 +438,7 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	instanceID, userID, err := UserParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// User has 5 update APIs
@@ -256,28 +448,37 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	// UpdateUserRoutingProfileWithContext: Assigns the specified routing profile to the specified user.
 	// UpdateUserSecurityProfilesWithContext: Assigns the specified security profiles to the specified user.
 
+	routingProfileID, securityProfileIDs, hierarchyGroupID, err := expandUserReferences(ctx, meta.(*conns.AWSClient), d)
+
+	if err != nil {
+		return diagFromErr(err)
+	}
+
 	// updates to hierarchy_group_id
-	if d.HasChange("hierarchy_group_id") {
+	if d.HasChanges("hierarchy_group_id", "hierarchy_group_path") {
 		input := &connect.UpdateUserHierarchyInput{
-			InstanceId: aws.String(instanceID),
-			UserId:     aws.String(userID),
-		}
-
-		if v, ok := d.GetOk("hierarchy_group_id"); ok {
-			input.HierarchyGroupId = aws.String(v.(string))
+			HierarchyGroupId: hierarchyGroupID,
+			InstanceId:       aws.String(instanceID),
+			UserId:           aws.String(userID),
 		}
 
 		_, err = conn.UpdateUserHierarchyWithContext(ctx, input)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("updating User hierarchy_group_id (%s): %w", d.Id(), err))
+			return diagFromErr(fmt.Errorf("updating User hierarchy_group_id (%s): %w", d.Id(), err))
 		}
 	}
 
 	// updates to identity_info
 	if d.HasChange("identity_info") {
+		identityInfo, err := expandIdentityInfo(d.Get("identity_info").([]interface{}))
+
+		if err != nil {
+			return diagFromErr(fmt.Errorf("expanding identity_info: %w", err))
+		}
+
 		input := &connect.UpdateUserIdentityInfoInput{
-			IdentityInfo: expandIdentityInfo(d.Get("identity_info").([]interface{})),
+			IdentityInfo: identityInfo,
 			InstanceId:   aws.String(instanceID),
 			UserId:       aws.String(userID),
 		}
@@ -285,52 +486,58 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		_, err = conn.UpdateUserIdentityInfoWithContext(ctx, input)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("updating User identity_info (%s): %w", d.Id(), err))
+			return diagFromErr(fmt.Errorf("updating User identity_info (%s): %w", d.Id(), err))
 		}
 	}
 
 	// updates to phone_config
 	if d.HasChange("phone_config") {
+		phoneConfig, err := expandPhoneConfig(d.Get("phone_config").([]interface{}))
+
+		if err != nil {
+			return diagFromErr(fmt.Errorf("expanding phone_config: %w", err))
+		}
+
 		input := &connect.UpdateUserPhoneConfigInput{
 			InstanceId:  aws.String(instanceID),
-			PhoneConfig: expandPhoneConfig(d.Get("phone_config").([]interface{})),
+			PhoneConfig: phoneConfig,
 			UserId:      aws.String(userID),
 		}
 
 		_, err = conn.UpdateUserPhoneConfigWithContext(ctx, input)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("updating User phone_config (%s): %w", d.Id(), err))
+			return diagFromErr(fmt.Errorf("updating User phone_config (%s): %w", d.Id(), err))
 		}
 	}
 
 	// updates to routing_profile_id
-	if d.HasChange("routing_profile_id") {
+	if d.HasChanges("routing_profile_id", "routing_profile_name") {
 		input := &connect.UpdateUserRoutingProfileInput{
 			InstanceId:       aws.String(instanceID),
-			RoutingProfileId: aws.String(d.Get("routing_profile_id").(string)),
+			RoutingProfileId: routingProfileID,
 			UserId:           aws.String(userID),
 		}
 
 		_, err = conn.UpdateUserRoutingProfileWithContext(ctx, input)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("updating User routing_profile_id (%s): %w", d.Id(), err))
+			return diagFromErr(fmt.Errorf("updating User routing_profile_id (%s): %w", d.Id(), err))
 		}
 	}
 
 	// updates to security_profile_ids
-	if d.HasChange("security_profile_ids") {
+	if d.HasChanges("security_profile_ids", "security_profile_names") {
 		input := &connect.UpdateUserSecurityProfilesInput{
 			InstanceId:         aws.String(instanceID),
-			SecurityProfileIds: flex.ExpandStringSet(d.Get("security_profile_ids").(*schema.Set)),
+			SecurityProfileIds: securityProfileIDs,
 			UserId:             aws.String(userID),
 		}
 
 		_, err = conn.UpdateUserSecurityProfilesWithContext(ctx, input)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("updating User security_profile_ids (%s): %w", d.Id(), err))
+			return diagFromErr(fmt.Errorf("updating User security_profile_ids (%s): %w", d.Id(), err))
 		}
 	}
 
@@ -343,7 +550,7 @@ func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	instanceID, userID, err := UserParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = conn.DeleteUserWithContext(ctx, &connect.DeleteUserInput{
@@ -352,120 +559,134 @@ func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting User (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error deleting User (%s): %w", d.Id(), err))
 	}
 
 	return nil
 }
 
-func UserParseID(id string) (string, string, error) {
-	parts := strings.SplitN(id, ":", 2)
-
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		return "", "", fmt.Errorf("unexpected format of ID (%s), expected instanceID:userID", id)
-	}
+// expandUserReferences returns the routing profile, security profile and hierarchy group IDs for the user,
+// resolving them by name if configured that way. A nil hierarchy group ID removes the user from its group.
+func expandUserReferences(ctx context.Context, client *conns.AWSClient, d *schema.ResourceData) (*string, []*string, *string, error) {
+	instanceID := d.Get("instance_id").(string)
+	routingProfileID := d.Get("routing_profile_id").(string)
+	securityProfileIDs := flex.ExpandStringValueSet(d.Get("security_profile_ids").(*schema.Set))
+	hierarchyGroupID := d.Get("hierarchy_group_id").(string)
 
-	return parts[0], parts[1], nil
-}
+	if v, ok := d.GetOk("routing_profile_name"); ok {
+		id, err := findRoutingProfileIDByName(ctx, client, instanceID, v.(string))
 
-func expandIdentityInfo(identityInfo []interface{}) *connect.UserIdentityInfo {
-	if len(identityInfo) == 0 || identityInfo[0] == nil {
-		return nil
-	}
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("resolving routing_profile_name (%s): %w", v, err)
+		}
 
-	tfMap, ok := identityInfo[0].(map[string]interface{})
-	if !ok {
-		return nil
+		routingProfileID = id
 	}
 
-	result := &connect.UserIdentityInfo{}
+	if v, ok := d.GetOk("security_profile_names"); ok {
+		ids, err := findSecurityProfileIDsByNames(ctx, client, instanceID, flex.ExpandStringValueSet(v.(*schema.Set)))
 
-	if v, ok := tfMap["email"].(string); ok && v != "" {
-		result.Email = aws.String(v)
-	}
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("resolving security_profile_names: %w", err)
+		}
 
-	if v, ok := tfMap["first_name"].(string); ok && v != "" {
-		result.FirstName = aws.String(v)
+		securityProfileIDs = ids
 	}
 
-	if v, ok := tfMap["last_name"].(string); ok && v != "" {
-		result.LastName = aws.String(v)
-	}
+	if v, ok := d.GetOk("hierarchy_group_path"); ok {
+		id, err := findUserHierarchyGroupIDByPath(ctx, client, instanceID, v.(string))
 
-	return result
-}
-
-func expandPhoneConfig(phoneConfig []interface{}) *connect.UserPhoneConfig {
-	if len(phoneConfig) == 0 || phoneConfig[0] == nil {
-		return nil
-	}
-
-	tfMap, ok := phoneConfig[0].(map[string]interface{})
-	if !ok {
-		return nil
-	}
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("resolving hierarchy_group_path (%s): %w", v, err)
+		}
 
-	result := &connect.UserPhoneConfig{
-		PhoneType: aws.String(tfMap["phone_type"].(string)),
+		hierarchyGroupID = id
 	}
 
-	if v, ok := tfMap["after_contact_work_time_limit"].(int); ok && v >= 0 {
-		result.AfterContactWorkTimeLimit = aws.Int64(int64(v))
-	}
+	var apiHierarchyGroupID *string
 
-	if v, ok := tfMap["auto_accept"].(bool); ok {
-		result.AutoAccept = aws.Bool(v)
+	if hierarchyGroupID != "" {
+		apiHierarchyGroupID = aws.String(hierarchyGroupID)
 	}
 
-	if v, ok := tfMap["desk_phone_number"].(string); ok && v != "" {
-		result.DeskPhoneNumber = aws.String(v)
-	}
-
-	return result
+	return aws.String(routingProfileID), aws.StringSlice(securityProfileIDs), apiHierarchyGroupID, nil
 }
 
-func flattenIdentityInfo(identityInfo *connect.UserIdentityInfo) []interface{} {
-	if identityInfo == nil {
-		return []interface{}{}
-	}
-
-	values := map[string]interface{}{}
-
-	if v := identityInfo.Email; v != nil {
-		values["email"] = aws.StringValue(v)
-	}
-
-	if v := identityInfo.FirstName; v != nil {
-		values["first_name"] = aws.StringValue(v)
-	}
+func UserParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
 
-	if v := identityInfo.LastName; v != nil {
-		values["last_name"] = aws.StringValue(v)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected instanceID:userID", id)
 	}
 
-	return []interface{}{values}
+	return parts[0], parts[1], nil
 }
 
-func flattenPhoneConfig(phoneConfig *connect.UserPhoneConfig) []interface{} {
-	if phoneConfig == nil {
-		return []interface{}{}
+func userIdentityInfoSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"first_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"last_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+		},
 	}
+}
 
-	values := map[string]interface{}{
-		"phone_type": aws.StringValue(phoneConfig.PhoneType),
+func userPhoneConfigSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"after_contact_work_time_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"auto_accept": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"desk_phone_number": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidE164PhoneNumber,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					if v := d.Get("phone_config.0.phone_type").(string); v == connect.PhoneTypeDeskPhone {
+						return false
+					}
+					return true
+				},
+			},
+			"phone_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(connect.PhoneType_Values(), false),
+			},
+		},
 	}
+}
 
-	if v := phoneConfig.AfterContactWorkTimeLimit; v != nil {
-		values["after_contact_work_time_limit"] = aws.Int64Value(v)
-	}
+func expandIdentityInfo(tfList []interface{}) (*connect.UserIdentityInfo, error) {
+	return flex.ExpandFirst[connect.UserIdentityInfo](tfList, flex.OmitEmptyStrings())
+}
 
-	if v := phoneConfig.AutoAccept; v != nil {
-		values["auto_accept"] = aws.BoolValue(v)
-	}
+func expandPhoneConfig(tfList []interface{}) (*connect.UserPhoneConfig, error) {
+	return flex.ExpandFirst[connect.UserPhoneConfig](tfList, flex.OmitEmptyStrings())
+}
 
-	if v := phoneConfig.DeskPhoneNumber; v != nil {
-		values["desk_phone_number"] = aws.StringValue(v)
-	}
+func flattenIdentityInfo(apiObject *connect.UserIdentityInfo) ([]interface{}, error) {
+	return flex.FlattenFirst(apiObject, userIdentityInfoSchema())
+}
 
-	return []interface{}{values}
+func flattenPhoneConfig(apiObject *connect.UserPhoneConfig) ([]interface{}, error) {
+	return flex.FlattenFirst(apiObject, userPhoneConfigSchema())
 }
@@ -54,7 +54,8 @@ func DataSourceUser() *schema.Resource {
 			},
 			"instance_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(1, 100),
 			},
 			"name": {
@@ -110,6 +111,10 @@ func DataSourceUser() *schema.Resource {
 }
 
 func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setDefaultInstanceID(d, meta); err != nil {
+		return diagFromErr(err)
+	}
+
 	conn := meta.(*conns.AWSClient).ConnectConn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -126,11 +131,11 @@ func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, meta interf
 		userSummary, err := dataSourceGetUserSummaryByName(ctx, conn, instanceID, name)
 
 		if err != nil {
-			return diag.Errorf("finding Connect User Summary by name (%s): %s", name, err)
+			return diagErrorf("finding Connect User Summary by name (%s): %s", name, err)
 		}
 
 		if userSummary == nil {
-			return diag.Errorf("finding Connect User Summary by name (%s): not found", name)
+			return diagErrorf("finding Connect User Summary by name (%s): not found", name)
 		}
 
 		input.UserId = userSummary.Id
@@ -139,11 +144,11 @@ func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, meta interf
 	resp, err := conn.DescribeUserWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("getting Connect User: %s", err)
+		return diagErrorf("getting Connect User: %s", err)
 	}
 
 	if resp == nil || resp.User == nil {
-		return diag.Errorf("getting Connect User: empty response")
+		return diagErrorf("getting Connect User: empty response")
 	}
 
 	user := resp.User
@@ -157,16 +162,28 @@ func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("security_profile_ids", flex.FlattenStringSet(user.SecurityProfileIds))
 	d.Set("user_id", user.Id)
 
-	if err := d.Set("identity_info", flattenIdentityInfo(user.IdentityInfo)); err != nil {
-		return diag.Errorf("setting identity_info: %s", err)
+	identityInfo, err := flattenIdentityInfo(user.IdentityInfo)
+
+	if err != nil {
+		return diagErrorf("flattening identity_info: %s", err)
+	}
+
+	if err := d.Set("identity_info", identityInfo); err != nil {
+		return diagErrorf("setting identity_info: %s", err)
+	}
+
+	phoneConfig, err := flattenPhoneConfig(user.PhoneConfig)
+
+	if err != nil {
+		return diagErrorf("flattening phone_config: %s", err)
 	}
 
-	if err := d.Set("phone_config", flattenPhoneConfig(user.PhoneConfig)); err != nil {
-		return diag.Errorf("setting phone_config: %s", err)
+	if err := d.Set("phone_config", phoneConfig); err != nil {
+		return diagErrorf("setting phone_config: %s", err)
 	}
 
 	if err := d.Set("tags", KeyValueTags(ctx, user.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
-		return diag.Errorf("setting tags: %s", err)
+		return diagErrorf("setting tags: %s", err)
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(user.Id)))
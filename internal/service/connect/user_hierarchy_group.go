@@ -10,10 +10,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -27,9 +29,16 @@ func ResourceUserHierarchyGroup() *schema.Resource {
 		UpdateWithoutTimeout: resourceUserHierarchyGroupUpdate,
 		DeleteWithoutTimeout: resourceUserHierarchyGroupDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceUserHierarchyGroupImport,
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(userHierarchyGroupDeletedTimeout),
+		},
+		CustomizeDiff: customdiff.Sequence(
+			defaultInstanceIDCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -69,7 +78,8 @@ func ResourceUserHierarchyGroup() *schema.Resource {
 			},
 			"instance_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(1, 100),
 			},
 			"level_id": {
@@ -121,6 +131,7 @@ func resourceUserHierarchyGroupCreate(ctx context.Context, d *schema.ResourceDat
 
 	instanceID := d.Get("instance_id").(string)
 	userHierarchyGroupName := d.Get("name").(string)
+
 	input := &connect.CreateUserHierarchyGroupInput{
 		InstanceId: aws.String(instanceID),
 		Name:       aws.String(userHierarchyGroupName),
@@ -132,14 +143,18 @@ func resourceUserHierarchyGroupCreate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	log.Printf("[DEBUG] Creating Connect User Hierarchy Group %s", input)
-	output, err := conn.CreateUserHierarchyGroupWithContext(ctx, input)
+	output, err := withInstanceLock(instanceID, func() (*connect.CreateUserHierarchyGroupOutput, error) {
+		return conn.CreateUserHierarchyGroupWithContext(ctx, input)
+	})
+
+	conns.Forget(meta.(*conns.AWSClient), userHierarchyGroupsMemoKey(instanceID))
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating Connect User Hierarchy Group (%s): %w", userHierarchyGroupName, err))
+		return diagFromErr(fmt.Errorf("error creating Connect User Hierarchy Group (%s): %w", userHierarchyGroupName, err))
 	}
 
 	if output == nil {
-		return diag.FromErr(fmt.Errorf("error creating Connect User Hierarchy Group (%s): empty output", userHierarchyGroupName))
+		return diagFromErr(fmt.Errorf("error creating Connect User Hierarchy Group (%s): empty output", userHierarchyGroupName))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(output.HierarchyGroupId)))
@@ -153,7 +168,7 @@ func resourceUserHierarchyGroupRead(ctx context.Context, d *schema.ResourceData,
 	instanceID, userHierarchyGroupID, err := UserHierarchyGroupParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	resp, err := conn.DescribeUserHierarchyGroupWithContext(ctx, &connect.DescribeUserHierarchyGroupInput{
@@ -168,11 +183,11 @@ func resourceUserHierarchyGroupRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect User Hierarchy Group (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error getting Connect User Hierarchy Group (%s): %w", d.Id(), err))
 	}
 
 	if resp == nil || resp.HierarchyGroup == nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect User Hierarchy Group (%s): empty response", d.Id()))
+		return diagFromErr(fmt.Errorf("error getting Connect User Hierarchy Group (%s): empty response", d.Id()))
 	}
 
 	d.Set("arn", resp.HierarchyGroup.Arn)
@@ -182,7 +197,7 @@ func resourceUserHierarchyGroupRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("name", resp.HierarchyGroup.Name)
 
 	if err := d.Set("hierarchy_path", flattenUserHierarchyPath(resp.HierarchyGroup.HierarchyPath)); err != nil {
-		return diag.FromErr(fmt.Errorf("error setting Connect User Hierarchy Group hierarchy_path (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error setting Connect User Hierarchy Group hierarchy_path (%s): %w", d.Id(), err))
 	}
 
 	SetTagsOut(ctx, resp.HierarchyGroup.Tags)
@@ -196,17 +211,22 @@ func resourceUserHierarchyGroupUpdate(ctx context.Context, d *schema.ResourceDat
 	instanceID, userHierarchyGroupID, err := UserHierarchyGroupParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if d.HasChange("name") {
-		_, err = conn.UpdateUserHierarchyGroupNameWithContext(ctx, &connect.UpdateUserHierarchyGroupNameInput{
-			HierarchyGroupId: aws.String(userHierarchyGroupID),
-			InstanceId:       aws.String(instanceID),
-			Name:             aws.String(d.Get("name").(string)),
+		_, err = withInstanceLock(instanceID, func() (*connect.UpdateUserHierarchyGroupNameOutput, error) {
+			return conn.UpdateUserHierarchyGroupNameWithContext(ctx, &connect.UpdateUserHierarchyGroupNameInput{
+				HierarchyGroupId: aws.String(userHierarchyGroupID),
+				InstanceId:       aws.String(instanceID),
+				Name:             aws.String(d.Get("name").(string)),
+			})
 		})
+
+		conns.Forget(meta.(*conns.AWSClient), userHierarchyGroupsMemoKey(instanceID))
+
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("updating User Hierarchy Group (%s): %w", d.Id(), err))
+			return diagFromErr(fmt.Errorf("updating User Hierarchy Group (%s): %w", d.Id(), err))
 		}
 	}
 
@@ -219,21 +239,52 @@ func resourceUserHierarchyGroupDelete(ctx context.Context, d *schema.ResourceDat
 	instanceID, userHierarchyGroupID, err := UserHierarchyGroupParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
-	_, err = conn.DeleteUserHierarchyGroupWithContext(ctx, &connect.DeleteUserHierarchyGroupInput{
-		HierarchyGroupId: aws.String(userHierarchyGroupID),
-		InstanceId:       aws.String(instanceID),
+	_, err = tfresource.RetryWhenConflict(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return withInstanceLock(instanceID, func() (*connect.DeleteUserHierarchyGroupOutput, error) {
+			return conn.DeleteUserHierarchyGroupWithContext(ctx, &connect.DeleteUserHierarchyGroupInput{
+				HierarchyGroupId: aws.String(userHierarchyGroupID),
+				InstanceId:       aws.String(instanceID),
+			})
+		})
 	})
 
+	conns.Forget(meta.(*conns.AWSClient), userHierarchyGroupsMemoKey(instanceID))
+
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting User Hierarchy Group (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error deleting User Hierarchy Group (%s): %w", d.Id(), err))
 	}
 
 	return nil
 }
 
+// resourceUserHierarchyGroupImport accepts either instanceID:userHierarchyGroupID
+// or instanceID/path, where path is the names of the group and its ancestors from level one down
+// separated by "/", e.g. "Europe/Sales/Team 1".
+func resourceUserHierarchyGroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := UserHierarchyGroupParseID(d.Id()); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	instanceID, path, ok := strings.Cut(d.Id(), userHierarchyGroupPathSeparator)
+
+	if !ok || instanceID == "" || path == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected instanceID:userHierarchyGroupID or instanceID/path", d.Id())
+	}
+
+	userHierarchyGroupID, err := findUserHierarchyGroupIDByPath(ctx, meta.(*conns.AWSClient), instanceID, path)
+
+	if err != nil {
+		return nil, fmt.Errorf("resolving Connect User Hierarchy Group path (%s): %w", path, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, userHierarchyGroupID))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func UserHierarchyGroupParseID(id string) (string, string, error) {
 	parts := strings.SplitN(id, ":", 2)
 
@@ -287,3 +338,22 @@ func flattenUserHierarchyPathLevel(userHierarchyPathLevel *connect.HierarchyGrou
 
 	return []interface{}{level}
 }
+
+const userHierarchyGroupPathSeparator = "/"
+
+// userHierarchyGroupPath returns the names of the levels in a hierarchy path separated by "/".
+func userHierarchyGroupPath(apiObject *connect.HierarchyPath) string {
+	if apiObject == nil {
+		return ""
+	}
+
+	var names []string
+
+	for _, v := range []*connect.HierarchyGroupSummary{apiObject.LevelOne, apiObject.LevelTwo, apiObject.LevelThree, apiObject.LevelFour, apiObject.LevelFive} {
+		if v != nil {
+			names = append(names, aws.StringValue(v.Name))
+		}
+	}
+
+	return strings.Join(names, userHierarchyGroupPathSeparator)
+}
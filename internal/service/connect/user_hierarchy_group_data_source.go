@@ -58,7 +58,8 @@ func DataSourceUserHierarchyGroup() *schema.Resource {
 			},
 			"instance_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(1, 100),
 			},
 			"level_id": {
@@ -82,6 +83,10 @@ func DataSourceUserHierarchyGroup() *schema.Resource {
 }
 
 func dataSourceUserHierarchyGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setDefaultInstanceID(d, meta); err != nil {
+		return diagFromErr(err)
+	}
+
 	conn := meta.(*conns.AWSClient).ConnectConn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -98,11 +103,11 @@ func dataSourceUserHierarchyGroupRead(ctx context.Context, d *schema.ResourceDat
 		hierarchyGroupSummary, err := userHierarchyGroupSummaryByName(ctx, conn, instanceID, name)
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error finding Connect Hierarchy Group Summary by name (%s): %w", name, err))
+			return diagFromErr(fmt.Errorf("error finding Connect Hierarchy Group Summary by name (%s): %w", name, err))
 		}
 
 		if hierarchyGroupSummary == nil {
-			return diag.FromErr(fmt.Errorf("error finding Connect Hierarchy Group Summary by name (%s): not found", name))
+			return diagFromErr(fmt.Errorf("error finding Connect Hierarchy Group Summary by name (%s): not found", name))
 		}
 
 		input.HierarchyGroupId = hierarchyGroupSummary.Id
@@ -111,11 +116,11 @@ func dataSourceUserHierarchyGroupRead(ctx context.Context, d *schema.ResourceDat
 	resp, err := conn.DescribeUserHierarchyGroupWithContext(ctx, input)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Hierarchy Group: %w", err))
+		return diagFromErr(fmt.Errorf("error getting Connect Hierarchy Group: %w", err))
 	}
 
 	if resp == nil || resp.HierarchyGroup == nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Hierarchy Group: empty response"))
+		return diagFromErr(fmt.Errorf("error getting Connect Hierarchy Group: empty response"))
 	}
 
 	hierarchyGroup := resp.HierarchyGroup
@@ -127,11 +132,11 @@ func dataSourceUserHierarchyGroupRead(ctx context.Context, d *schema.ResourceDat
 	d.Set("name", hierarchyGroup.Name)
 
 	if err := d.Set("hierarchy_path", flattenUserHierarchyPath(hierarchyGroup.HierarchyPath)); err != nil {
-		return diag.FromErr(fmt.Errorf("error setting Connect User Hierarchy Group hierarchy_path (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error setting Connect User Hierarchy Group hierarchy_path (%s): %w", d.Id(), err))
 	}
 
 	if err := d.Set("tags", KeyValueTags(ctx, hierarchyGroup.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
-		return diag.FromErr(fmt.Errorf("error setting tags: %s", err))
+		return diagFromErr(fmt.Errorf("error setting tags: %s", err))
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(hierarchyGroup.Id)))
@@ -7,13 +7,13 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func testAccUserHierarchyGroup_basic(t *testing.T) {
@@ -109,6 +109,13 @@ func testAccUserHierarchyGroup_parentGroupId(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "tags.Name", "Test User Hierarchy Group Child"),
 				),
 			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccUserHierarchyGroupImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parent_group_id"},
+			},
 		},
 	})
 }
@@ -221,6 +228,17 @@ func testAccCheckUserHierarchyGroupExists(ctx context.Context, resourceName stri
 	}
 }
 
+func testAccUserHierarchyGroupImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["instance_id"], rs.Primary.Attributes["hierarchy_path.0.level_one.0.name"], rs.Primary.Attributes["name"]), nil
+	}
+}
+
 func testAccCheckUserHierarchyGroupDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -236,14 +254,9 @@ func testAccCheckUserHierarchyGroupDestroy(ctx context.Context) resource.TestChe
 				return err
 			}
 
-			params := &connect.DescribeUserHierarchyGroupInput{
-				HierarchyGroupId: aws.String(userHierarchyGroupID),
-				InstanceId:       aws.String(instanceID),
-			}
-
-			_, err = conn.DescribeUserHierarchyGroupWithContext(ctx, params)
+			_, err = tfconnect.FindUserHierarchyGroupByID(ctx, conn, instanceID, userHierarchyGroupID)
 
-			if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+			if tfresource.NotFound(err) {
 				continue
 			}
 
@@ -0,0 +1,133 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// @SDKDataSource("aws_connect_user_hierarchy_groups")
+func DataSourceUserHierarchyGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceUserHierarchyGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"hierarchy_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"level_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+		},
+	}
+}
+
+func dataSourceUserHierarchyGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setDefaultInstanceID(d, meta); err != nil {
+		return diagFromErr(err)
+	}
+
+	conn := meta.(*conns.AWSClient).ConnectConn()
+
+	instanceID := d.Get("instance_id").(string)
+
+	groups, err := findUserHierarchyGroupsByInstanceID(ctx, conn, instanceID)
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error listing Connect User Hierarchy Groups for Connect Instance (%s): %w", instanceID, err))
+	}
+
+	// Parents are listed before their children.
+	sort.SliceStable(groups, func(i, j int) bool {
+		return userHierarchyGroupPath(groups[i].HierarchyPath) < userHierarchyGroupPath(groups[j].HierarchyPath)
+	})
+
+	if err := d.Set("hierarchy_groups", flattenUserHierarchyGroups(groups)); err != nil {
+		return diagFromErr(fmt.Errorf("error setting hierarchy_groups: %w", err))
+	}
+
+	d.SetId(instanceID)
+
+	return nil
+}
+
+func flattenUserHierarchyGroups(apiObjects []*connect.HierarchyGroup) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"arn":             aws.StringValue(apiObject.Arn),
+			"id":              aws.StringValue(apiObject.Id),
+			"level_id":        aws.StringValue(apiObject.LevelId),
+			"name":            aws.StringValue(apiObject.Name),
+			"parent_group_id": userHierarchyGroupParentID(apiObject),
+			"path":            userHierarchyGroupPath(apiObject.HierarchyPath),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+// userHierarchyGroupParentID returns the ID of the group one level above the group in its hierarchy path,
+// or an empty string for groups at level one.
+func userHierarchyGroupParentID(apiObject *connect.HierarchyGroup) string {
+	if apiObject.HierarchyPath == nil {
+		return ""
+	}
+
+	var parentID string
+
+	for _, v := range []*connect.HierarchyGroupSummary{apiObject.HierarchyPath.LevelOne, apiObject.HierarchyPath.LevelTwo, apiObject.HierarchyPath.LevelThree, apiObject.HierarchyPath.LevelFour, apiObject.HierarchyPath.LevelFive} {
+		if v == nil || aws.StringValue(v.Id) == aws.StringValue(apiObject.Id) {
+			break
+		}
+
+		parentID = aws.StringValue(v.Id)
+	}
+
+	return parentID
+}
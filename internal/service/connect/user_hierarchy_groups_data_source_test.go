@@ -0,0 +1,60 @@
+package connect_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccUserHierarchyGroupsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName3 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	parentResourceName := "aws_connect_user_hierarchy_group.parent"
+	resourceName := "aws_connect_user_hierarchy_group.test"
+	datasourceName := "data.aws_connect_user_hierarchy_groups.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserHierarchyGroupsDataSourceConfig_basic(rName, rName2, rName3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttr(datasourceName, "hierarchy_groups.#", "2"),
+					resource.TestCheckResourceAttrPair(datasourceName, "hierarchy_groups.0.arn", parentResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "hierarchy_groups.0.id", parentResourceName, "hierarchy_group_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "hierarchy_groups.0.level_id", parentResourceName, "level_id"),
+					resource.TestCheckResourceAttr(datasourceName, "hierarchy_groups.0.name", rName2),
+					resource.TestCheckResourceAttr(datasourceName, "hierarchy_groups.0.parent_group_id", ""),
+					resource.TestCheckResourceAttr(datasourceName, "hierarchy_groups.0.path", rName2),
+					resource.TestCheckResourceAttrPair(datasourceName, "hierarchy_groups.1.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "hierarchy_groups.1.id", resourceName, "hierarchy_group_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "hierarchy_groups.1.level_id", resourceName, "level_id"),
+					resource.TestCheckResourceAttr(datasourceName, "hierarchy_groups.1.name", rName3),
+					resource.TestCheckResourceAttrPair(datasourceName, "hierarchy_groups.1.parent_group_id", parentResourceName, "hierarchy_group_id"),
+					resource.TestCheckResourceAttr(datasourceName, "hierarchy_groups.1.path", fmt.Sprintf("%s/%s", rName2, rName3)),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserHierarchyGroupsDataSourceConfig_basic(rName, rName2, rName3 string) string {
+	return acctest.ConfigCompose(
+		testAccUserHierarchyGroupConfig_parentID(rName, rName2, rName3),
+		`
+data "aws_connect_user_hierarchy_groups" "test" {
+  instance_id = aws_connect_instance.test.id
+
+  depends_on = [aws_connect_user_hierarchy_group.test]
+}
+`)
+}
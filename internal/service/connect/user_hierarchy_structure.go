@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
@@ -22,9 +23,20 @@ func ResourceUserHierarchyStructure() *schema.Resource {
 		UpdateWithoutTimeout: resourceUserHierarchyStructureUpdate,
 		DeleteWithoutTimeout: resourceUserHierarchyStructureDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// force is not returned by the API.
+				d.Set("force", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
+		CustomizeDiff: defaultInstanceIDCustomizeDiff,
 		Schema: map[string]*schema.Schema{
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"hierarchy_structure": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -56,7 +68,8 @@ func ResourceUserHierarchyStructure() *schema.Resource {
 			},
 			"instance_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(1, 100),
 			},
 		},
@@ -101,10 +114,14 @@ func resourceUserHierarchyStructureCreate(ctx context.Context, d *schema.Resourc
 	}
 
 	log.Printf("[DEBUG] Creating Connect User Hierarchy Structure %s", input)
-	_, err := conn.UpdateUserHierarchyStructureWithContext(ctx, input)
+	_, err := withInstanceLock(instanceID, func() (*connect.UpdateUserHierarchyStructureOutput, error) {
+		return conn.UpdateUserHierarchyStructureWithContext(ctx, input)
+	})
+
+	conns.Forget(meta.(*conns.AWSClient), userHierarchyStructureMemoKey(instanceID))
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating Connect User Hierarchy Structure for Connect Instance (%s): %w", instanceID, err))
+		return diagFromErr(fmt.Errorf("error creating Connect User Hierarchy Structure for Connect Instance (%s): %w", instanceID, err))
 	}
 
 	d.SetId(instanceID)
@@ -128,15 +145,15 @@ func resourceUserHierarchyStructureRead(ctx context.Context, d *schema.ResourceD
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect User Hierarchy Structure (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error getting Connect User Hierarchy Structure (%s): %w", d.Id(), err))
 	}
 
 	if resp == nil || resp.HierarchyStructure == nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect User Hierarchy Structure (%s): empty response", d.Id()))
+		return diagFromErr(fmt.Errorf("error getting Connect User Hierarchy Structure (%s): empty response", d.Id()))
 	}
 
 	if err := d.Set("hierarchy_structure", flattenUserHierarchyStructure(resp.HierarchyStructure)); err != nil {
-		return diag.FromErr(fmt.Errorf("error setting Connect User Hierarchy Structure hierarchy_structure for Connect instance: (%s)", d.Id()))
+		return diagFromErr(fmt.Errorf("error setting Connect User Hierarchy Structure hierarchy_structure for Connect instance: (%s)", d.Id()))
 	}
 
 	d.Set("instance_id", instanceID)
@@ -150,13 +167,49 @@ func resourceUserHierarchyStructureUpdate(ctx context.Context, d *schema.Resourc
 	instanceID := d.Id()
 
 	if d.HasChange("hierarchy_structure") {
-		_, err := conn.UpdateUserHierarchyStructureWithContext(ctx, &connect.UpdateUserHierarchyStructureInput{
-			HierarchyStructure: expandUserHierarchyStructure(d.Get("hierarchy_structure").([]interface{})),
-			InstanceId:         aws.String(instanceID),
-		})
+		o, n := d.GetChange("hierarchy_structure")
+		oldLevels, newLevels := userHierarchyStructureLevels(o.([]interface{})), userHierarchyStructureLevels(n.([]interface{}))
+
+		if !d.Get("force").(bool) {
+			removed := map[string]string{}
 
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("error updating UserHierarchyStructure Name (%s): %w", d.Id(), err))
+			for key, level := range oldLevels {
+				if _, ok := newLevels[key]; !ok {
+					removed[key] = level["id"].(string)
+				}
+			}
+
+			if err := checkUserHierarchyStructureLevelsRemovable(ctx, conn, instanceID, removed); err != nil {
+				return diagErrorf("updating Connect User Hierarchy Structure (%s): %s", d.Id(), err)
+			}
+		}
+
+		var changed []string
+
+		for _, key := range userHierarchyStructureLevelKeys {
+			oldLevel, newLevel := oldLevels[key], newLevels[key]
+
+			if (oldLevel == nil) != (newLevel == nil) || (oldLevel != nil && oldLevel["name"] != newLevel["name"]) {
+				changed = append(changed, key)
+			}
+		}
+
+		// UpdateUserHierarchyStructure replaces the whole structure, so unchanged levels are sent as they are.
+		// Only call it when a level has been added, renamed or removed.
+		if len(changed) > 0 {
+			log.Printf("[DEBUG] Updating Connect User Hierarchy Structure (%s) levels: %s", d.Id(), strings.Join(changed, ", "))
+			_, err := withInstanceLock(instanceID, func() (*connect.UpdateUserHierarchyStructureOutput, error) {
+				return conn.UpdateUserHierarchyStructureWithContext(ctx, &connect.UpdateUserHierarchyStructureInput{
+					HierarchyStructure: expandUserHierarchyStructure(n.([]interface{})),
+					InstanceId:         aws.String(instanceID),
+				})
+			})
+
+			conns.Forget(meta.(*conns.AWSClient), userHierarchyStructureMemoKey(instanceID))
+
+			if err != nil {
+				return diagFromErr(fmt.Errorf("error updating UserHierarchyStructure Name (%s): %w", d.Id(), err))
+			}
 		}
 	}
 
@@ -168,13 +221,85 @@ func resourceUserHierarchyStructureDelete(ctx context.Context, d *schema.Resourc
 
 	instanceID := d.Id()
 
-	_, err := conn.UpdateUserHierarchyStructureWithContext(ctx, &connect.UpdateUserHierarchyStructureInput{
-		HierarchyStructure: &connect.HierarchyStructureUpdate{},
-		InstanceId:         aws.String(instanceID),
+	if !d.Get("force").(bool) {
+		removed := map[string]string{}
+
+		for key, level := range userHierarchyStructureLevels(d.Get("hierarchy_structure").([]interface{})) {
+			removed[key] = level["id"].(string)
+		}
+
+		if err := checkUserHierarchyStructureLevelsRemovable(ctx, conn, instanceID, removed); err != nil {
+			return diagErrorf("deleting Connect User Hierarchy Structure (%s): %s", d.Id(), err)
+		}
+	}
+
+	_, err := withInstanceLock(instanceID, func() (*connect.UpdateUserHierarchyStructureOutput, error) {
+		return conn.UpdateUserHierarchyStructureWithContext(ctx, &connect.UpdateUserHierarchyStructureInput{
+			HierarchyStructure: &connect.HierarchyStructureUpdate{},
+			InstanceId:         aws.String(instanceID),
+		})
 	})
 
+	conns.Forget(meta.(*conns.AWSClient), userHierarchyStructureMemoKey(instanceID))
+
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error deleting UserHierarchyStructure (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+// userHierarchyStructureLevelKeys lists the hierarchy levels from the top down.
+var userHierarchyStructureLevelKeys = []string{"level_one", "level_two", "level_three", "level_four", "level_five"}
+
+// userHierarchyStructureLevels returns the configured levels of a hierarchy_structure block keyed by level.
+func userHierarchyStructureLevels(userHierarchyStructure []interface{}) map[string]map[string]interface{} {
+	levels := map[string]map[string]interface{}{}
+
+	if len(userHierarchyStructure) == 0 || userHierarchyStructure[0] == nil {
+		return levels
+	}
+
+	tfMap := userHierarchyStructure[0].(map[string]interface{})
+
+	for _, key := range userHierarchyStructureLevelKeys {
+		if v, ok := tfMap[key].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			levels[key] = v[0].(map[string]interface{})
+		}
+	}
+
+	return levels
+}
+
+// checkUserHierarchyStructureLevelsRemovable returns an error if any of the levels to be removed,
+// a map of level key to level ID, still has user hierarchy groups.
+func checkUserHierarchyStructureLevelsRemovable(ctx context.Context, conn *connect.Connect, instanceID string, removed map[string]string) error {
+	if len(removed) == 0 {
+		return nil
+	}
+
+	groups, err := findUserHierarchyGroupsByInstanceID(ctx, conn, instanceID)
+
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting UserHierarchyStructure (%s): %w", d.Id(), err))
+		return fmt.Errorf("listing user hierarchy groups: %w", err)
+	}
+
+	counts := map[string]int{}
+
+	for _, group := range groups {
+		counts[aws.StringValue(group.LevelId)]++
+	}
+
+	var inUse []string
+
+	for _, key := range userHierarchyStructureLevelKeys {
+		if id, ok := removed[key]; ok && counts[id] > 0 {
+			inUse = append(inUse, fmt.Sprintf("%s (%d groups)", key, counts[id]))
+		}
+	}
+
+	if len(inUse) > 0 {
+		return fmt.Errorf("user hierarchy groups exist at levels being removed: %s; delete the groups first or set force to true", strings.Join(inUse, ", "))
 	}
 
 	return nil
@@ -250,6 +375,23 @@ func flattenUserHierarchyStructure(userHierarchyStructure *connect.HierarchyStru
 	return []interface{}{values}
 }
 
+// userHierarchyStructureLevelCount returns the number of levels defined in a hierarchy structure.
+func userHierarchyStructureLevelCount(apiObject *connect.HierarchyStructure) int {
+	if apiObject == nil {
+		return 0
+	}
+
+	count := 0
+
+	for _, v := range []*connect.HierarchyLevel{apiObject.LevelOne, apiObject.LevelTwo, apiObject.LevelThree, apiObject.LevelFour, apiObject.LevelFive} {
+		if v != nil {
+			count++
+		}
+	}
+
+	return count
+}
+
 func flattenUserHierarchyStructureLevel(userHierarchyStructureLevel *connect.HierarchyLevel) []interface{} {
 	if userHierarchyStructureLevel == nil {
 		return []interface{}{}
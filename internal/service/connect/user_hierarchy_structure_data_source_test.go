@@ -36,6 +36,7 @@ func testAccUserHierarchyStructureDataSource_instanceID(t *testing.T) {
 					resource.TestCheckResourceAttrPair(datasourceName, "hierarchy_structure.0.level_four.0.name", resourceName, "hierarchy_structure.0.level_four.0.name"),
 					resource.TestCheckResourceAttrPair(datasourceName, "hierarchy_structure.0.level_five.#", resourceName, "hierarchy_structure.0.level_five.#"),
 					resource.TestCheckResourceAttrPair(datasourceName, "hierarchy_structure.0.level_five.0.name", resourceName, "hierarchy_structure.0.level_five.0.name"),
+					resource.TestCheckResourceAttr(datasourceName, "level_count", "5"),
 				),
 			},
 		},
@@ -3,6 +3,8 @@ package connect_test
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -206,6 +208,45 @@ func testAccUserHierarchyStructure_disappears(t *testing.T) {
 	})
 }
 
+func testAccUserHierarchyStructure_removeLevelWithGroups(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.DescribeUserHierarchyStructureOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	levelOneName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	levelTwoName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	groupName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_user_hierarchy_structure.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserHierarchyStructureDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserHierarchyStructureConfig_groups(rName, groupName, levelOneName, levelTwoName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserHierarchyStructureExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "force", "false"),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy_structure.0.level_two.#", "1"),
+				),
+			},
+			{
+				Config:      testAccUserHierarchyStructureConfig_groups(rName, groupName, levelOneName),
+				ExpectError: regexp.MustCompile(`user hierarchy groups exist at levels being removed: level_two \(1 groups\)`),
+			},
+			{
+				// Renaming a level is not destructive.
+				Config: testAccUserHierarchyStructureConfig_groups(rName, groupName, levelOneName, levelTwoName+"-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserHierarchyStructureExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy_structure.0.level_two.0.name", levelTwoName+"-renamed"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckUserHierarchyStructureExists(ctx context.Context, resourceName string, function *connect.DescribeUserHierarchyStructureOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -400,3 +441,45 @@ resource "aws_connect_user_hierarchy_structure" "test" {
 }
 `, levelOneName, levelTwoName, levelThreeName, levelFourName, levelFiveName))
 }
+
+// testAccUserHierarchyStructureConfig_groups creates a hierarchy group at every level of the hierarchy structure.
+// The groups are chained so that each one is the parent of the group at the next level.
+func testAccUserHierarchyStructureConfig_groups(rName, groupName string, levelNames ...string) string {
+	var levels, groups strings.Builder
+
+	for i, name := range levelNames {
+		fmt.Fprintf(&levels, `
+    %[1]s {
+      name = %[2]q
+    }
+`, []string{"level_one", "level_two", "level_three", "level_four", "level_five"}[i], name)
+
+		parent := ""
+		if i > 0 {
+			parent = fmt.Sprintf("parent_group_id = aws_connect_user_hierarchy_group.test%[1]d.hierarchy_group_id", i-1)
+		}
+
+		fmt.Fprintf(&groups, `
+resource "aws_connect_user_hierarchy_group" "test%[1]d" {
+  instance_id = aws_connect_instance.test.id
+  name        = "%[2]s-%[1]d"
+  %[3]s
+
+  depends_on = [
+    aws_connect_user_hierarchy_structure.test,
+  ]
+}
+`, i, groupName, parent)
+	}
+
+	return acctest.ConfigCompose(
+		testAccUserHierarchyStructureConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_user_hierarchy_structure" "test" {
+  instance_id = aws_connect_instance.test.id
+
+  hierarchy_structure {
+%[1]s  }
+}
+%[2]s`, levels.String(), groups.String()))
+}
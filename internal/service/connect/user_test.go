@@ -3,18 +3,19 @@ package connect_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func testAccUser_basic(t *testing.T) {
@@ -327,6 +328,55 @@ func testAccUser_updateRoutingProfileId(t *testing.T) {
 	})
 }
 
+func testAccUser_names(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.DescribeUserOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName3 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName4 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName5 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+
+	resourceName := "aws_connect_user.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_names(rName, rName2, rName3, rName4, rName5, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "hierarchy_group_id", "aws_connect_user_hierarchy_group.child", "hierarchy_group_id"),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy_group_path", fmt.Sprintf("%s/%s", rName3, rName4)),
+					resource.TestCheckResourceAttrPair(resourceName, "routing_profile_id", "data.aws_connect_routing_profile.test", "routing_profile_id"),
+					resource.TestCheckResourceAttr(resourceName, "security_profile_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "security_profile_ids.*", "data.aws_connect_security_profile.agent", "security_profile_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"hierarchy_group_path", "password", "routing_profile_name", "security_profile_names"},
+			},
+			{
+				Config: testAccUserConfig_names(rName, rName2, rName3, rName4, rName5, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy_group_id", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "routing_profile_id", "aws_connect_routing_profile.test", "routing_profile_id"),
+					resource.TestCheckResourceAttr(resourceName, "security_profile_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "security_profile_ids.*", "data.aws_connect_security_profile.agent", "security_profile_id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "security_profile_ids.*", "data.aws_connect_security_profile.call_center_manager", "security_profile_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccUser_updateTags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v connect.DescribeUserOutput
@@ -381,6 +431,42 @@ func testAccUser_updateTags(t *testing.T) {
 	})
 }
 
+func testAccUser_replace(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v, v2 connect.DescribeUserOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName3 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName4 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName5 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_user.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_basic(rName, rName2, rName3, rName4, rName5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &v),
+				),
+			},
+			{
+				// recreate the user with the same username straight after deleting it
+				Config: testAccUserConfig_basic(rName, rName2, rName3, rName4, rName5),
+				Taint:  []string{resourceName},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, resourceName, &v2),
+					testAccCheckUserRecreated(&v, &v2),
+					resource.TestCheckResourceAttr(resourceName, "name", rName5),
+				),
+			},
+		},
+	})
+}
+
 func testAccUser_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v connect.DescribeUserOutput
@@ -409,6 +495,32 @@ func testAccUser_disappears(t *testing.T) {
 	})
 }
 
+func testAccUser_passwordRequired(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName3 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName4 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName5 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// the identity management type is only checked once the instance exists
+				Config: testAccUserConfig_base(rName, rName2, rName3, rName4),
+			},
+			{
+				Config:      testAccUserConfig_noPassword(rName, rName2, rName3, rName4, rName5),
+				ExpectError: regexp.MustCompile("`password` or `directory_user_id` is required"),
+			},
+		},
+	})
+}
+
 func testAccCheckUserExists(ctx context.Context, resourceName string, function *connect.DescribeUserOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -443,6 +555,16 @@ func testAccCheckUserExists(ctx context.Context, resourceName string, function *
 	}
 }
 
+func testAccCheckUserRecreated(i, j *connect.DescribeUserOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(i.User.Id), aws.StringValue(j.User.Id); before == after {
+			return fmt.Errorf("Connect User not recreated: %s", before)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckUserDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -458,14 +580,9 @@ func testAccCheckUserDestroy(ctx context.Context) resource.TestCheckFunc {
 				return err
 			}
 
-			params := &connect.DescribeUserInput{
-				UserId:     aws.String(userID),
-				InstanceId: aws.String(instanceID),
-			}
+			_, err = tfconnect.FindUserByID(ctx, conn, instanceID, userID)
 
-			_, err = conn.DescribeUserWithContext(ctx, params)
-
-			if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+			if tfresource.NotFound(err) {
 				continue
 			}
 
@@ -594,6 +711,27 @@ resource "aws_connect_user" "test" {
 `, rName5))
 }
 
+func testAccUserConfig_noPassword(rName, rName2, rName3, rName4, rName5 string) string {
+	return acctest.ConfigCompose(
+		testAccUserConfig_base(rName, rName2, rName3, rName4),
+		fmt.Sprintf(`
+resource "aws_connect_user" "test" {
+  instance_id        = aws_connect_instance.test.id
+  name               = %[1]q
+  routing_profile_id = data.aws_connect_routing_profile.test.routing_profile_id
+
+  security_profile_ids = [
+    data.aws_connect_security_profile.agent.security_profile_id
+  ]
+
+  phone_config {
+    after_contact_work_time_limit = 0
+    phone_type                    = "SOFT_PHONE"
+  }
+}
+`, rName5))
+}
+
 func testAccUserConfig_hierarchyGroupID(rName, rName2, rName3, rName4, rName5, selectHierarchyGroupId string) string {
 	return acctest.ConfigCompose(
 		testAccUserConfig_base(rName, rName2, rName3, rName4),
@@ -718,6 +856,36 @@ resource "aws_connect_user" "test" {
 `, rName5, selectRoutingProfileId))
 }
 
+func testAccUserConfig_names(rName, rName2, rName3, rName4, rName5, selectNames string) string {
+	return acctest.ConfigCompose(
+		testAccUserConfig_base(rName, rName2, rName3, rName4),
+		fmt.Sprintf(`
+locals {
+  select_names = %[2]q
+}
+
+resource "aws_connect_user" "test" {
+  instance_id          = aws_connect_instance.test.id
+  name                 = %[1]q
+  password             = "Password123"
+  routing_profile_name = local.select_names == "first" ? "Basic Routing Profile" : aws_connect_routing_profile.test.name
+  hierarchy_group_path = local.select_names == "first" ? "${aws_connect_user_hierarchy_group.parent.name}/${aws_connect_user_hierarchy_group.child.name}" : null
+
+  security_profile_names = local.select_names == "first" ? ["Agent"] : ["Agent", "CallCenterManager"]
+
+  identity_info {
+    first_name = "example"
+    last_name  = "example2"
+  }
+
+  phone_config {
+    after_contact_work_time_limit = 0
+    phone_type                    = "SOFT_PHONE"
+  }
+}
+`, rName5, selectNames))
+}
+
 func testAccUserConfig_securityProfileIDs(rName, rName2, rName3, rName4, rName5, selectSecurityProfileIds string) string {
 	return acctest.ConfigCompose(
 		testAccUserConfig_base(rName, rName2, rName3, rName4),
@@ -0,0 +1,127 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	connect_sdkv2 "github.com/aws/aws-sdk-go-v2/service/connect"
+	"github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+)
+
+// @SDKDataSource("aws_connect_views")
+func DataSourceViews() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceViewsRead,
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ViewType](), // Valid values: AWS_MANAGED | CUSTOMER_MANAGED
+			},
+			"views": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceViewsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setDefaultInstanceID(d, meta); err != nil {
+		return diagFromErr(err)
+	}
+
+	client := meta.(*conns.AWSClient).ConnectClient()
+
+	instanceID := d.Get("instance_id").(string)
+
+	input := &connect_sdkv2.ListViewsInput{
+		InstanceId: aws_sdkv2.String(instanceID),
+		MaxResults: aws_sdkv2.Int32(ListViewsMaxResults),
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		input.Type = types.ViewType(v.(string))
+	}
+
+	var views []types.ViewSummary
+
+	pages := connect_sdkv2.NewListViewsPaginator(client, input)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return diagFromErr(fmt.Errorf("error listing Connect Views for Connect Instance (%s): %w", instanceID, err))
+		}
+
+		views = append(views, page.ViewsSummaryList...)
+	}
+
+	if err := d.Set("views", flattenViewSummaries(views)); err != nil {
+		return diagFromErr(fmt.Errorf("error setting views: %w", err))
+	}
+
+	d.SetId(instanceID)
+
+	return nil
+}
+
+func flattenViewSummaries(apiObjects []types.ViewSummary) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"arn":         aws_sdkv2.ToString(apiObject.Arn),
+			"description": aws_sdkv2.ToString(apiObject.Description),
+			"id":          aws_sdkv2.ToString(apiObject.Id),
+			"name":        aws_sdkv2.ToString(apiObject.Name),
+			"status":      string(apiObject.Status),
+			"type":        string(apiObject.Type),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
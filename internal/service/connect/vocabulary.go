@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -39,7 +40,10 @@ func ResourceVocabulary() *schema.Resource {
 			Delete: schema.DefaultTimeout(vocabularyDeletedTimeout),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			defaultInstanceIDCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -58,7 +62,8 @@ func ResourceVocabulary() *schema.Resource {
 			},
 			"instance_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 100),
 			},
@@ -113,20 +118,21 @@ func resourceVocabularyCreate(ctx context.Context, d *schema.ResourceData, meta
 	output, err := conn.CreateVocabularyWithContext(ctx, input)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating Connect Vocabulary (%s): %w", vocabularyName, err))
+		return diagFromErr(fmt.Errorf("error creating Connect Vocabulary (%s): %w", vocabularyName, err))
 	}
 
 	if output == nil {
-		return diag.FromErr(fmt.Errorf("error creating Connect Vocabulary (%s): empty output", vocabularyName))
+		return diagFromErr(fmt.Errorf("error creating Connect Vocabulary (%s): empty output", vocabularyName))
 	}
 
 	vocabularyID := aws.StringValue(output.VocabularyId)
 
 	d.SetId(fmt.Sprintf("%s:%s", instanceID, vocabularyID))
 
-	// waiter since the status changes from CREATION_IN_PROGRESS to either ACTIVE or CREATION_FAILED
+	// waiter since the status changes from CREATION_IN_PROGRESS to either ACTIVE or CREATION_FAILED.
+	// A vocabulary that fails to build is left in state (and so tainted) with the failure reason surfaced.
 	if _, err := waitVocabularyCreated(ctx, conn, d.Timeout(schema.TimeoutCreate), instanceID, vocabularyID); err != nil {
-		return diag.FromErr(fmt.Errorf("error waiting for Vocabulary (%s) creation: %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error waiting for Vocabulary (%s) creation: %w", d.Id(), err))
 	}
 
 	return resourceVocabularyRead(ctx, d, meta)
@@ -138,7 +144,7 @@ func resourceVocabularyRead(ctx context.Context, d *schema.ResourceData, meta in
 	instanceID, vocabularyID, err := VocabularyParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	resp, err := conn.DescribeVocabularyWithContext(ctx, &connect.DescribeVocabularyInput{
@@ -153,11 +159,11 @@ func resourceVocabularyRead(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Vocabulary (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error getting Connect Vocabulary (%s): %w", d.Id(), err))
 	}
 
 	if resp == nil || resp.Vocabulary == nil {
-		return diag.FromErr(fmt.Errorf("error getting Connect Vocabulary (%s): empty response", d.Id()))
+		return diagFromErr(fmt.Errorf("error getting Connect Vocabulary (%s): empty response", d.Id()))
 	}
 
 	vocabulary := resp.Vocabulary
@@ -188,7 +194,7 @@ func resourceVocabularyDelete(ctx context.Context, d *schema.ResourceData, meta
 	instanceID, vocabularyID, err := VocabularyParseID(d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = conn.DeleteVocabularyWithContext(ctx, &connect.DeleteVocabularyInput{
@@ -196,12 +202,16 @@ func resourceVocabularyDelete(ctx context.Context, d *schema.ResourceData, meta
 		VocabularyId: aws.String(vocabularyID),
 	})
 
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting Vocabulary (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error deleting Vocabulary (%s): %w", d.Id(), err))
 	}
 
 	if _, err := waitVocabularyDeleted(ctx, conn, d.Timeout(schema.TimeoutDelete), instanceID, vocabularyID); err != nil {
-		return diag.FromErr(fmt.Errorf("error waiting for Vocabulary (%s) deletion: %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error waiting for Vocabulary (%s) deletion: %w", d.Id(), err))
 	}
 
 	return nil
@@ -34,7 +34,8 @@ func DataSourceVocabulary() *schema.Resource {
 			},
 			"instance_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(1, 100),
 			},
 			"language_code": {
@@ -67,6 +68,10 @@ func DataSourceVocabulary() *schema.Resource {
 }
 
 func dataSourceVocabularyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setDefaultInstanceID(d, meta); err != nil {
+		return diagFromErr(err)
+	}
+
 	conn := meta.(*conns.AWSClient).ConnectConn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -83,11 +88,11 @@ func dataSourceVocabularyRead(ctx context.Context, d *schema.ResourceData, meta
 		vocabularySummary, err := dataSourceGetVocabularySummaryByName(ctx, conn, instanceID, name)
 
 		if err != nil {
-			return diag.Errorf("finding Connect Vocabulary Summary by name (%s): %s", name, err)
+			return diagErrorf("finding Connect Vocabulary Summary by name (%s): %s", name, err)
 		}
 
 		if vocabularySummary == nil {
-			return diag.Errorf("finding Connect Vocabulary Summary by name (%s): not found", name)
+			return diagErrorf("finding Connect Vocabulary Summary by name (%s): not found", name)
 		}
 
 		input.VocabularyId = vocabularySummary.Id
@@ -96,11 +101,11 @@ func dataSourceVocabularyRead(ctx context.Context, d *schema.ResourceData, meta
 	resp, err := conn.DescribeVocabularyWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("getting Connect Vocabulary: %s", err)
+		return diagErrorf("getting Connect Vocabulary: %s", err)
 	}
 
 	if resp == nil || resp.Vocabulary == nil {
-		return diag.Errorf("getting Connect Vocabulary: empty response")
+		return diagErrorf("getting Connect Vocabulary: empty response")
 	}
 
 	vocabulary := resp.Vocabulary
@@ -116,7 +121,7 @@ func dataSourceVocabularyRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("vocabulary_id", vocabulary.Id)
 
 	if err := d.Set("tags", KeyValueTags(ctx, vocabulary.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
-		return diag.Errorf("setting tags: %s", err)
+		return diagErrorf("setting tags: %s", err)
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(vocabulary.Id)))
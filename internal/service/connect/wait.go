@@ -5,13 +5,18 @@ import (
 	"errors"
 	"time"
 
+	connect_sdkv2 "github.com/aws/aws-sdk-go-v2/service/connect"
+	"github.com/aws/aws-sdk-go-v2/service/connect/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
+	propagationTimeout = 2 * time.Minute
+
 	// ConnectInstanceCreateTimeout Timeout for connect instance creation
 	instanceCreatedTimeout = 5 * time.Minute
 	instanceDeletedTimeout = 5 * time.Minute
@@ -22,13 +27,28 @@ const (
 	phoneNumberUpdatedTimeout = 2 * time.Minute
 	phoneNumberDeletedTimeout = 2 * time.Minute
 
+	// Deletion fails with ResourceInUseException until resources referencing the one
+	// being deleted (e.g. users in a hierarchy group) have been updated or removed.
+	hoursOfOperationDeletedTimeout   = 2 * time.Minute
+	queueDeletedTimeout              = 2 * time.Minute
+	routingProfileDeletedTimeout     = 2 * time.Minute
+	securityProfileDeletedTimeout    = 2 * time.Minute
+	userHierarchyGroupDeletedTimeout = 2 * time.Minute
+
+	// Deleting a user propagates asynchronously, so recreating a user with the same
+	// username, e.g. during a replacement, fails with DuplicateResourceException for a while.
+	// createUser only waits when the existing user is no longer listed, i.e. is being deleted.
+	userCreatedTimeout = 2 * time.Minute
+
+	trafficDistributionUpdatedTimeout = 5 * time.Minute
+
 	vocabularyCreatedTimeout = 5 * time.Minute
 	// It takes about 90 minutes for Amazon Connect to delete a vocabulary.
 	// https://docs.aws.amazon.com/connect/latest/adminguide/add-custom-vocabulary.html
 	vocabularyDeletedTimeout = 100 * time.Minute
 )
 
-func waitInstanceCreated(ctx context.Context, conn *connect.Connect, timeout time.Duration, instanceId string) (*connect.DescribeInstanceOutput, error) {
+func waitInstanceCreated(ctx context.Context, conn *connect.Connect, timeout time.Duration, instanceId string) (*connect.Instance, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{connect.InstanceStatusCreationInProgress},
 		Target:  []string{connect.InstanceStatusActive},
@@ -38,27 +58,31 @@ func waitInstanceCreated(ctx context.Context, conn *connect.Connect, timeout tim
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if v, ok := outputRaw.(*connect.DescribeInstanceOutput); ok {
-		return v, err
+	if output, ok := outputRaw.(*connect.Instance); ok {
+		if aws.StringValue(output.InstanceStatus) == connect.InstanceStatusCreationFailed && output.StatusReason != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason.Message)))
+		}
+		return output, err
 	}
 
 	return nil, err
 }
 
-// We don't have a PENDING_DELETION or DELETED for the Connect instance.
+// We don't have a PENDING_DELETION or DELETED for the Connect instance, so wait until it can no longer be found.
+// Amazon Connect keeps the instance alias reserved until then, so returning early causes re-creates with the same alias to fail.
 // If the Connect Instance has an associated EXISTING DIRECTORY, removing the connect instance
 // will cause an error because it is still has authorized applications.
-func waitInstanceDeleted(ctx context.Context, conn *connect.Connect, timeout time.Duration, instanceId string) (*connect.DescribeInstanceOutput, error) {
+func waitInstanceDeleted(ctx context.Context, conn *connect.Connect, timeout time.Duration, instanceId string) (*connect.Instance, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{connect.InstanceStatusActive},
-		Target:  []string{connect.ErrCodeResourceNotFoundException},
+		Pending: []string{connect.InstanceStatusActive, connect.InstanceStatusCreationFailed, connect.InstanceStatusCreationInProgress},
+		Target:  []string{},
 		Refresh: statusInstance(ctx, conn, instanceId),
 		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if v, ok := outputRaw.(*connect.DescribeInstanceOutput); ok {
+	if v, ok := outputRaw.(*connect.Instance); ok {
 		return v, err
 	}
 
@@ -122,36 +146,59 @@ func waitPhoneNumberDeleted(ctx context.Context, conn *connect.Connect, timeout
 	return nil, err
 }
 
-func waitVocabularyCreated(ctx context.Context, conn *connect.Connect, timeout time.Duration, instanceId, vocabularyId string) (*connect.DescribeVocabularyOutput, error) {
+func waitVocabularyCreated(ctx context.Context, conn *connect.Connect, timeout time.Duration, instanceId, vocabularyId string) (*connect.Vocabulary, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{connect.VocabularyStateCreationInProgress},
-		Target:  []string{connect.VocabularyStateActive, connect.VocabularyStateCreationFailed},
+		Target:  []string{connect.VocabularyStateActive},
 		Refresh: statusVocabulary(ctx, conn, instanceId, vocabularyId),
 		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if v, ok := outputRaw.(*connect.DescribeVocabularyOutput); ok {
-		return v, err
+	if output, ok := outputRaw.(*connect.Vocabulary); ok {
+		if aws.StringValue(output.State) == connect.VocabularyStateCreationFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureReason)))
+		}
+
+		return output, err
 	}
 
 	return nil, err
 }
 
-func waitVocabularyDeleted(ctx context.Context, conn *connect.Connect, timeout time.Duration, instanceId, vocabularyId string) (*connect.DescribeVocabularyOutput, error) {
+// The vocabulary name stays reserved until the vocabulary can no longer be found,
+// so wait for that rather than for the end of DELETE_IN_PROGRESS.
+func waitVocabularyDeleted(ctx context.Context, conn *connect.Connect, timeout time.Duration, instanceId, vocabularyId string) (*connect.Vocabulary, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{connect.VocabularyStateDeleteInProgress},
-		Target:  []string{connect.ErrCodeResourceNotFoundException},
+		Pending: []string{connect.VocabularyStateActive, connect.VocabularyStateCreationFailed, connect.VocabularyStateDeleteInProgress},
+		Target:  []string{},
 		Refresh: statusVocabulary(ctx, conn, instanceId, vocabularyId),
 		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if v, ok := outputRaw.(*connect.DescribeVocabularyOutput); ok {
+	if v, ok := outputRaw.(*connect.Vocabulary); ok {
 		return v, err
 	}
 
 	return nil, err
 }
+
+func waitTrafficDistributionGroupUpdated(ctx context.Context, client *connect_sdkv2.Client, timeout time.Duration, trafficDistributionGroupID string) (*types.TrafficDistributionGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.TrafficDistributionGroupStatusCreationInProgress, types.TrafficDistributionGroupStatusUpdateInProgress),
+		Target:  enum.Slice(types.TrafficDistributionGroupStatusActive),
+		Refresh: statusTrafficDistributionGroup(ctx, client, trafficDistributionGroupID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.TrafficDistributionGroup); ok {
+		return output, err
+	}
+
+	return nil, err
+}
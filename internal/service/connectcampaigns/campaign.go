@@ -0,0 +1,414 @@
+package connectcampaigns
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connectcampaigns"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_connectcampaigns_campaign", name="Campaign")
+// @Tags(identifierAttribute="arn")
+func ResourceCampaign() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCampaignCreate,
+		ReadWithoutTimeout:   resourceCampaignRead,
+		UpdateWithoutTimeout: resourceCampaignUpdate,
+		DeleteWithoutTimeout: resourceCampaignDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connect_instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"dialer_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"agentless_dialer_config": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"dialer_config.0.agentless_dialer_config", "dialer_config.0.predictive_dialer_config", "dialer_config.0.progressive_dialer_config"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dialing_capacity": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.FloatBetween(0.01, 1),
+									},
+								},
+							},
+						},
+						"predictive_dialer_config": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"dialer_config.0.agentless_dialer_config", "dialer_config.0.predictive_dialer_config", "dialer_config.0.progressive_dialer_config"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bandwidth_allocation": {
+										Type:         schema.TypeFloat,
+										Required:     true,
+										ValidateFunc: validation.FloatBetween(0, 1),
+									},
+									"dialing_capacity": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.FloatBetween(0.01, 1),
+									},
+								},
+							},
+						},
+						"progressive_dialer_config": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"dialer_config.0.agentless_dialer_config", "dialer_config.0.predictive_dialer_config", "dialer_config.0.progressive_dialer_config"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bandwidth_allocation": {
+										Type:         schema.TypeFloat,
+										Required:     true,
+										ValidateFunc: validation.FloatBetween(0, 1),
+									},
+									"dialing_capacity": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.FloatBetween(0.01, 1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			"outbound_call_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"answer_machine_detection_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"await_answer_machine_prompt": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"enable_answer_machine_detection": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+						"connect_contact_flow_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 500),
+						},
+						// UpdateCampaignOutboundCallConfig cannot change the queue.
+						// Agentless campaigns don't route contacts to a queue.
+						"connect_queue_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 500),
+						},
+						"connect_source_phone_number": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCampaignCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectCampaignsConn()
+
+	name := d.Get(names.AttrName).(string)
+	input := &connectcampaigns.CreateCampaignInput{
+		ConnectInstanceId:  aws.String(d.Get("connect_instance_id").(string)),
+		DialerConfig:       expandDialerConfig(d.Get("dialer_config").([]interface{})),
+		Name:               aws.String(name),
+		OutboundCallConfig: expandOutboundCallConfig(d.Get("outbound_call_config").([]interface{})),
+		Tags:               GetTagsIn(ctx),
+	}
+
+	output, err := conn.CreateCampaignWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Connect Campaigns Campaign (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return resourceCampaignRead(ctx, d, meta)
+}
+
+func resourceCampaignRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectCampaignsConn()
+
+	campaign, err := FindCampaignByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Campaigns Campaign (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Connect Campaigns Campaign (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, campaign.Arn)
+	d.Set("connect_instance_id", campaign.ConnectInstanceId)
+	if err := d.Set("dialer_config", flattenDialerConfig(campaign.DialerConfig)); err != nil {
+		return diag.Errorf("setting dialer_config: %s", err)
+	}
+	d.Set(names.AttrName, campaign.Name)
+	if err := d.Set("outbound_call_config", flattenOutboundCallConfig(campaign.OutboundCallConfig)); err != nil {
+		return diag.Errorf("setting outbound_call_config: %s", err)
+	}
+
+	SetTagsOut(ctx, campaign.Tags)
+
+	return nil
+}
+
+func resourceCampaignUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectCampaignsConn()
+
+	if d.HasChange("dialer_config") {
+		input := &connectcampaigns.UpdateCampaignDialerConfigInput{
+			DialerConfig: expandDialerConfig(d.Get("dialer_config").([]interface{})),
+			Id:           aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateCampaignDialerConfigWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Connect Campaigns Campaign (%s) dialer config: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange(names.AttrName) {
+		input := &connectcampaigns.UpdateCampaignNameInput{
+			Id:   aws.String(d.Id()),
+			Name: aws.String(d.Get(names.AttrName).(string)),
+		}
+
+		_, err := conn.UpdateCampaignNameWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Connect Campaigns Campaign (%s) name: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("outbound_call_config") {
+		outboundCallConfig := expandOutboundCallConfig(d.Get("outbound_call_config").([]interface{}))
+		input := &connectcampaigns.UpdateCampaignOutboundCallConfigInput{
+			AnswerMachineDetectionConfig: outboundCallConfig.AnswerMachineDetectionConfig,
+			ConnectContactFlowId:         outboundCallConfig.ConnectContactFlowId,
+			ConnectSourcePhoneNumber:     outboundCallConfig.ConnectSourcePhoneNumber,
+			Id:                           aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateCampaignOutboundCallConfigWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Connect Campaigns Campaign (%s) outbound call config: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCampaignRead(ctx, d, meta)
+}
+
+func resourceCampaignDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectCampaignsConn()
+
+	log.Printf("[INFO] Deleting Connect Campaigns Campaign: %s", d.Id())
+	_, err := conn.DeleteCampaignWithContext(ctx, &connectcampaigns.DeleteCampaignInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connectcampaigns.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Connect Campaigns Campaign (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDialerConfig(tfList []interface{}) *connectcampaigns.DialerConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &connectcampaigns.DialerConfig{}
+
+	if v, ok := tfMap["agentless_dialer_config"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AgentlessDialerConfig = &connectcampaigns.AgentlessDialerConfig{}
+
+		if v, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := v["dialing_capacity"].(float64); ok && v != 0 {
+				apiObject.AgentlessDialerConfig.DialingCapacity = aws.Float64(v)
+			}
+		}
+	}
+
+	if v, ok := tfMap["predictive_dialer_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.PredictiveDialerConfig = &connectcampaigns.PredictiveDialerConfig{
+			BandwidthAllocation: aws.Float64(tfMap["bandwidth_allocation"].(float64)),
+		}
+
+		if v, ok := tfMap["dialing_capacity"].(float64); ok && v != 0 {
+			apiObject.PredictiveDialerConfig.DialingCapacity = aws.Float64(v)
+		}
+	}
+
+	if v, ok := tfMap["progressive_dialer_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.ProgressiveDialerConfig = &connectcampaigns.ProgressiveDialerConfig{
+			BandwidthAllocation: aws.Float64(tfMap["bandwidth_allocation"].(float64)),
+		}
+
+		if v, ok := tfMap["dialing_capacity"].(float64); ok && v != 0 {
+			apiObject.ProgressiveDialerConfig.DialingCapacity = aws.Float64(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandOutboundCallConfig(tfList []interface{}) *connectcampaigns.OutboundCallConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &connectcampaigns.OutboundCallConfig{}
+
+	if v, ok := tfMap["answer_machine_detection_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.AnswerMachineDetectionConfig = &connectcampaigns.AnswerMachineDetectionConfig{
+			EnableAnswerMachineDetection: aws.Bool(tfMap["enable_answer_machine_detection"].(bool)),
+		}
+
+		if v, ok := tfMap["await_answer_machine_prompt"].(bool); ok && v {
+			apiObject.AnswerMachineDetectionConfig.AwaitAnswerMachinePrompt = aws.Bool(v)
+		}
+	}
+
+	if v, ok := tfMap["connect_contact_flow_id"].(string); ok && v != "" {
+		apiObject.ConnectContactFlowId = aws.String(v)
+	}
+
+	if v, ok := tfMap["connect_queue_id"].(string); ok && v != "" {
+		apiObject.ConnectQueueId = aws.String(v)
+	}
+
+	if v, ok := tfMap["connect_source_phone_number"].(string); ok && v != "" {
+		apiObject.ConnectSourcePhoneNumber = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDialerConfig(apiObject *connectcampaigns.DialerConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AgentlessDialerConfig; v != nil {
+		tfMap["agentless_dialer_config"] = []interface{}{map[string]interface{}{
+			"dialing_capacity": aws.Float64Value(v.DialingCapacity),
+		}}
+	}
+
+	if v := apiObject.PredictiveDialerConfig; v != nil {
+		tfMap["predictive_dialer_config"] = []interface{}{map[string]interface{}{
+			"bandwidth_allocation": aws.Float64Value(v.BandwidthAllocation),
+			"dialing_capacity":     aws.Float64Value(v.DialingCapacity),
+		}}
+	}
+
+	if v := apiObject.ProgressiveDialerConfig; v != nil {
+		tfMap["progressive_dialer_config"] = []interface{}{map[string]interface{}{
+			"bandwidth_allocation": aws.Float64Value(v.BandwidthAllocation),
+			"dialing_capacity":     aws.Float64Value(v.DialingCapacity),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenOutboundCallConfig(apiObject *connectcampaigns.OutboundCallConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"connect_contact_flow_id":     aws.StringValue(apiObject.ConnectContactFlowId),
+		"connect_queue_id":            aws.StringValue(apiObject.ConnectQueueId),
+		"connect_source_phone_number": aws.StringValue(apiObject.ConnectSourcePhoneNumber),
+	}
+
+	if v := apiObject.AnswerMachineDetectionConfig; v != nil {
+		tfMap["answer_machine_detection_config"] = []interface{}{map[string]interface{}{
+			"await_answer_machine_prompt":     aws.BoolValue(v.AwaitAnswerMachinePrompt),
+			"enable_answer_machine_detection": aws.BoolValue(v.EnableAnswerMachineDetection),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,293 @@
+package connectcampaigns_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connectcampaigns"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tfconnectcampaigns "github.com/hashicorp/terraform-provider-aws/internal/service/connectcampaigns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Campaigns can only be created in an Amazon Connect instance that has completed outbound campaigns onboarding.
+	envVarInstanceID             = "AWS_CONNECTCAMPAIGNS_INSTANCE_ID"
+	envVarInstanceIDMessageError = "Environment variable AWS_CONNECTCAMPAIGNS_INSTANCE_ID is not set. " +
+		"To properly test campaigns, an Amazon Connect instance onboarded for outbound campaigns must be provided."
+)
+
+func TestAccConnectCampaignsCampaign_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	instanceID := envvar.SkipIfEmpty(t, envVarInstanceID, envVarInstanceIDMessageError)
+	var campaign connectcampaigns.Campaign
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_connectcampaigns_campaign.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connectcampaigns.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCampaignDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCampaignConfig_basic(rName, instanceID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCampaignExists(ctx, resourceName, &campaign),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "connect-campaigns", regexp.MustCompile(`campaign/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "connect_instance_id", instanceID),
+					resource.TestCheckResourceAttr(resourceName, "dialer_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dialer_config.0.predictive_dialer_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "dialer_config.0.progressive_dialer_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dialer_config.0.progressive_dialer_config.0.bandwidth_allocation", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "outbound_call_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "outbound_call_config.0.answer_machine_detection_config.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "outbound_call_config.0.connect_contact_flow_id", "data.aws_connect_contact_flow.test", "contact_flow_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "outbound_call_config.0.connect_queue_id", "data.aws_connect_queue.test", "queue_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccConnectCampaignsCampaign_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	instanceID := envvar.SkipIfEmpty(t, envVarInstanceID, envVarInstanceIDMessageError)
+	var campaign connectcampaigns.Campaign
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_connectcampaigns_campaign.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connectcampaigns.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCampaignDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCampaignConfig_basic(rName, instanceID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCampaignExists(ctx, resourceName, &campaign),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfconnectcampaigns.ResourceCampaign(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccConnectCampaignsCampaign_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	instanceID := envvar.SkipIfEmpty(t, envVarInstanceID, envVarInstanceIDMessageError)
+	var campaign connectcampaigns.Campaign
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_connectcampaigns_campaign.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connectcampaigns.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCampaignDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCampaignConfig_basic(rName, instanceID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCampaignExists(ctx, resourceName, &campaign),
+					resource.TestCheckResourceAttr(resourceName, "dialer_config.0.progressive_dialer_config.#", "1"),
+				),
+			},
+			{
+				Config: testAccCampaignConfig_predictive(rNameUpdated, instanceID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCampaignExists(ctx, resourceName, &campaign),
+					resource.TestCheckResourceAttr(resourceName, "dialer_config.0.predictive_dialer_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dialer_config.0.predictive_dialer_config.0.bandwidth_allocation", "0.5"),
+					resource.TestCheckResourceAttr(resourceName, "dialer_config.0.progressive_dialer_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, "outbound_call_config.0.answer_machine_detection_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "outbound_call_config.0.answer_machine_detection_config.0.enable_answer_machine_detection", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConnectCampaignsCampaign_agentless(t *testing.T) {
+	ctx := acctest.Context(t)
+	instanceID := envvar.SkipIfEmpty(t, envVarInstanceID, envVarInstanceIDMessageError)
+	var campaign connectcampaigns.Campaign
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_connectcampaigns_campaign.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connectcampaigns.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCampaignDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCampaignConfig_agentless(rName, instanceID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCampaignExists(ctx, resourceName, &campaign),
+					resource.TestCheckResourceAttr(resourceName, "dialer_config.0.agentless_dialer_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dialer_config.0.agentless_dialer_config.0.dialing_capacity", "0.5"),
+					resource.TestCheckResourceAttr(resourceName, "dialer_config.0.predictive_dialer_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "dialer_config.0.progressive_dialer_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "outbound_call_config.0.answer_machine_detection_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "outbound_call_config.0.answer_machine_detection_config.0.await_answer_machine_prompt", "true"),
+					resource.TestCheckResourceAttr(resourceName, "outbound_call_config.0.answer_machine_detection_config.0.enable_answer_machine_detection", "true"),
+					resource.TestCheckResourceAttr(resourceName, "outbound_call_config.0.connect_queue_id", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCampaignExists(ctx context.Context, resourceName string, v *connectcampaigns.Campaign) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectCampaignsConn()
+
+		output, err := tfconnectcampaigns.FindCampaignByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCampaignDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectCampaignsConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_connectcampaigns_campaign" {
+				continue
+			}
+
+			_, err := tfconnectcampaigns.FindCampaignByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Campaigns Campaign %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCampaignConfig_base(instanceID string) string {
+	return fmt.Sprintf(`
+data "aws_connect_contact_flow" "test" {
+  instance_id = %[1]q
+  name        = "Default outbound"
+}
+
+data "aws_connect_queue" "test" {
+  instance_id = %[1]q
+  name        = "BasicQueue"
+}
+`, instanceID)
+}
+
+func testAccCampaignConfig_basic(rName, instanceID string) string {
+	return acctest.ConfigCompose(testAccCampaignConfig_base(instanceID), fmt.Sprintf(`
+resource "aws_connectcampaigns_campaign" "test" {
+  connect_instance_id = %[2]q
+  name                = %[1]q
+
+  dialer_config {
+    progressive_dialer_config {
+      bandwidth_allocation = 1
+    }
+  }
+
+  outbound_call_config {
+    connect_contact_flow_id = data.aws_connect_contact_flow.test.contact_flow_id
+    connect_queue_id        = data.aws_connect_queue.test.queue_id
+  }
+}
+`, rName, instanceID))
+}
+
+func testAccCampaignConfig_predictive(rName, instanceID string) string {
+	return acctest.ConfigCompose(testAccCampaignConfig_base(instanceID), fmt.Sprintf(`
+resource "aws_connectcampaigns_campaign" "test" {
+  connect_instance_id = %[2]q
+  name                = %[1]q
+
+  dialer_config {
+    predictive_dialer_config {
+      bandwidth_allocation = 0.5
+    }
+  }
+
+  outbound_call_config {
+    connect_contact_flow_id = data.aws_connect_contact_flow.test.contact_flow_id
+    connect_queue_id        = data.aws_connect_queue.test.queue_id
+
+    answer_machine_detection_config {
+      enable_answer_machine_detection = true
+    }
+  }
+}
+`, rName, instanceID))
+}
+
+func testAccCampaignConfig_agentless(rName, instanceID string) string {
+	return acctest.ConfigCompose(testAccCampaignConfig_base(instanceID), fmt.Sprintf(`
+resource "aws_connectcampaigns_campaign" "test" {
+  connect_instance_id = %[2]q
+  name                = %[1]q
+
+  dialer_config {
+    agentless_dialer_config {
+      dialing_capacity = 0.5
+    }
+  }
+
+  outbound_call_config {
+    connect_contact_flow_id = data.aws_connect_contact_flow.test.contact_flow_id
+
+    answer_machine_detection_config {
+      await_answer_machine_prompt     = true
+      enable_answer_machine_detection = true
+    }
+  }
+}
+`, rName, instanceID))
+}
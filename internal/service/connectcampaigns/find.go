@@ -0,0 +1,36 @@
+package connectcampaigns
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connectcampaigns"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindCampaignByID(ctx context.Context, conn *connectcampaigns.ConnectCampaigns, id string) (*connectcampaigns.Campaign, error) {
+	input := &connectcampaigns.DescribeCampaignInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.DescribeCampaignWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connectcampaigns.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Campaign == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Campaign, nil
+}
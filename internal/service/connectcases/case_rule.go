@@ -0,0 +1,432 @@
+package connectcases
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	connectcases_sdkv2 "github.com/aws/aws-sdk-go-v2/service/connectcases"
+	"github.com/aws/aws-sdk-go-v2/service/connectcases/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_connectcases_case_rule", name="Case Rule")
+func ResourceCaseRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCaseRuleCreate,
+		ReadWithoutTimeout:   resourceCaseRuleRead,
+		UpdateWithoutTimeout: resourceCaseRuleUpdate,
+		DeleteWithoutTimeout: resourceCaseRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"case_rule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"domain_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 500),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hidden":   caseRuleDetailsSchema(),
+						"required": caseRuleDetailsSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func caseRuleDetailsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: []string{"rule.0.hidden", "rule.0.required"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"condition": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"equal_to":     caseRuleBooleanOperandsSchema(),
+							"not_equal_to": caseRuleBooleanOperandsSchema(),
+						},
+					},
+				},
+				"default_value": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func caseRuleBooleanOperandsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"field_id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 500),
+				},
+				"result": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+				"value": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"boolean_value": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"double_value": {
+								Type:     schema.TypeFloat,
+								Optional: true,
+							},
+							"empty_value": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"string_value": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(0, 1500),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCaseRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).ConnectCasesClient()
+
+	domainID := d.Get("domain_id").(string)
+	name := d.Get(names.AttrName).(string)
+	input := &connectcases_sdkv2.CreateCaseRuleInput{
+		DomainId: aws_sdkv2.String(domainID),
+		Name:     aws_sdkv2.String(name),
+		Rule:     expandCaseRuleDetails(d.Get("rule").([]interface{})),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws_sdkv2.String(v.(string))
+	}
+
+	output, err := client.CreateCaseRule(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Connect Cases Case Rule (%s): %s", name, err)
+	}
+
+	d.SetId(CaseRuleCreateResourceID(domainID, aws_sdkv2.ToString(output.CaseRuleId)))
+
+	return resourceCaseRuleRead(ctx, d, meta)
+}
+
+func resourceCaseRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).ConnectCasesClient()
+
+	domainID, caseRuleID, err := CaseRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	caseRule, err := FindCaseRuleByTwoPartKey(ctx, client, domainID, caseRuleID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Cases Case Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Connect Cases Case Rule (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, caseRule.CaseRuleArn)
+	d.Set("case_rule_id", caseRule.CaseRuleId)
+	d.Set(names.AttrDescription, caseRule.Description)
+	d.Set("domain_id", domainID)
+	d.Set(names.AttrName, caseRule.Name)
+	if err := d.Set("rule", flattenCaseRuleDetails(caseRule.Rule)); err != nil {
+		return diag.Errorf("setting rule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCaseRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).ConnectCasesClient()
+
+	domainID, caseRuleID, err := CaseRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &connectcases_sdkv2.UpdateCaseRuleInput{
+		CaseRuleId:  aws_sdkv2.String(caseRuleID),
+		Description: aws_sdkv2.String(d.Get(names.AttrDescription).(string)),
+		DomainId:    aws_sdkv2.String(domainID),
+		Name:        aws_sdkv2.String(d.Get(names.AttrName).(string)),
+	}
+
+	if d.HasChange("rule") {
+		input.Rule = expandCaseRuleDetails(d.Get("rule").([]interface{}))
+	}
+
+	_, err = client.UpdateCaseRule(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating Connect Cases Case Rule (%s): %s", d.Id(), err)
+	}
+
+	return resourceCaseRuleRead(ctx, d, meta)
+}
+
+func resourceCaseRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).ConnectCasesClient()
+
+	domainID, caseRuleID, err := CaseRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Connect Cases Case Rule: %s", d.Id())
+	_, err = client.DeleteCaseRule(ctx, &connectcases_sdkv2.DeleteCaseRuleInput{
+		CaseRuleId: aws_sdkv2.String(caseRuleID),
+		DomainId:   aws_sdkv2.String(domainID),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Connect Cases Case Rule (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const caseRuleResourceIDSeparator = "/"
+
+func CaseRuleCreateResourceID(domainID, caseRuleID string) string {
+	parts := []string{domainID, caseRuleID}
+	id := strings.Join(parts, caseRuleResourceIDSeparator)
+
+	return id
+}
+
+func CaseRuleParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, caseRuleResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected domain-id%[2]scase-rule-id", id, caseRuleResourceIDSeparator)
+}
+
+func expandCaseRuleDetails(tfList []interface{}) types.CaseRuleDetails {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["hidden"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		return &types.CaseRuleDetailsMemberHidden{
+			Value: types.HiddenCaseRule{
+				Conditions:   expandCaseRuleConditions(tfMap["condition"].([]interface{})),
+				DefaultValue: aws_sdkv2.Bool(tfMap["default_value"].(bool)),
+			},
+		}
+	}
+
+	if v, ok := tfMap["required"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		return &types.CaseRuleDetailsMemberRequired{
+			Value: types.RequiredCaseRule{
+				Conditions:   expandCaseRuleConditions(tfMap["condition"].([]interface{})),
+				DefaultValue: aws_sdkv2.Bool(tfMap["default_value"].(bool)),
+			},
+		}
+	}
+
+	return nil
+}
+
+func expandCaseRuleConditions(tfList []interface{}) []types.BooleanCondition {
+	apiObjects := []types.BooleanCondition{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["equal_to"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObjects = append(apiObjects, &types.BooleanConditionMemberEqualTo{
+				Value: expandCaseRuleBooleanOperands(v[0].(map[string]interface{})),
+			})
+		} else if v, ok := tfMap["not_equal_to"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObjects = append(apiObjects, &types.BooleanConditionMemberNotEqualTo{
+				Value: expandCaseRuleBooleanOperands(v[0].(map[string]interface{})),
+			})
+		}
+	}
+
+	return apiObjects
+}
+
+func expandCaseRuleBooleanOperands(tfMap map[string]interface{}) types.BooleanOperands {
+	apiObject := types.BooleanOperands{
+		OperandOne: &types.OperandOneMemberFieldId{
+			Value: tfMap["field_id"].(string),
+		},
+		Result: aws_sdkv2.Bool(tfMap["result"].(bool)),
+	}
+
+	if v, ok := tfMap["value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		// Exactly one value is sent; a false boolean_value is used when no other value is configured.
+		if v, ok := tfMap["empty_value"].(bool); ok && v {
+			apiObject.OperandTwo = &types.OperandTwoMemberEmptyValue{}
+		} else if v, ok := tfMap["string_value"].(string); ok && v != "" {
+			apiObject.OperandTwo = &types.OperandTwoMemberStringValue{Value: v}
+		} else if v, ok := tfMap["double_value"].(float64); ok && v != 0 {
+			apiObject.OperandTwo = &types.OperandTwoMemberDoubleValue{Value: v}
+		} else {
+			apiObject.OperandTwo = &types.OperandTwoMemberBooleanValue{Value: tfMap["boolean_value"].(bool)}
+		}
+	}
+
+	return apiObject
+}
+
+func flattenCaseRuleDetails(apiObject types.CaseRuleDetails) []interface{} {
+	tfMap := map[string]interface{}{}
+
+	switch v := apiObject.(type) {
+	case *types.CaseRuleDetailsMemberHidden:
+		tfMap["hidden"] = []interface{}{map[string]interface{}{
+			"condition":     flattenCaseRuleConditions(v.Value.Conditions),
+			"default_value": aws_sdkv2.ToBool(v.Value.DefaultValue),
+		}}
+	case *types.CaseRuleDetailsMemberRequired:
+		tfMap["required"] = []interface{}{map[string]interface{}{
+			"condition":     flattenCaseRuleConditions(v.Value.Conditions),
+			"default_value": aws_sdkv2.ToBool(v.Value.DefaultValue),
+		}}
+	default:
+		return []interface{}{}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenCaseRuleConditions(apiObjects []types.BooleanCondition) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		switch v := apiObject.(type) {
+		case *types.BooleanConditionMemberEqualTo:
+			tfList = append(tfList, map[string]interface{}{
+				"equal_to": []interface{}{flattenCaseRuleBooleanOperands(v.Value)},
+			})
+		case *types.BooleanConditionMemberNotEqualTo:
+			tfList = append(tfList, map[string]interface{}{
+				"not_equal_to": []interface{}{flattenCaseRuleBooleanOperands(v.Value)},
+			})
+		}
+	}
+
+	return tfList
+}
+
+func flattenCaseRuleBooleanOperands(apiObject types.BooleanOperands) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"result": aws_sdkv2.ToBool(apiObject.Result),
+	}
+
+	if v, ok := apiObject.OperandOne.(*types.OperandOneMemberFieldId); ok {
+		tfMap["field_id"] = v.Value
+	}
+
+	value := map[string]interface{}{
+		"boolean_value": false,
+		"double_value":  float64(0),
+		"empty_value":   false,
+		"string_value":  "",
+	}
+
+	switch v := apiObject.OperandTwo.(type) {
+	case *types.OperandTwoMemberBooleanValue:
+		value["boolean_value"] = v.Value
+	case *types.OperandTwoMemberDoubleValue:
+		value["double_value"] = v.Value
+	case *types.OperandTwoMemberEmptyValue:
+		value["empty_value"] = true
+	case *types.OperandTwoMemberStringValue:
+		value["string_value"] = v.Value
+	}
+
+	tfMap["value"] = []interface{}{value}
+
+	return tfMap
+}
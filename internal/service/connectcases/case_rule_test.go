@@ -0,0 +1,254 @@
+package connectcases_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/connectcases/types"
+	"github.com/aws/aws-sdk-go/service/connectcases"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnectcases "github.com/hashicorp/terraform-provider-aws/internal/service/connectcases"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccConnectCasesCaseRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var caseRule types.GetCaseRuleResponse
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_connectcases_case_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connectcases.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCaseRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCaseRuleConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCaseRuleExists(ctx, resourceName, &caseRule),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "cases", regexp.MustCompile(`domain/.+/case-rule/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "case_rule_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_id", "aws_connectcases_domain.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.hidden.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.required.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.required.0.condition.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.required.0.default_value", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccConnectCasesCaseRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var caseRule types.GetCaseRuleResponse
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_connectcases_case_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connectcases.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCaseRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCaseRuleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCaseRuleExists(ctx, resourceName, &caseRule),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfconnectcases.ResourceCaseRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccConnectCasesCaseRule_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var caseRule types.GetCaseRuleResponse
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_connectcases_case_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connectcases.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCaseRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCaseRuleConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCaseRuleExists(ctx, resourceName, &caseRule),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.required.#", "1"),
+				),
+			},
+			{
+				Config: testAccCaseRuleConfig_conditions(rName, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCaseRuleExists(ctx, resourceName, &caseRule),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "example"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName2),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.hidden.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.hidden.0.default_value", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.hidden.0.condition.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "rule.0.hidden.0.condition.0.equal_to.0.field_id", "aws_connectcases_field.test", "field_id"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.hidden.0.condition.0.equal_to.0.result", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.hidden.0.condition.0.equal_to.0.value.0.string_value", "internal"),
+					resource.TestCheckResourceAttrPair(resourceName, "rule.0.hidden.0.condition.1.not_equal_to.0.field_id", "aws_connectcases_field.test", "field_id"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.hidden.0.condition.1.not_equal_to.0.result", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.hidden.0.condition.1.not_equal_to.0.value.0.empty_value", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.required.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCaseRuleExists(ctx context.Context, resourceName string, v *types.GetCaseRuleResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		domainID, caseRuleID, err := tfconnectcases.CaseRuleParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		client := acctest.Provider.Meta().(*conns.AWSClient).ConnectCasesClient()
+
+		output, err := tfconnectcases.FindCaseRuleByTwoPartKey(ctx, client, domainID, caseRuleID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCaseRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acctest.Provider.Meta().(*conns.AWSClient).ConnectCasesClient()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_connectcases_case_rule" {
+				continue
+			}
+
+			domainID, caseRuleID, err := tfconnectcases.CaseRuleParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfconnectcases.FindCaseRuleByTwoPartKey(ctx, client, domainID, caseRuleID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Cases Case Rule %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCaseRuleConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connectcases_domain" "test" {
+  name = %[1]q
+}
+
+resource "aws_connectcases_case_rule" "test" {
+  domain_id = aws_connectcases_domain.test.id
+  name      = %[1]q
+
+  rule {
+    required {
+      default_value = true
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCaseRuleConfig_conditions(rName, ruleName string) string {
+	return fmt.Sprintf(`
+resource "aws_connectcases_domain" "test" {
+  name = %[1]q
+}
+
+resource "aws_connectcases_field" "test" {
+  domain_id = aws_connectcases_domain.test.id
+  name      = %[1]q
+  type      = "Text"
+}
+
+resource "aws_connectcases_case_rule" "test" {
+  domain_id   = aws_connectcases_domain.test.id
+  name        = %[2]q
+  description = "example"
+
+  rule {
+    hidden {
+      default_value = false
+
+      condition {
+        equal_to {
+          field_id = aws_connectcases_field.test.field_id
+          result   = true
+
+          value {
+            string_value = "internal"
+          }
+        }
+      }
+
+      condition {
+        not_equal_to {
+          field_id = aws_connectcases_field.test.field_id
+          result   = true
+
+          value {
+            empty_value = true
+          }
+        }
+      }
+    }
+  }
+}
+`, rName, ruleName)
+}
@@ -0,0 +1,142 @@
+package connectcases
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connectcases"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_connectcases_domain", name="Domain")
+// @Tags(identifierAttribute="arn")
+func ResourceDomain() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDomainCreate,
+		ReadWithoutTimeout:   resourceDomainRead,
+		UpdateWithoutTimeout: resourceDomainUpdate,
+		DeleteWithoutTimeout: resourceDomainDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(domainCreatedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectCasesConn()
+
+	name := d.Get(names.AttrName).(string)
+	input := &connectcases.CreateDomainInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.CreateDomainWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Connect Cases Domain (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.DomainId))
+
+	if _, err := waitDomainCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Connect Cases Domain (%s) create: %s", d.Id(), err)
+	}
+
+	// CreateDomain does not accept tags.
+	if tags := GetTagsIn(ctx); len(tags) > 0 {
+		if err := UpdateTags(ctx, conn, aws.StringValue(output.DomainArn), nil, tags); err != nil {
+			return diag.Errorf("setting Connect Cases Domain (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDomainRead(ctx, d, meta)
+}
+
+func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectCasesConn()
+
+	domain, err := FindDomainByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Cases Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Connect Cases Domain (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, domain.DomainArn)
+	d.Set("created_time", aws.TimeValue(domain.CreatedTime).Format(time.RFC3339))
+	d.Set("domain_status", domain.DomainStatus)
+	d.Set(names.AttrName, domain.Name)
+
+	SetTagsOut(ctx, domain.Tags)
+
+	return nil
+}
+
+func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceDomainRead(ctx, d, meta)
+}
+
+func resourceDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectCasesConn()
+
+	log.Printf("[INFO] Deleting Connect Cases Domain: %s", d.Id())
+	_, err := conn.DeleteDomainWithContext(ctx, &connectcases.DeleteDomainInput{
+		DomainId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connectcases.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Connect Cases Domain (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package connectcases
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connectcases"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_connectcases_field", name="Field")
+// @Tags(identifierAttribute="arn")
+func ResourceField() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFieldCreate,
+		ReadWithoutTimeout:   resourceFieldRead,
+		UpdateWithoutTimeout: resourceFieldUpdate,
+		// The Connect Cases API has no operation to delete a field.
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"domain_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 500),
+			},
+			"field_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(connectcases.FieldType_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFieldCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectCasesConn()
+
+	domainID := d.Get("domain_id").(string)
+	name := d.Get(names.AttrName).(string)
+	input := &connectcases.CreateFieldInput{
+		DomainId: aws.String(domainID),
+		Name:     aws.String(name),
+		Type:     aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateFieldWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Connect Cases Field (%s): %s", name, err)
+	}
+
+	d.SetId(FieldCreateResourceID(domainID, aws.StringValue(output.FieldId)))
+
+	// CreateField does not accept tags.
+	if tags := GetTagsIn(ctx); len(tags) > 0 {
+		if err := UpdateTags(ctx, conn, aws.StringValue(output.FieldArn), nil, tags); err != nil {
+			return diag.Errorf("setting Connect Cases Field (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceFieldRead(ctx, d, meta)
+}
+
+func resourceFieldRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectCasesConn()
+
+	domainID, fieldID, err := FieldParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	field, err := FindFieldByTwoPartKey(ctx, conn, domainID, fieldID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Cases Field (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Connect Cases Field (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, field.FieldArn)
+	d.Set(names.AttrDescription, field.Description)
+	d.Set("domain_id", domainID)
+	d.Set("field_id", field.FieldId)
+	d.Set(names.AttrName, field.Name)
+	d.Set("namespace", field.Namespace)
+	d.Set("type", field.Type)
+
+	SetTagsOut(ctx, field.Tags)
+
+	return nil
+}
+
+func resourceFieldUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectCasesConn()
+
+	if d.HasChanges(names.AttrDescription, names.AttrName) {
+		domainID, fieldID, err := FieldParseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &connectcases.UpdateFieldInput{
+			Description: aws.String(d.Get(names.AttrDescription).(string)),
+			DomainId:    aws.String(domainID),
+			FieldId:     aws.String(fieldID),
+			Name:        aws.String(d.Get(names.AttrName).(string)),
+		}
+
+		_, err = conn.UpdateFieldWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Connect Cases Field (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceFieldRead(ctx, d, meta)
+}
+
+const fieldResourceIDSeparator = "/"
+
+func FieldCreateResourceID(domainID, fieldID string) string {
+	parts := []string{domainID, fieldID}
+	id := strings.Join(parts, fieldResourceIDSeparator)
+
+	return id
+}
+
+func FieldParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, fieldResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected domain-id%[2]sfield-id", id, fieldResourceIDSeparator)
+}
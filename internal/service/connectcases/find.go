@@ -0,0 +1,201 @@
+package connectcases
+
+import (
+	"context"
+	"fmt"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	connectcases_sdkv2 "github.com/aws/aws-sdk-go-v2/service/connectcases"
+	"github.com/aws/aws-sdk-go-v2/service/connectcases/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connectcases"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindDomainByID(ctx context.Context, conn *connectcases.ConnectCases, id string) (*connectcases.GetDomainOutput, error) {
+	input := &connectcases.GetDomainInput{
+		DomainId: aws.String(id),
+	}
+
+	output, err := conn.GetDomainWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connectcases.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindFieldByTwoPartKey(ctx context.Context, conn *connectcases.ConnectCases, domainID, fieldID string) (*connectcases.GetFieldResponse, error) {
+	input := &connectcases.BatchGetFieldInput{
+		DomainId: aws.String(domainID),
+		Fields: []*connectcases.FieldIdentifier{{
+			Id: aws.String(fieldID),
+		}},
+	}
+
+	output, err := conn.BatchGetFieldWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connectcases.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.Errors {
+		if v == nil || aws.StringValue(v.Id) != fieldID {
+			continue
+		}
+
+		if code := aws.StringValue(v.ErrorCode); code == connectcases.ErrCodeResourceNotFoundException {
+			return nil, &retry.NotFoundError{
+				Message:     aws.StringValue(v.Message),
+				LastRequest: input,
+			}
+		}
+
+		return nil, fmt.Errorf("%s: %s", aws.StringValue(v.ErrorCode), aws.StringValue(v.Message))
+	}
+
+	for _, v := range output.Fields {
+		if v != nil && aws.StringValue(v.FieldId) == fieldID {
+			return v, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+func FindTemplateByTwoPartKey(ctx context.Context, conn *connectcases.ConnectCases, domainID, templateID string) (*connectcases.GetTemplateOutput, error) {
+	input := &connectcases.GetTemplateInput{
+		DomainId:   aws.String(domainID),
+		TemplateId: aws.String(templateID),
+	}
+
+	output, err := conn.GetTemplateWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connectcases.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindLayoutByTwoPartKey(ctx context.Context, conn *connectcases.ConnectCases, domainID, layoutID string) (*connectcases.GetLayoutOutput, error) {
+	input := &connectcases.GetLayoutInput{
+		DomainId: aws.String(domainID),
+		LayoutId: aws.String(layoutID),
+	}
+
+	output, err := conn.GetLayoutWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, connectcases.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindCaseRuleByTwoPartKey(ctx context.Context, client *connectcases_sdkv2.Client, domainID, caseRuleID string) (*types.GetCaseRuleResponse, error) {
+	input := &connectcases_sdkv2.BatchGetCaseRuleInput{
+		CaseRules: []types.CaseRuleIdentifier{{
+			Id: aws_sdkv2.String(caseRuleID),
+		}},
+		DomainId: aws_sdkv2.String(domainID),
+	}
+
+	output, err := client.BatchGetCaseRule(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.Errors {
+		if aws_sdkv2.ToString(v.Id) != caseRuleID {
+			continue
+		}
+
+		if code := aws_sdkv2.ToString(v.ErrorCode); code == "ResourceNotFoundException" {
+			return nil, &retry.NotFoundError{
+				Message:     aws_sdkv2.ToString(v.Message),
+				LastRequest: input,
+			}
+		}
+
+		return nil, fmt.Errorf("%s: %s", aws_sdkv2.ToString(v.ErrorCode), aws_sdkv2.ToString(v.Message))
+	}
+
+	for _, v := range output.CaseRules {
+		if aws_sdkv2.ToString(v.CaseRuleId) != caseRuleID {
+			continue
+		}
+
+		// Deleted case rules are returned until they are purged.
+		if v.Deleted {
+			return nil, &retry.NotFoundError{
+				Message:     "deleted",
+				LastRequest: input,
+			}
+		}
+
+		return &v, nil
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
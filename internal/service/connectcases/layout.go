@@ -0,0 +1,390 @@
+package connectcases
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connectcases"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_connectcases_layout", name="Layout")
+// @Tags(identifierAttribute="arn")
+func ResourceLayout() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLayoutCreate,
+		ReadWithoutTimeout:   resourceLayoutRead,
+		UpdateWithoutTimeout: resourceLayoutUpdate,
+		// The Connect Cases API has no operation to delete a layout.
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"basic": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"more_info": layoutSectionsSchema(),
+									"top_panel": layoutSectionsSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"domain_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 500),
+			},
+			"layout_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func layoutSectionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"section": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"field_group": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"field": {
+											Type:     schema.TypeList,
+											Required: true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"id": {
+														Type:         schema.TypeString,
+														Required:     true,
+														ValidateFunc: validation.StringLenBetween(1, 500),
+													},
+												},
+											},
+										},
+										names.AttrName: {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringLenBetween(0, 100),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceLayoutCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectCasesConn()
+
+	domainID := d.Get("domain_id").(string)
+	name := d.Get(names.AttrName).(string)
+	input := &connectcases.CreateLayoutInput{
+		Content:  expandLayoutContent(d.Get("content").([]interface{})),
+		DomainId: aws.String(domainID),
+		Name:     aws.String(name),
+	}
+
+	output, err := conn.CreateLayoutWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Connect Cases Layout (%s): %s", name, err)
+	}
+
+	d.SetId(LayoutCreateResourceID(domainID, aws.StringValue(output.LayoutId)))
+
+	// CreateLayout does not accept tags.
+	if tags := GetTagsIn(ctx); len(tags) > 0 {
+		if err := UpdateTags(ctx, conn, aws.StringValue(output.LayoutArn), nil, tags); err != nil {
+			return diag.Errorf("setting Connect Cases Layout (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceLayoutRead(ctx, d, meta)
+}
+
+func resourceLayoutRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectCasesConn()
+
+	domainID, layoutID, err := LayoutParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	layout, err := FindLayoutByTwoPartKey(ctx, conn, domainID, layoutID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Cases Layout (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Connect Cases Layout (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, layout.LayoutArn)
+	if err := d.Set("content", flattenLayoutContent(layout.Content)); err != nil {
+		return diag.Errorf("setting content: %s", err)
+	}
+	d.Set("domain_id", domainID)
+	d.Set("layout_id", layout.LayoutId)
+	d.Set(names.AttrName, layout.Name)
+
+	SetTagsOut(ctx, layout.Tags)
+
+	return nil
+}
+
+func resourceLayoutUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectCasesConn()
+
+	if d.HasChanges("content", names.AttrName) {
+		domainID, layoutID, err := LayoutParseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &connectcases.UpdateLayoutInput{
+			DomainId: aws.String(domainID),
+			LayoutId: aws.String(layoutID),
+		}
+
+		if d.HasChange("content") {
+			input.Content = expandLayoutContent(d.Get("content").([]interface{}))
+		}
+
+		if d.HasChange(names.AttrName) {
+			input.Name = aws.String(d.Get(names.AttrName).(string))
+		}
+
+		_, err = conn.UpdateLayoutWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Connect Cases Layout (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceLayoutRead(ctx, d, meta)
+}
+
+const layoutResourceIDSeparator = "/"
+
+func LayoutCreateResourceID(domainID, layoutID string) string {
+	parts := []string{domainID, layoutID}
+	id := strings.Join(parts, layoutResourceIDSeparator)
+
+	return id
+}
+
+func LayoutParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, layoutResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected domain-id%[2]slayout-id", id, layoutResourceIDSeparator)
+}
+
+func expandLayoutContent(tfList []interface{}) *connectcases.LayoutContent {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &connectcases.LayoutContent{}
+
+	if v, ok := tfMap["basic"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Basic = expandBasicLayout(v)
+	}
+
+	return apiObject
+}
+
+func expandBasicLayout(tfList []interface{}) *connectcases.BasicLayout {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return &connectcases.BasicLayout{}
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &connectcases.BasicLayout{}
+
+	if v, ok := tfMap["more_info"].([]interface{}); ok && len(v) > 0 {
+		apiObject.MoreInfo = expandLayoutSections(v)
+	}
+
+	if v, ok := tfMap["top_panel"].([]interface{}); ok && len(v) > 0 {
+		apiObject.TopPanel = expandLayoutSections(v)
+	}
+
+	return apiObject
+}
+
+func expandLayoutSections(tfList []interface{}) *connectcases.LayoutSections {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return &connectcases.LayoutSections{}
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &connectcases.LayoutSections{}
+
+	if v, ok := tfMap["section"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			section := &connectcases.Section{}
+
+			if v, ok := tfMap["field_group"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				section.FieldGroup = expandFieldGroup(v[0].(map[string]interface{}))
+			}
+
+			apiObject.Sections = append(apiObject.Sections, section)
+		}
+	}
+
+	return apiObject
+}
+
+func expandFieldGroup(tfMap map[string]interface{}) *connectcases.FieldGroup {
+	apiObject := &connectcases.FieldGroup{
+		Fields: []*connectcases.FieldItem{},
+	}
+
+	if v, ok := tfMap["field"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Fields = append(apiObject.Fields, &connectcases.FieldItem{
+				Id: aws.String(tfMap["id"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenLayoutContent(apiObject *connectcases.LayoutContent) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Basic; v != nil {
+		tfMap["basic"] = []interface{}{map[string]interface{}{
+			"more_info": flattenLayoutSections(v.MoreInfo),
+			"top_panel": flattenLayoutSections(v.TopPanel),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenLayoutSections(apiObject *connectcases.LayoutSections) []interface{} {
+	if apiObject == nil || len(apiObject.Sections) == 0 {
+		return []interface{}{}
+	}
+
+	tfList := make([]interface{}, 0, len(apiObject.Sections))
+
+	for _, section := range apiObject.Sections {
+		if section == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := section.FieldGroup; v != nil {
+			fields := make([]interface{}, 0, len(v.Fields))
+
+			for _, field := range v.Fields {
+				if field == nil {
+					continue
+				}
+
+				fields = append(fields, map[string]interface{}{
+					"id": aws.StringValue(field.Id),
+				})
+			}
+
+			tfMap["field_group"] = []interface{}{map[string]interface{}{
+				"field":        fields,
+				names.AttrName: aws.StringValue(v.Name),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return []interface{}{map[string]interface{}{
+		"section": tfList,
+	}}
+}
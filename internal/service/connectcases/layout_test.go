@@ -0,0 +1,196 @@
+package connectcases_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connectcases"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnectcases "github.com/hashicorp/terraform-provider-aws/internal/service/connectcases"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccConnectCasesLayout_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var layout connectcases.GetLayoutOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_connectcases_layout.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connectcases.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayoutConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLayoutExists(ctx, resourceName, &layout),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "cases", regexp.MustCompile(`domain/.+/layout/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "content.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.more_info.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.top_panel.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.top_panel.0.section.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.top_panel.0.section.0.field_group.0.field.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "content.0.basic.0.top_panel.0.section.0.field_group.0.field.0.id", "aws_connectcases_field.test1", "field_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_id", "aws_connectcases_domain.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "layout_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccConnectCasesLayout_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var layout connectcases.GetLayoutOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_connectcases_layout.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connectcases.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayoutConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLayoutExists(ctx, resourceName, &layout),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.more_info.#", "0"),
+				),
+			},
+			{
+				Config: testAccLayoutConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLayoutExists(ctx, resourceName, &layout),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.more_info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.more_info.0.section.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.more_info.0.section.0.field_group.0.name", "Details"),
+					resource.TestCheckResourceAttrPair(resourceName, "content.0.basic.0.more_info.0.section.0.field_group.0.field.0.id", "aws_connectcases_field.test2", "field_id"),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.top_panel.0.section.0.field_group.0.field.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLayoutExists(ctx context.Context, resourceName string, v *connectcases.GetLayoutOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		domainID, layoutID, err := tfconnectcases.LayoutParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectCasesConn()
+
+		output, err := tfconnectcases.FindLayoutByTwoPartKey(ctx, conn, domainID, layoutID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccLayoutConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connectcases_domain" "test" {
+  name = %[1]q
+}
+
+resource "aws_connectcases_field" "test1" {
+  domain_id = aws_connectcases_domain.test.id
+  name      = "%[1]s-1"
+  type      = "Text"
+}
+
+resource "aws_connectcases_field" "test2" {
+  domain_id = aws_connectcases_domain.test.id
+  name      = "%[1]s-2"
+  type      = "Number"
+}
+`, rName)
+}
+
+func testAccLayoutConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccLayoutConfig_base(rName), fmt.Sprintf(`
+resource "aws_connectcases_layout" "test" {
+  domain_id = aws_connectcases_domain.test.id
+  name      = %[1]q
+
+  content {
+    basic {
+      top_panel {
+        section {
+          field_group {
+            field {
+              id = aws_connectcases_field.test1.field_id
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccLayoutConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccLayoutConfig_base(rName), fmt.Sprintf(`
+resource "aws_connectcases_layout" "test" {
+  domain_id = aws_connectcases_domain.test.id
+  name      = "%[1]s-updated"
+
+  content {
+    basic {
+      more_info {
+        section {
+          field_group {
+            name = "Details"
+
+            field {
+              id = aws_connectcases_field.test2.field_id
+            }
+          }
+        }
+      }
+
+      top_panel {
+        section {
+          field_group {
+            field {
+              id = aws_connectcases_field.test1.field_id
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
@@ -0,0 +1,26 @@
+package connectcases
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connectcases"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusDomain(ctx context.Context, conn *connectcases.ConnectCases, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDomainByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.DomainStatus), nil
+	}
+}
@@ -0,0 +1,322 @@
+package connectcases
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connectcases"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_connectcases_template", name="Template")
+// @Tags(identifierAttribute="arn")
+func ResourceTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTemplateCreate,
+		ReadWithoutTimeout:   resourceTemplateRead,
+		UpdateWithoutTimeout: resourceTemplateUpdate,
+		DeleteWithoutTimeout: resourceTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"domain_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 500),
+			},
+			"layout_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_layout": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 500),
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"required_fields": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 500),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      connectcases.TemplateStatusActive,
+				ValidateFunc: validation.StringInSlice(connectcases.TemplateStatus_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"template_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectCasesConn()
+
+	domainID := d.Get("domain_id").(string)
+	name := d.Get(names.AttrName).(string)
+	input := &connectcases.CreateTemplateInput{
+		DomainId: aws.String(domainID),
+		Name:     aws.String(name),
+		Status:   aws.String(d.Get("status").(string)),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("layout_configuration"); ok && len(v.([]interface{})) > 0 {
+		input.LayoutConfiguration = expandLayoutConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("required_fields"); ok && v.(*schema.Set).Len() > 0 {
+		input.RequiredFields = expandRequiredFields(v.(*schema.Set).List())
+	}
+
+	output, err := conn.CreateTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Connect Cases Template (%s): %s", name, err)
+	}
+
+	d.SetId(TemplateCreateResourceID(domainID, aws.StringValue(output.TemplateId)))
+
+	// CreateTemplate does not accept tags.
+	if tags := GetTagsIn(ctx); len(tags) > 0 {
+		if err := UpdateTags(ctx, conn, aws.StringValue(output.TemplateArn), nil, tags); err != nil {
+			return diag.Errorf("setting Connect Cases Template (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceTemplateRead(ctx, d, meta)
+}
+
+func resourceTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectCasesConn()
+
+	domainID, templateID, err := TemplateParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	template, err := FindTemplateByTwoPartKey(ctx, conn, domainID, templateID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Cases Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Connect Cases Template (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, template.TemplateArn)
+	d.Set(names.AttrDescription, template.Description)
+	d.Set("domain_id", domainID)
+	if err := d.Set("layout_configuration", flattenLayoutConfiguration(template.LayoutConfiguration)); err != nil {
+		return diag.Errorf("setting layout_configuration: %s", err)
+	}
+	d.Set(names.AttrName, template.Name)
+	if err := d.Set("required_fields", flattenRequiredFields(template.RequiredFields)); err != nil {
+		return diag.Errorf("setting required_fields: %s", err)
+	}
+	d.Set("status", template.Status)
+	d.Set("template_id", template.TemplateId)
+
+	SetTagsOut(ctx, template.Tags)
+
+	return nil
+}
+
+func resourceTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectCasesConn()
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		domainID, templateID, err := TemplateParseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &connectcases.UpdateTemplateInput{
+			Description:         aws.String(d.Get(names.AttrDescription).(string)),
+			DomainId:            aws.String(domainID),
+			LayoutConfiguration: &connectcases.LayoutConfiguration{},
+			Name:                aws.String(d.Get(names.AttrName).(string)),
+			RequiredFields:      expandRequiredFields(d.Get("required_fields").(*schema.Set).List()),
+			Status:              aws.String(d.Get("status").(string)),
+			TemplateId:          aws.String(templateID),
+		}
+
+		if v := expandLayoutConfiguration(d.Get("layout_configuration").([]interface{})); v != nil {
+			input.LayoutConfiguration = v
+		}
+
+		_, err = conn.UpdateTemplateWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Connect Cases Template (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceTemplateRead(ctx, d, meta)
+}
+
+// resourceTemplateDelete deactivates the template, as the Connect Cases API has no operation to delete one.
+// The template is deleted together with its domain.
+func resourceTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectCasesConn()
+
+	domainID, templateID, err := TemplateParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deactivating Connect Cases Template: %s", d.Id())
+	_, err = conn.UpdateTemplateWithContext(ctx, &connectcases.UpdateTemplateInput{
+		DomainId:   aws.String(domainID),
+		Status:     aws.String(connectcases.TemplateStatusInactive),
+		TemplateId: aws.String(templateID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connectcases.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deactivating Connect Cases Template (%s): %s", d.Id(), err)
+	}
+
+	return sdkdiag.AppendWarningf(nil, "Connect Cases Template (%s) cannot be deleted; it has been set to %s and removed from state", d.Id(), connectcases.TemplateStatusInactive)
+}
+
+const templateResourceIDSeparator = "/"
+
+func TemplateCreateResourceID(domainID, templateID string) string {
+	parts := []string{domainID, templateID}
+	id := strings.Join(parts, templateResourceIDSeparator)
+
+	return id
+}
+
+func TemplateParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, templateResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected domain-id%[2]stemplate-id", id, templateResourceIDSeparator)
+}
+
+func expandLayoutConfiguration(tfList []interface{}) *connectcases.LayoutConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &connectcases.LayoutConfiguration{}
+
+	if v, ok := tfMap["default_layout"].(string); ok && v != "" {
+		apiObject.DefaultLayout = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandRequiredFields(tfList []interface{}) []*connectcases.RequiredField {
+	apiObjects := make([]*connectcases.RequiredField, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &connectcases.RequiredField{
+			FieldId: aws.String(tfMap["field_id"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenLayoutConfiguration(apiObject *connectcases.LayoutConfiguration) []interface{} {
+	if apiObject == nil || apiObject.DefaultLayout == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"default_layout": aws.StringValue(apiObject.DefaultLayout),
+	}}
+}
+
+func flattenRequiredFields(apiObjects []*connectcases.RequiredField) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"field_id": aws.StringValue(apiObject.FieldId),
+		})
+	}
+
+	return tfList
+}
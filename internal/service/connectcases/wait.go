@@ -0,0 +1,30 @@
+package connectcases
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/connectcases"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const (
+	domainCreatedTimeout = 10 * time.Minute
+)
+
+func waitDomainCreated(ctx context.Context, conn *connectcases.ConnectCases, id string, timeout time.Duration) (*connectcases.GetDomainOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{connectcases.DomainStatusCreationInProgress},
+		Target:  []string{connectcases.DomainStatusActive},
+		Refresh: statusDomain(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*connectcases.GetDomainOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,464 @@
+package customerprofiles
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/customerprofiles"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_customerprofiles_calculated_attribute_definition", name="Calculated Attribute Definition")
+// @Tags(identifierAttribute="arn")
+func ResourceCalculatedAttributeDefinition() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCalculatedAttributeDefinitionCreate,
+		ReadWithoutTimeout:   resourceCalculatedAttributeDefinitionRead,
+		UpdateWithoutTimeout: resourceCalculatedAttributeDefinitionUpdate,
+		DeleteWithoutTimeout: resourceCalculatedAttributeDefinitionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attribute_details": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 2,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+								},
+							},
+						},
+						"expression": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+					},
+				},
+			},
+			"calculated_attribute_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_][a-zA-Z_0-9-]*$`), "must start with a letter or underscore and contain only alphanumeric, underscore (_), and hyphen (-) characters"),
+				),
+			},
+			"conditions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"object_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+						"range": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"unit": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(customerprofiles.Unit_Values(), false),
+									},
+									"value": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 366),
+									},
+								},
+							},
+						},
+						"threshold": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"operator": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(customerprofiles.Operator_Values(), false),
+									},
+									"value": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"statistic": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(customerprofiles.Statistic_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			// UpdateCalculatedAttributeDefinition can change the conditions but not remove them.
+			customdiff.ForceNewIfChange("conditions", func(_ context.Context, old, new, meta interface{}) bool {
+				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
+			}),
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func resourceCalculatedAttributeDefinitionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CustomerProfilesConn()
+
+	domainName := d.Get("domain_name").(string)
+	calculatedAttributeName := d.Get("calculated_attribute_name").(string)
+	id := CalculatedAttributeDefinitionCreateResourceID(domainName, calculatedAttributeName)
+	input := &customerprofiles.CreateCalculatedAttributeDefinitionInput{
+		AttributeDetails:        expandAttributeDetails(d.Get("attribute_details").([]interface{})),
+		CalculatedAttributeName: aws.String(calculatedAttributeName),
+		DomainName:              aws.String(domainName),
+		Statistic:               aws.String(d.Get("statistic").(string)),
+		Tags:                    GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("conditions"); ok {
+		input.Conditions = expandConditions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateCalculatedAttributeDefinitionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Customer Profiles Calculated Attribute Definition (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceCalculatedAttributeDefinitionRead(ctx, d, meta)
+}
+
+func resourceCalculatedAttributeDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CustomerProfilesConn()
+
+	domainName, calculatedAttributeName, err := CalculatedAttributeDefinitionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindCalculatedAttributeDefinitionByTwoPartKey(ctx, conn, domainName, calculatedAttributeName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Customer Profiles Calculated Attribute Definition (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Customer Profiles Calculated Attribute Definition (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Partition: meta.(*conns.AWSClient).Partition,
+		Region:    meta.(*conns.AWSClient).Region,
+		Resource:  fmt.Sprintf("domains/%s/calculated-attributes/%s", domainName, calculatedAttributeName),
+		Service:   "profile",
+	}.String()
+	d.Set(names.AttrARN, arn)
+	if err := d.Set("attribute_details", flattenAttributeDetails(output.AttributeDetails)); err != nil {
+		return diag.Errorf("setting attribute_details: %s", err)
+	}
+	d.Set("calculated_attribute_name", output.CalculatedAttributeName)
+	if err := d.Set("conditions", flattenConditions(output.Conditions)); err != nil {
+		return diag.Errorf("setting conditions: %s", err)
+	}
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("display_name", output.DisplayName)
+	d.Set("domain_name", domainName)
+	d.Set("statistic", output.Statistic)
+
+	SetTagsOut(ctx, output.Tags)
+
+	return nil
+}
+
+func resourceCalculatedAttributeDefinitionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CustomerProfilesConn()
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		domainName, calculatedAttributeName, err := CalculatedAttributeDefinitionParseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &customerprofiles.UpdateCalculatedAttributeDefinitionInput{
+			CalculatedAttributeName: aws.String(calculatedAttributeName),
+			Conditions:              expandConditions(d.Get("conditions").([]interface{})),
+			DomainName:              aws.String(domainName),
+		}
+
+		if v, ok := d.GetOk(names.AttrDescription); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("display_name"); ok {
+			input.DisplayName = aws.String(v.(string))
+		}
+
+		_, err = conn.UpdateCalculatedAttributeDefinitionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Customer Profiles Calculated Attribute Definition (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceCalculatedAttributeDefinitionRead(ctx, d, meta)
+}
+
+func resourceCalculatedAttributeDefinitionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CustomerProfilesConn()
+
+	domainName, calculatedAttributeName, err := CalculatedAttributeDefinitionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Customer Profiles Calculated Attribute Definition: %s", d.Id())
+	_, err = conn.DeleteCalculatedAttributeDefinitionWithContext(ctx, &customerprofiles.DeleteCalculatedAttributeDefinitionInput{
+		CalculatedAttributeName: aws.String(calculatedAttributeName),
+		DomainName:              aws.String(domainName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, customerprofiles.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Customer Profiles Calculated Attribute Definition (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const calculatedAttributeDefinitionResourceIDSeparator = "/"
+
+func CalculatedAttributeDefinitionCreateResourceID(domainName, calculatedAttributeName string) string {
+	parts := []string{domainName, calculatedAttributeName}
+	id := strings.Join(parts, calculatedAttributeDefinitionResourceIDSeparator)
+
+	return id
+}
+
+func CalculatedAttributeDefinitionParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, calculatedAttributeDefinitionResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected domain-name%[2]scalculated-attribute-name", id, calculatedAttributeDefinitionResourceIDSeparator)
+}
+
+func FindCalculatedAttributeDefinitionByTwoPartKey(ctx context.Context, conn *customerprofiles.CustomerProfiles, domainName, calculatedAttributeName string) (*customerprofiles.GetCalculatedAttributeDefinitionOutput, error) {
+	input := &customerprofiles.GetCalculatedAttributeDefinitionInput{
+		CalculatedAttributeName: aws.String(calculatedAttributeName),
+		DomainName:              aws.String(domainName),
+	}
+
+	output, err := conn.GetCalculatedAttributeDefinitionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, customerprofiles.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandAttributeDetails(tfList []interface{}) *customerprofiles.AttributeDetails {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &customerprofiles.AttributeDetails{
+		Expression: aws.String(tfMap["expression"].(string)),
+	}
+
+	for _, tfMapRaw := range tfMap["attribute"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject.Attributes = append(apiObject.Attributes, &customerprofiles.AttributeItem{
+			Name: aws.String(tfMap["name"].(string)),
+		})
+	}
+
+	return apiObject
+}
+
+func expandConditions(tfList []interface{}) *customerprofiles.Conditions {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &customerprofiles.Conditions{}
+
+	if v, ok := tfMap["object_count"].(int); ok && v != 0 {
+		apiObject.ObjectCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Range = &customerprofiles.Range{
+			Unit:  aws.String(tfMap["unit"].(string)),
+			Value: aws.Int64(int64(tfMap["value"].(int))),
+		}
+	}
+
+	if v, ok := tfMap["threshold"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Threshold = &customerprofiles.Threshold{
+			Operator: aws.String(tfMap["operator"].(string)),
+			Value:    aws.String(tfMap["value"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenAttributeDetails(apiObject *customerprofiles.AttributeDetails) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfList := make([]interface{}, 0, len(apiObject.Attributes))
+
+	for _, apiObject := range apiObject.Attributes {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name": aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"attribute":  tfList,
+		"expression": aws.StringValue(apiObject.Expression),
+	}}
+}
+
+func flattenConditions(apiObject *customerprofiles.Conditions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"object_count": aws.Int64Value(apiObject.ObjectCount),
+	}
+
+	if v := apiObject.Range; v != nil {
+		tfMap["range"] = []interface{}{map[string]interface{}{
+			"unit":  aws.StringValue(v.Unit),
+			"value": aws.Int64Value(v.Value),
+		}}
+	}
+
+	if v := apiObject.Threshold; v != nil {
+		tfMap["threshold"] = []interface{}{map[string]interface{}{
+			"operator": aws.StringValue(v.Operator),
+			"value":    aws.StringValue(v.Value),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
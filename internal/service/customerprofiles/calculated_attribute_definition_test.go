@@ -0,0 +1,381 @@
+package customerprofiles_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/customerprofiles"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcustomerprofiles "github.com/hashicorp/terraform-provider-aws/internal/service/customerprofiles"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCustomerProfilesCalculatedAttributeDefinition_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var definition customerprofiles.GetCalculatedAttributeDefinitionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_calculated_attribute_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCalculatedAttributeDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCalculatedAttributeDefinitionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCalculatedAttributeDefinitionExists(ctx, resourceName, &definition),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "profile", regexp.MustCompile(`domains/.+/calculated-attributes/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "attribute_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attribute_details.0.attribute.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attribute_details.0.attribute.0.name", "Amount"),
+					resource.TestCheckResourceAttr(resourceName, "attribute_details.0.expression", "{Order.Amount}"),
+					resource.TestCheckResourceAttr(resourceName, "calculated_attribute_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "conditions.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_name", "aws_customerprofiles_domain.test", "domain_name"),
+					resource.TestCheckResourceAttr(resourceName, "statistic", "SUM"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesCalculatedAttributeDefinition_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var definition customerprofiles.GetCalculatedAttributeDefinitionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_calculated_attribute_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCalculatedAttributeDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCalculatedAttributeDefinitionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCalculatedAttributeDefinitionExists(ctx, resourceName, &definition),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcustomerprofiles.ResourceCalculatedAttributeDefinition(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesCalculatedAttributeDefinition_conditions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var definition customerprofiles.GetCalculatedAttributeDefinitionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_calculated_attribute_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCalculatedAttributeDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCalculatedAttributeDefinitionConfig_conditions(rName, "initial description", 30, "1000"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCalculatedAttributeDefinitionExists(ctx, resourceName, &definition),
+					resource.TestCheckResourceAttr(resourceName, "conditions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "conditions.0.object_count", "10"),
+					resource.TestCheckResourceAttr(resourceName, "conditions.0.range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "conditions.0.range.0.unit", "DAYS"),
+					resource.TestCheckResourceAttr(resourceName, "conditions.0.range.0.value", "30"),
+					resource.TestCheckResourceAttr(resourceName, "conditions.0.threshold.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "conditions.0.threshold.0.operator", "GREATER_THAN"),
+					resource.TestCheckResourceAttr(resourceName, "conditions.0.threshold.0.value", "1000"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "initial description"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCalculatedAttributeDefinitionConfig_conditions(rName, "updated description", 90, "5000"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCalculatedAttributeDefinitionExists(ctx, resourceName, &definition),
+					resource.TestCheckResourceAttr(resourceName, "conditions.0.range.0.value", "90"),
+					resource.TestCheckResourceAttr(resourceName, "conditions.0.threshold.0.value", "5000"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated description"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesCalculatedAttributeDefinition_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var definition customerprofiles.GetCalculatedAttributeDefinitionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_calculated_attribute_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCalculatedAttributeDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCalculatedAttributeDefinitionConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCalculatedAttributeDefinitionExists(ctx, resourceName, &definition),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCalculatedAttributeDefinitionConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCalculatedAttributeDefinitionExists(ctx, resourceName, &definition),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccCalculatedAttributeDefinitionConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCalculatedAttributeDefinitionExists(ctx, resourceName, &definition),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCalculatedAttributeDefinitionExists(ctx context.Context, resourceName string, v *customerprofiles.GetCalculatedAttributeDefinitionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		domainName, calculatedAttributeName, err := tfcustomerprofiles.CalculatedAttributeDefinitionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CustomerProfilesConn()
+
+		output, err := tfcustomerprofiles.FindCalculatedAttributeDefinitionByTwoPartKey(ctx, conn, domainName, calculatedAttributeName)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCalculatedAttributeDefinitionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CustomerProfilesConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_customerprofiles_calculated_attribute_definition" {
+				continue
+			}
+
+			domainName, calculatedAttributeName, err := tfcustomerprofiles.CalculatedAttributeDefinitionParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfcustomerprofiles.FindCalculatedAttributeDefinitionByTwoPartKey(ctx, conn, domainName, calculatedAttributeName)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Customer Profiles Calculated Attribute Definition %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// Calculated attributes can only reference fields of an existing profile object type.
+func testAccCalculatedAttributeDefinitionConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_customerprofiles_domain" "test" {
+  domain_name             = %[1]q
+  default_expiration_days = 365
+}
+
+resource "aws_customerprofiles_profile_object_type" "test" {
+  domain_name            = aws_customerprofiles_domain.test.domain_name
+  object_type_name       = "Order"
+  description            = "Orders"
+  allow_profile_creation = true
+
+  field {
+    name = "email"
+
+    object_type_field {
+      content_type = "EMAIL_ADDRESS"
+      source       = "_source.email"
+      target       = "_profile.EmailAddress"
+    }
+  }
+
+  field {
+    name = "Amount"
+
+    object_type_field {
+      content_type = "NUMBER"
+      source       = "_source.Amount"
+      target       = "Order.Amount"
+    }
+  }
+
+  key {
+    name = "_email"
+
+    object_type_key {
+      field_names          = ["email"]
+      standard_identifiers = ["PROFILE"]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCalculatedAttributeDefinitionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCalculatedAttributeDefinitionConfig_base(rName), fmt.Sprintf(`
+resource "aws_customerprofiles_calculated_attribute_definition" "test" {
+  domain_name               = aws_customerprofiles_domain.test.domain_name
+  calculated_attribute_name = %[1]q
+  statistic                 = "SUM"
+
+  attribute_details {
+    attribute {
+      name = "Amount"
+    }
+
+    expression = "{Order.Amount}"
+  }
+
+  depends_on = [aws_customerprofiles_profile_object_type.test]
+}
+`, rName))
+}
+
+func testAccCalculatedAttributeDefinitionConfig_conditions(rName, description string, days int, threshold string) string {
+	return acctest.ConfigCompose(testAccCalculatedAttributeDefinitionConfig_base(rName), fmt.Sprintf(`
+resource "aws_customerprofiles_calculated_attribute_definition" "test" {
+  domain_name               = aws_customerprofiles_domain.test.domain_name
+  calculated_attribute_name = %[1]q
+  display_name              = %[1]q
+  description               = %[2]q
+  statistic                 = "SUM"
+
+  attribute_details {
+    attribute {
+      name = "Amount"
+    }
+
+    expression = "{Order.Amount}"
+  }
+
+  conditions {
+    object_count = 10
+
+    range {
+      unit  = "DAYS"
+      value = %[3]d
+    }
+
+    threshold {
+      operator = "GREATER_THAN"
+      value    = %[4]q
+    }
+  }
+
+  depends_on = [aws_customerprofiles_profile_object_type.test]
+}
+`, rName, description, days, threshold))
+}
+
+func testAccCalculatedAttributeDefinitionConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccCalculatedAttributeDefinitionConfig_base(rName), fmt.Sprintf(`
+resource "aws_customerprofiles_calculated_attribute_definition" "test" {
+  domain_name               = aws_customerprofiles_domain.test.domain_name
+  calculated_attribute_name = %[1]q
+  statistic                 = "SUM"
+
+  attribute_details {
+    attribute {
+      name = "Amount"
+    }
+
+    expression = "{Order.Amount}"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_customerprofiles_profile_object_type.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccCalculatedAttributeDefinitionConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccCalculatedAttributeDefinitionConfig_base(rName), fmt.Sprintf(`
+resource "aws_customerprofiles_calculated_attribute_definition" "test" {
+  domain_name               = aws_customerprofiles_domain.test.domain_name
+  calculated_attribute_name = %[1]q
+  statistic                 = "SUM"
+
+  attribute_details {
+    attribute {
+      name = "Amount"
+    }
+
+    expression = "{Order.Amount}"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_customerprofiles_profile_object_type.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,537 @@
+package customerprofiles
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/customerprofiles"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_customerprofiles_domain", name="Domain")
+// @Tags(identifierAttribute="arn")
+func ResourceDomain() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDomainCreate,
+		ReadWithoutTimeout:   resourceDomainRead,
+		UpdateWithoutTimeout: resourceDomainUpdate,
+		DeleteWithoutTimeout: resourceDomainDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dead_letter_queue_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"default_encryption_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"default_expiration_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 1098),
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only alphanumeric, underscore (_), and hyphen (-) characters"),
+				),
+			},
+			"matching": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_merging": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"conflict_resolution": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"conflict_resolving_model": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(customerprofiles.ConflictResolvingModel_Values(), false),
+												},
+												"source_name": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+											},
+										},
+									},
+									"consolidation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"matching_attributes_list": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													MaxItems: 10,
+													Elem: &schema.Schema{
+														Type:     schema.TypeList,
+														MinItems: 1,
+														MaxItems: 20,
+														Elem: &schema.Schema{
+															Type:         schema.TypeString,
+															ValidateFunc: validation.StringLenBetween(1, 255),
+														},
+													},
+												},
+											},
+										},
+									},
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"min_allowed_confidence_score_for_merging": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ValidateFunc: validation.FloatBetween(0, 1),
+									},
+								},
+							},
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"exporting_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3_exporting": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"s3_bucket_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(3, 63),
+												},
+												"s3_key_name": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 800),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"job_schedule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"day_of_the_week": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(customerprofiles.JobScheduleDayOfTheWeek_Values(), false),
+									},
+									"time": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(3, 5),
+											validation.StringMatch(regexp.MustCompile(`^([0-9]|0[0-9]|1[0-9]|2[0-3]):[0-5][0-9]$`), "must be a time of day in HH:MM format"),
+										),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CustomerProfilesConn()
+
+	name := d.Get("domain_name").(string)
+	input := &customerprofiles.CreateDomainInput{
+		DefaultExpirationDays: aws.Int64(int64(d.Get("default_expiration_days").(int))),
+		DomainName:            aws.String(name),
+		Tags:                  GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("dead_letter_queue_url"); ok {
+		input.DeadLetterQueueUrl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_encryption_key"); ok {
+		input.DefaultEncryptionKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("matching"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Matching = expandMatching(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateDomainWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Customer Profiles Domain (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.DomainName))
+
+	return resourceDomainRead(ctx, d, meta)
+}
+
+func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CustomerProfilesConn()
+
+	output, err := FindDomainByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Customer Profiles Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Customer Profiles Domain (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Partition: meta.(*conns.AWSClient).Partition,
+		Region:    meta.(*conns.AWSClient).Region,
+		Resource:  fmt.Sprintf("domains/%s", d.Id()),
+		Service:   "profile",
+	}.String()
+	d.Set(names.AttrARN, arn)
+	d.Set("dead_letter_queue_url", output.DeadLetterQueueUrl)
+	d.Set("default_encryption_key", output.DefaultEncryptionKey)
+	d.Set("default_expiration_days", output.DefaultExpirationDays)
+	d.Set("domain_name", output.DomainName)
+	if err := d.Set("matching", flattenMatching(output.Matching)); err != nil {
+		return diag.Errorf("setting matching: %s", err)
+	}
+
+	SetTagsOut(ctx, output.Tags)
+
+	return nil
+}
+
+func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CustomerProfilesConn()
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &customerprofiles.UpdateDomainInput{
+			DomainName: aws.String(d.Id()),
+		}
+
+		// Clearing the dead letter queue or encryption key requires sending an empty string.
+		if d.HasChange("dead_letter_queue_url") {
+			input.DeadLetterQueueUrl = aws.String(d.Get("dead_letter_queue_url").(string))
+		}
+
+		if d.HasChange("default_encryption_key") {
+			input.DefaultEncryptionKey = aws.String(d.Get("default_encryption_key").(string))
+		}
+
+		if d.HasChange("default_expiration_days") {
+			input.DefaultExpirationDays = aws.Int64(int64(d.Get("default_expiration_days").(int)))
+		}
+
+		if d.HasChange("matching") {
+			if v, ok := d.GetOk("matching"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.Matching = expandMatching(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.Matching = &customerprofiles.MatchingRequest{
+					Enabled: aws.Bool(false),
+				}
+			}
+		}
+
+		_, err := conn.UpdateDomainWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Customer Profiles Domain (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceDomainRead(ctx, d, meta)
+}
+
+func resourceDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CustomerProfilesConn()
+
+	log.Printf("[INFO] Deleting Customer Profiles Domain: %s", d.Id())
+	_, err := conn.DeleteDomainWithContext(ctx, &customerprofiles.DeleteDomainInput{
+		DomainName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, customerprofiles.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Customer Profiles Domain (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindDomainByName(ctx context.Context, conn *customerprofiles.CustomerProfiles, name string) (*customerprofiles.GetDomainOutput, error) {
+	input := &customerprofiles.GetDomainInput{
+		DomainName: aws.String(name),
+	}
+
+	output, err := conn.GetDomainWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, customerprofiles.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandMatching(tfMap map[string]interface{}) *customerprofiles.MatchingRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &customerprofiles.MatchingRequest{
+		Enabled: aws.Bool(tfMap["enabled"].(bool)),
+	}
+
+	if v, ok := tfMap["auto_merging"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AutoMerging = expandAutoMerging(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["exporting_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ExportingConfig = expandExportingConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["job_schedule"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.JobSchedule = expandJobSchedule(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandAutoMerging(tfMap map[string]interface{}) *customerprofiles.AutoMerging {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &customerprofiles.AutoMerging{
+		Enabled: aws.Bool(tfMap["enabled"].(bool)),
+	}
+
+	if v, ok := tfMap["conflict_resolution"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ConflictResolution = expandConflictResolution(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["consolidation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Consolidation = expandConsolidation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["min_allowed_confidence_score_for_merging"].(float64); ok && v != 0 {
+		apiObject.MinAllowedConfidenceScoreForMerging = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func expandConflictResolution(tfMap map[string]interface{}) *customerprofiles.ConflictResolution {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &customerprofiles.ConflictResolution{
+		ConflictResolvingModel: aws.String(tfMap["conflict_resolving_model"].(string)),
+	}
+
+	if v, ok := tfMap["source_name"].(string); ok && v != "" {
+		apiObject.SourceName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandConsolidation(tfMap map[string]interface{}) *customerprofiles.Consolidation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &customerprofiles.Consolidation{}
+
+	if v, ok := tfMap["matching_attributes_list"].([]interface{}); ok && len(v) > 0 {
+		for _, attributes := range v {
+			apiObject.MatchingAttributesList = append(apiObject.MatchingAttributesList, flex.ExpandStringList(attributes.([]interface{})))
+		}
+	}
+
+	return apiObject
+}
+
+func expandExportingConfig(tfMap map[string]interface{}) *customerprofiles.ExportingConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &customerprofiles.ExportingConfig{}
+
+	if v, ok := tfMap["s3_exporting"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3Exporting = expandS3ExportingConfig(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandS3ExportingConfig(tfMap map[string]interface{}) *customerprofiles.S3ExportingConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &customerprofiles.S3ExportingConfig{
+		S3BucketName: aws.String(tfMap["s3_bucket_name"].(string)),
+	}
+
+	if v, ok := tfMap["s3_key_name"].(string); ok && v != "" {
+		apiObject.S3KeyName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJobSchedule(tfMap map[string]interface{}) *customerprofiles.JobSchedule {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &customerprofiles.JobSchedule{
+		DayOfTheWeek: aws.String(tfMap["day_of_the_week"].(string)),
+		Time:         aws.String(tfMap["time"].(string)),
+	}
+}
+
+func flattenMatching(apiObject *customerprofiles.MatchingResponse) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled": aws.BoolValue(apiObject.Enabled),
+	}
+
+	if v := apiObject.AutoMerging; v != nil {
+		tfMap["auto_merging"] = flattenAutoMerging(v)
+	}
+
+	if v := apiObject.ExportingConfig; v != nil {
+		tfMap["exporting_config"] = flattenExportingConfig(v)
+	}
+
+	if v := apiObject.JobSchedule; v != nil {
+		tfMap["job_schedule"] = []interface{}{map[string]interface{}{
+			"day_of_the_week": aws.StringValue(v.DayOfTheWeek),
+			"time":            aws.StringValue(v.Time),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAutoMerging(apiObject *customerprofiles.AutoMerging) []interface{} {
+	tfMap := map[string]interface{}{
+		"enabled": aws.BoolValue(apiObject.Enabled),
+		"min_allowed_confidence_score_for_merging": aws.Float64Value(apiObject.MinAllowedConfidenceScoreForMerging),
+	}
+
+	if v := apiObject.ConflictResolution; v != nil {
+		tfMap["conflict_resolution"] = []interface{}{map[string]interface{}{
+			"conflict_resolving_model": aws.StringValue(v.ConflictResolvingModel),
+			"source_name":              aws.StringValue(v.SourceName),
+		}}
+	}
+
+	if v := apiObject.Consolidation; v != nil {
+		matchingAttributesList := make([]interface{}, 0, len(v.MatchingAttributesList))
+		for _, attributes := range v.MatchingAttributesList {
+			matchingAttributesList = append(matchingAttributesList, aws.StringValueSlice(attributes))
+		}
+
+		tfMap["consolidation"] = []interface{}{map[string]interface{}{
+			"matching_attributes_list": matchingAttributesList,
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenExportingConfig(apiObject *customerprofiles.ExportingConfig) []interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.S3Exporting; v != nil {
+		tfMap["s3_exporting"] = []interface{}{map[string]interface{}{
+			"s3_bucket_name": aws.StringValue(v.S3BucketName),
+			"s3_key_name":    aws.StringValue(v.S3KeyName),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
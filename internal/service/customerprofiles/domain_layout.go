@@ -0,0 +1,297 @@
+package customerprofiles
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	customerprofiles_sdkv2 "github.com/aws/aws-sdk-go-v2/service/customerprofiles"
+	"github.com/aws/aws-sdk-go-v2/service/customerprofiles/types"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_customerprofiles_domain_layout", name="Domain Layout")
+// @Tags(identifierAttribute="arn")
+func ResourceDomainLayout() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDomainLayoutCreate,
+		ReadWithoutTimeout:   resourceDomainLayoutRead,
+		UpdateWithoutTimeout: resourceDomainLayoutUpdate,
+		DeleteWithoutTimeout: resourceDomainLayoutDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"is_default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"layout": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"layout_definition_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_][a-zA-Z_0-9-]*$`), "must start with a letter or underscore and contain only alphanumeric, underscore (_), and hyphen (-) characters"),
+				),
+			},
+			"layout_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.LayoutType](),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDomainLayoutCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).CustomerProfilesClient()
+
+	domainName := d.Get("domain_name").(string)
+	layoutDefinitionName := d.Get("layout_definition_name").(string)
+	id := DomainLayoutCreateResourceID(domainName, layoutDefinitionName)
+
+	layout, err := structure.NormalizeJsonString(d.Get("layout").(string))
+
+	if err != nil {
+		return diag.Errorf("layout (%s) is invalid JSON: %s", layout, err)
+	}
+
+	input := &customerprofiles_sdkv2.CreateDomainLayoutInput{
+		Description:          aws_sdkv2.String(d.Get(names.AttrDescription).(string)),
+		DisplayName:          aws_sdkv2.String(d.Get("display_name").(string)),
+		DomainName:           aws_sdkv2.String(domainName),
+		IsDefault:            d.Get("is_default").(bool),
+		Layout:               aws_sdkv2.String(layout),
+		LayoutDefinitionName: aws_sdkv2.String(layoutDefinitionName),
+		LayoutType:           types.LayoutType(d.Get("layout_type").(string)),
+		Tags:                 aws_sdkv2.ToStringMap(GetTagsIn(ctx)),
+	}
+
+	_, err = client.CreateDomainLayout(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Customer Profiles Domain Layout (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceDomainLayoutRead(ctx, d, meta)
+}
+
+func resourceDomainLayoutRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).CustomerProfilesClient()
+
+	domainName, layoutDefinitionName, err := DomainLayoutParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindDomainLayoutByTwoPartKey(ctx, client, domainName, layoutDefinitionName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Customer Profiles Domain Layout (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Customer Profiles Domain Layout (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Partition: meta.(*conns.AWSClient).Partition,
+		Region:    meta.(*conns.AWSClient).Region,
+		Resource:  fmt.Sprintf("domains/%s/layouts/%s", domainName, layoutDefinitionName),
+		Service:   "profile",
+	}.String()
+	d.Set(names.AttrARN, arn)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("display_name", output.DisplayName)
+	d.Set("domain_name", domainName)
+	d.Set("is_default", output.IsDefault)
+	d.Set("layout", output.Layout)
+	d.Set("layout_definition_name", output.LayoutDefinitionName)
+	d.Set("layout_type", output.LayoutType)
+	d.Set("version", output.Version)
+
+	SetTagsOut(ctx, aws_sdkv2.StringMap(output.Tags))
+
+	return nil
+}
+
+func resourceDomainLayoutUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).CustomerProfilesClient()
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		domainName, layoutDefinitionName, err := DomainLayoutParseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &customerprofiles_sdkv2.UpdateDomainLayoutInput{
+			DomainName:           aws_sdkv2.String(domainName),
+			IsDefault:            d.Get("is_default").(bool),
+			LayoutDefinitionName: aws_sdkv2.String(layoutDefinitionName),
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws_sdkv2.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange("display_name") {
+			input.DisplayName = aws_sdkv2.String(d.Get("display_name").(string))
+		}
+
+		if d.HasChange("layout") {
+			layout, err := structure.NormalizeJsonString(d.Get("layout").(string))
+
+			if err != nil {
+				return diag.Errorf("layout (%s) is invalid JSON: %s", layout, err)
+			}
+
+			input.Layout = aws_sdkv2.String(layout)
+		}
+
+		if d.HasChange("layout_type") {
+			input.LayoutType = types.LayoutType(d.Get("layout_type").(string))
+		}
+
+		_, err = client.UpdateDomainLayout(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Customer Profiles Domain Layout (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceDomainLayoutRead(ctx, d, meta)
+}
+
+func resourceDomainLayoutDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).CustomerProfilesClient()
+
+	domainName, layoutDefinitionName, err := DomainLayoutParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Customer Profiles Domain Layout: %s", d.Id())
+	_, err = client.DeleteDomainLayout(ctx, &customerprofiles_sdkv2.DeleteDomainLayoutInput{
+		DomainName:           aws_sdkv2.String(domainName),
+		LayoutDefinitionName: aws_sdkv2.String(layoutDefinitionName),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Customer Profiles Domain Layout (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const domainLayoutResourceIDSeparator = "/"
+
+func DomainLayoutCreateResourceID(domainName, layoutDefinitionName string) string {
+	parts := []string{domainName, layoutDefinitionName}
+	id := strings.Join(parts, domainLayoutResourceIDSeparator)
+
+	return id
+}
+
+func DomainLayoutParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, domainLayoutResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected domain-name%[2]slayout-definition-name", id, domainLayoutResourceIDSeparator)
+}
+
+func FindDomainLayoutByTwoPartKey(ctx context.Context, client *customerprofiles_sdkv2.Client, domainName, layoutDefinitionName string) (*customerprofiles_sdkv2.GetDomainLayoutOutput, error) {
+	input := &customerprofiles_sdkv2.GetDomainLayoutInput{
+		DomainName:           aws_sdkv2.String(domainName),
+		LayoutDefinitionName: aws_sdkv2.String(layoutDefinitionName),
+	}
+
+	output, err := client.GetDomainLayout(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,308 @@
+package customerprofiles_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	customerprofiles_sdkv2 "github.com/aws/aws-sdk-go-v2/service/customerprofiles"
+	"github.com/aws/aws-sdk-go/service/customerprofiles"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcustomerprofiles "github.com/hashicorp/terraform-provider-aws/internal/service/customerprofiles"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCustomerProfilesDomainLayout_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var domainLayout customerprofiles_sdkv2.GetDomainLayoutOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_domain_layout.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainLayoutDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainLayoutConfig_basic(rName, "example", "Overview"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDomainLayoutExists(ctx, resourceName, &domainLayout),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "profile", regexp.MustCompile(`domains/.+/layouts/.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "example"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_name", "aws_customerprofiles_domain.test", "domain_name"),
+					resource.TestCheckResourceAttr(resourceName, "is_default", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "layout"),
+					resource.TestCheckResourceAttr(resourceName, "layout_definition_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "layout_type", "PROFILE_EXPLORER"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesDomainLayout_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var domainLayout customerprofiles_sdkv2.GetDomainLayoutOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_domain_layout.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainLayoutDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainLayoutConfig_basic(rName, "example", "Overview"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainLayoutExists(ctx, resourceName, &domainLayout),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcustomerprofiles.ResourceDomainLayout(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesDomainLayout_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var domainLayout customerprofiles_sdkv2.GetDomainLayoutOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_domain_layout.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainLayoutDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainLayoutConfig_basic(rName, "example", "Overview"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDomainLayoutExists(ctx, resourceName, &domainLayout),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "example"),
+					resource.TestCheckResourceAttr(resourceName, "is_default", "false"),
+				),
+			},
+			{
+				Config: testAccDomainLayoutConfig_default(rName, "updated", "Details"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDomainLayoutExists(ctx, resourceName, &domainLayout),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "is_default", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesDomainLayout_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var domainLayout customerprofiles_sdkv2.GetDomainLayoutOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_domain_layout.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainLayoutDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainLayoutConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainLayoutExists(ctx, resourceName, &domainLayout),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDomainLayoutConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainLayoutExists(ctx, resourceName, &domainLayout),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccDomainLayoutConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainLayoutExists(ctx, resourceName, &domainLayout),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDomainLayoutExists(ctx context.Context, resourceName string, v *customerprofiles_sdkv2.GetDomainLayoutOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		domainName, layoutDefinitionName, err := tfcustomerprofiles.DomainLayoutParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		client := acctest.Provider.Meta().(*conns.AWSClient).CustomerProfilesClient()
+
+		output, err := tfcustomerprofiles.FindDomainLayoutByTwoPartKey(ctx, client, domainName, layoutDefinitionName)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDomainLayoutDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acctest.Provider.Meta().(*conns.AWSClient).CustomerProfilesClient()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_customerprofiles_domain_layout" {
+				continue
+			}
+
+			domainName, layoutDefinitionName, err := tfcustomerprofiles.DomainLayoutParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfcustomerprofiles.FindDomainLayoutByTwoPartKey(ctx, client, domainName, layoutDefinitionName)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Customer Profiles Domain Layout %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccDomainLayoutConfig_basic(rName, description, sectionTitle string) string {
+	return acctest.ConfigCompose(testAccDomainConfig_basic(rName, 120), fmt.Sprintf(`
+resource "aws_customerprofiles_domain_layout" "test" {
+  domain_name            = aws_customerprofiles_domain.test.domain_name
+  layout_definition_name = %[1]q
+  display_name           = %[1]q
+  description            = %[2]q
+  layout_type            = "PROFILE_EXPLORER"
+
+  layout = jsonencode({
+    sections = [{
+      title  = %[3]q
+      fields = ["FirstName", "LastName", "EmailAddress"]
+    }]
+  })
+}
+`, rName, description, sectionTitle))
+}
+
+func testAccDomainLayoutConfig_default(rName, description, sectionTitle string) string {
+	return acctest.ConfigCompose(testAccDomainConfig_basic(rName, 120), fmt.Sprintf(`
+resource "aws_customerprofiles_domain_layout" "test" {
+  domain_name            = aws_customerprofiles_domain.test.domain_name
+  layout_definition_name = %[1]q
+  display_name           = %[1]q
+  description            = %[2]q
+  layout_type            = "PROFILE_EXPLORER"
+  is_default             = true
+
+  layout = jsonencode({
+    sections = [{
+      title  = %[3]q
+      fields = ["FirstName", "LastName", "EmailAddress", "PhoneNumber"]
+    }]
+  })
+}
+`, rName, description, sectionTitle))
+}
+
+func testAccDomainLayoutConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccDomainConfig_basic(rName, 120), fmt.Sprintf(`
+resource "aws_customerprofiles_domain_layout" "test" {
+  domain_name            = aws_customerprofiles_domain.test.domain_name
+  layout_definition_name = %[1]q
+  display_name           = %[1]q
+  description            = "example"
+  layout_type            = "PROFILE_EXPLORER"
+
+  layout = jsonencode({
+    sections = [{
+      title  = "Overview"
+      fields = ["FirstName", "LastName"]
+    }]
+  })
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccDomainLayoutConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccDomainConfig_basic(rName, 120), fmt.Sprintf(`
+resource "aws_customerprofiles_domain_layout" "test" {
+  domain_name            = aws_customerprofiles_domain.test.domain_name
+  layout_definition_name = %[1]q
+  display_name           = %[1]q
+  description            = "example"
+  layout_type            = "PROFILE_EXPLORER"
+
+  layout = jsonencode({
+    sections = [{
+      title  = "Overview"
+      fields = ["FirstName", "LastName"]
+    }]
+  })
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
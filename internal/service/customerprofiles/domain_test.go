@@ -0,0 +1,349 @@
+package customerprofiles_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/customerprofiles"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcustomerprofiles "github.com/hashicorp/terraform-provider-aws/internal/service/customerprofiles"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCustomerProfilesDomain_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var domain customerprofiles.GetDomainOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfig_basic(rName, 120),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "profile", regexp.MustCompile(`domains/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "default_expiration_days", "120"),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "matching.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDomainConfig_basic(rName, 365),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain),
+					resource.TestCheckResourceAttr(resourceName, "default_expiration_days", "365"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesDomain_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var domain customerprofiles.GetDomainOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfig_basic(rName, 120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcustomerprofiles.ResourceDomain(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesDomain_full(t *testing.T) {
+	ctx := acctest.Context(t)
+	var domain customerprofiles.GetDomainOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfig_full(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain),
+					resource.TestCheckResourceAttrPair(resourceName, "dead_letter_queue_url", "aws_sqs_queue.test", "url"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_encryption_key", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "matching.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "matching.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "matching.0.auto_merging.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "matching.0.auto_merging.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "matching.0.auto_merging.0.conflict_resolution.0.conflict_resolving_model", "RECENCY"),
+					resource.TestCheckResourceAttr(resourceName, "matching.0.auto_merging.0.consolidation.0.matching_attributes_list.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "matching.0.auto_merging.0.consolidation.0.matching_attributes_list.0.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "matching.0.auto_merging.0.min_allowed_confidence_score_for_merging", "0.8"),
+					resource.TestCheckResourceAttrPair(resourceName, "matching.0.exporting_config.0.s3_exporting.0.s3_bucket_name", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "matching.0.exporting_config.0.s3_exporting.0.s3_key_name", "example"),
+					resource.TestCheckResourceAttr(resourceName, "matching.0.job_schedule.0.day_of_the_week", "SUNDAY"),
+					resource.TestCheckResourceAttr(resourceName, "matching.0.job_schedule.0.time", "18:00"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesDomain_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var domain customerprofiles.GetDomainOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDomainConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccDomainConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDomainExists(ctx context.Context, resourceName string, v *customerprofiles.GetDomainOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CustomerProfilesConn()
+
+		output, err := tfcustomerprofiles.FindDomainByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDomainDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CustomerProfilesConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_customerprofiles_domain" {
+				continue
+			}
+
+			_, err := tfcustomerprofiles.FindDomainByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Customer Profiles Domain %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccDomainConfig_basic(rName string, expirationDays int) string {
+	return fmt.Sprintf(`
+resource "aws_customerprofiles_domain" "test" {
+  domain_name             = %[1]q
+  default_expiration_days = %[2]d
+}
+`, rName, expirationDays)
+}
+
+func testAccDomainConfig_full(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "Customer Profiles SQS policy"
+      Effect = "Allow"
+      Action = ["sqs:SendMessage"]
+      Principal = {
+        Service = "profile.${data.aws_partition.current.dns_suffix}"
+      }
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "Customer Profiles S3 policy"
+      Effect = "Allow"
+      Action = ["s3:GetObject", "s3:PutObject", "s3:ListBucket"]
+      Principal = {
+        Service = "profile.${data.aws_partition.current.dns_suffix}"
+      }
+      Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+      Condition = {
+        StringEquals = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_customerprofiles_domain" "test" {
+  domain_name             = %[1]q
+  default_expiration_days = 365
+  dead_letter_queue_url   = aws_sqs_queue.test.url
+  default_encryption_key  = aws_kms_key.test.arn
+
+  matching {
+    enabled = true
+
+    auto_merging {
+      enabled                                  = true
+      min_allowed_confidence_score_for_merging = 0.8
+
+      conflict_resolution {
+        conflict_resolving_model = "RECENCY"
+      }
+
+      consolidation {
+        matching_attributes_list = [
+          ["PhoneNumber", "EmailAddress"],
+          ["BusinessName", "BusinessPhoneNumber"],
+        ]
+      }
+    }
+
+    exporting_config {
+      s3_exporting {
+        s3_bucket_name = aws_s3_bucket.test.bucket
+        s3_key_name    = "example"
+      }
+    }
+
+    job_schedule {
+      day_of_the_week = "SUNDAY"
+      time            = "18:00"
+    }
+  }
+
+  depends_on = [aws_s3_bucket_policy.test]
+}
+`, rName)
+}
+
+func testAccDomainConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_customerprofiles_domain" "test" {
+  domain_name             = %[1]q
+  default_expiration_days = 120
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccDomainConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_customerprofiles_domain" "test" {
+  domain_name             = %[1]q
+  default_expiration_days = 120
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
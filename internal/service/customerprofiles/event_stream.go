@@ -0,0 +1,242 @@
+package customerprofiles
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/customerprofiles"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_customerprofiles_event_stream", name="Event Stream")
+// @Tags(identifierAttribute="arn")
+func ResourceEventStream() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEventStreamCreate,
+		ReadWithoutTimeout:   resourceEventStreamRead,
+		UpdateWithoutTimeout: resourceEventStreamUpdate,
+		DeleteWithoutTimeout: resourceEventStreamDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destination_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"unhealthy_since": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"event_stream_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only alphanumeric, underscore (_), and hyphen (-) characters"),
+				),
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"uri": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceEventStreamCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CustomerProfilesConn()
+
+	domainName := d.Get("domain_name").(string)
+	eventStreamName := d.Get("event_stream_name").(string)
+	id := EventStreamCreateResourceID(domainName, eventStreamName)
+	input := &customerprofiles.CreateEventStreamInput{
+		DomainName:      aws.String(domainName),
+		EventStreamName: aws.String(eventStreamName),
+		Tags:            GetTagsIn(ctx),
+		Uri:             aws.String(d.Get("uri").(string)),
+	}
+
+	_, err := conn.CreateEventStreamWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Customer Profiles Event Stream (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceEventStreamRead(ctx, d, meta)
+}
+
+func resourceEventStreamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CustomerProfilesConn()
+
+	domainName, eventStreamName, err := EventStreamParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindEventStreamByTwoPartKey(ctx, conn, domainName, eventStreamName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Customer Profiles Event Stream (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Customer Profiles Event Stream (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.EventStreamArn)
+	if err := d.Set("destination_details", flattenEventStreamDestinationDetails(output.DestinationDetails)); err != nil {
+		return diag.Errorf("setting destination_details: %s", err)
+	}
+	d.Set("domain_name", output.DomainName)
+	d.Set("event_stream_name", eventStreamName)
+	d.Set("state", output.State)
+	if output.DestinationDetails != nil {
+		d.Set("uri", output.DestinationDetails.Uri)
+	}
+
+	SetTagsOut(ctx, output.Tags)
+
+	return nil
+}
+
+func resourceEventStreamUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceEventStreamRead(ctx, d, meta)
+}
+
+func resourceEventStreamDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CustomerProfilesConn()
+
+	domainName, eventStreamName, err := EventStreamParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Customer Profiles Event Stream: %s", d.Id())
+	_, err = conn.DeleteEventStreamWithContext(ctx, &customerprofiles.DeleteEventStreamInput{
+		DomainName:      aws.String(domainName),
+		EventStreamName: aws.String(eventStreamName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, customerprofiles.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Customer Profiles Event Stream (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const eventStreamResourceIDSeparator = "/"
+
+func EventStreamCreateResourceID(domainName, eventStreamName string) string {
+	parts := []string{domainName, eventStreamName}
+	id := strings.Join(parts, eventStreamResourceIDSeparator)
+
+	return id
+}
+
+func EventStreamParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, eventStreamResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected domain-name%[2]sevent-stream-name", id, eventStreamResourceIDSeparator)
+}
+
+func FindEventStreamByTwoPartKey(ctx context.Context, conn *customerprofiles.CustomerProfiles, domainName, eventStreamName string) (*customerprofiles.GetEventStreamOutput, error) {
+	input := &customerprofiles.GetEventStreamInput{
+		DomainName:      aws.String(domainName),
+		EventStreamName: aws.String(eventStreamName),
+	}
+
+	output, err := conn.GetEventStreamWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, customerprofiles.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func flattenEventStreamDestinationDetails(apiObject *customerprofiles.EventStreamDestinationDetails) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"status": aws.StringValue(apiObject.Status),
+	}
+
+	if v := apiObject.UnhealthySince; v != nil {
+		tfMap["unhealthy_since"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
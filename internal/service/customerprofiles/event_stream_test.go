@@ -0,0 +1,235 @@
+package customerprofiles_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/customerprofiles"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcustomerprofiles "github.com/hashicorp/terraform-provider-aws/internal/service/customerprofiles"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCustomerProfilesEventStream_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var eventStream customerprofiles.GetEventStreamOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_event_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventStreamDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventStreamConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEventStreamExists(ctx, resourceName, &eventStream),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "profile", regexp.MustCompile(`domains/.+/event-streams/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "destination_details.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "destination_details.0.status"),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_name", "aws_customerprofiles_domain.test", "domain_name"),
+					resource.TestCheckResourceAttr(resourceName, "event_stream_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "state"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "uri", "aws_kinesis_stream.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesEventStream_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var eventStream customerprofiles.GetEventStreamOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_event_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventStreamDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventStreamConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventStreamExists(ctx, resourceName, &eventStream),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcustomerprofiles.ResourceEventStream(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesEventStream_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var eventStream customerprofiles.GetEventStreamOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_event_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventStreamDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventStreamConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventStreamExists(ctx, resourceName, &eventStream),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEventStreamConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventStreamExists(ctx, resourceName, &eventStream),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccEventStreamConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventStreamExists(ctx, resourceName, &eventStream),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEventStreamExists(ctx context.Context, resourceName string, v *customerprofiles.GetEventStreamOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		domainName, eventStreamName, err := tfcustomerprofiles.EventStreamParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CustomerProfilesConn()
+
+		output, err := tfcustomerprofiles.FindEventStreamByTwoPartKey(ctx, conn, domainName, eventStreamName)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckEventStreamDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CustomerProfilesConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_customerprofiles_event_stream" {
+				continue
+			}
+
+			domainName, eventStreamName, err := tfcustomerprofiles.EventStreamParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfcustomerprofiles.FindEventStreamByTwoPartKey(ctx, conn, domainName, eventStreamName)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Customer Profiles Event Stream %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccEventStreamConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_customerprofiles_domain" "test" {
+  domain_name             = %[1]q
+  default_expiration_days = 365
+}
+
+resource "aws_kinesis_stream" "test" {
+  name        = %[1]q
+  shard_count = 1
+}
+`, rName)
+}
+
+func testAccEventStreamConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEventStreamConfig_base(rName), fmt.Sprintf(`
+resource "aws_customerprofiles_event_stream" "test" {
+  domain_name       = aws_customerprofiles_domain.test.domain_name
+  event_stream_name = %[1]q
+  uri               = aws_kinesis_stream.test.arn
+}
+`, rName))
+}
+
+func testAccEventStreamConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccEventStreamConfig_base(rName), fmt.Sprintf(`
+resource "aws_customerprofiles_event_stream" "test" {
+  domain_name       = aws_customerprofiles_domain.test.domain_name
+  event_stream_name = %[1]q
+  uri               = aws_kinesis_stream.test.arn
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccEventStreamConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccEventStreamConfig_base(rName), fmt.Sprintf(`
+resource "aws_customerprofiles_event_stream" "test" {
+  domain_name       = aws_customerprofiles_domain.test.domain_name
+  event_stream_name = %[1]q
+  uri               = aws_kinesis_stream.test.arn
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
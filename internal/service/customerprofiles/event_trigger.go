@@ -0,0 +1,537 @@
+package customerprofiles
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	customerprofiles_sdkv2 "github.com/aws/aws-sdk-go-v2/service/customerprofiles"
+	"github.com/aws/aws-sdk-go-v2/service/customerprofiles/types"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_customerprofiles_event_trigger", name="Event Trigger")
+// @Tags(identifierAttribute="arn")
+func ResourceEventTrigger() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEventTriggerCreate,
+		ReadWithoutTimeout:   resourceEventTriggerRead,
+		UpdateWithoutTimeout: resourceEventTriggerUpdate,
+		DeleteWithoutTimeout: resourceEventTriggerDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"event_trigger_condition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_trigger_dimension": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 10,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"object_attribute": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"comparison_operator": {
+													Type:             schema.TypeString,
+													Required:         true,
+													ValidateDiagFunc: enum.Validate[types.ComparisonOperator](),
+												},
+												"field_name": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 64),
+												},
+												"source": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1000),
+												},
+												"values": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													MaxItems: 10,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validation.StringLenBetween(1, 255),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"logical_operator": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.EventTriggerLogicalOperator](),
+						},
+					},
+				},
+			},
+			"event_trigger_limits": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_expiration": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"period": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 4,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_invocations_per_profile": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 1000),
+									},
+									"unit": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.PeriodUnit](),
+									},
+									"unlimited": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"value": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 24),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"event_trigger_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_][a-zA-Z_0-9-]*$`), "must start with a letter or underscore and contain only alphanumeric, underscore (_), and hyphen (-) characters"),
+				),
+			},
+			"object_type_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_][a-zA-Z_0-9-]*$`), "must start with a letter or underscore and contain only alphanumeric, underscore (_), and hyphen (-) characters"),
+				),
+			},
+			"segment_filter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceEventTriggerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).CustomerProfilesClient()
+
+	domainName := d.Get("domain_name").(string)
+	eventTriggerName := d.Get("event_trigger_name").(string)
+	id := EventTriggerCreateResourceID(domainName, eventTriggerName)
+	input := &customerprofiles_sdkv2.CreateEventTriggerInput{
+		DomainName:             aws_sdkv2.String(domainName),
+		EventTriggerConditions: expandEventTriggerConditions(d.Get("event_trigger_condition").([]interface{})),
+		EventTriggerName:       aws_sdkv2.String(eventTriggerName),
+		ObjectTypeName:         aws_sdkv2.String(d.Get("object_type_name").(string)),
+		Tags:                   aws_sdkv2.ToStringMap(GetTagsIn(ctx)),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws_sdkv2.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("event_trigger_limits"); ok {
+		input.EventTriggerLimits = expandEventTriggerLimits(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("segment_filter"); ok {
+		input.SegmentFilter = aws_sdkv2.String(v.(string))
+	}
+
+	_, err := client.CreateEventTrigger(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Customer Profiles Event Trigger (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceEventTriggerRead(ctx, d, meta)
+}
+
+func resourceEventTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).CustomerProfilesClient()
+
+	domainName, eventTriggerName, err := EventTriggerParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindEventTriggerByTwoPartKey(ctx, client, domainName, eventTriggerName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Customer Profiles Event Trigger (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Customer Profiles Event Trigger (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Partition: meta.(*conns.AWSClient).Partition,
+		Region:    meta.(*conns.AWSClient).Region,
+		Resource:  fmt.Sprintf("domains/%s/event-triggers/%s", domainName, eventTriggerName),
+		Service:   "profile",
+	}.String()
+	d.Set(names.AttrARN, arn)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("domain_name", domainName)
+	if err := d.Set("event_trigger_condition", flattenEventTriggerConditions(output.EventTriggerConditions)); err != nil {
+		return diag.Errorf("setting event_trigger_condition: %s", err)
+	}
+	if err := d.Set("event_trigger_limits", flattenEventTriggerLimits(output.EventTriggerLimits)); err != nil {
+		return diag.Errorf("setting event_trigger_limits: %s", err)
+	}
+	d.Set("event_trigger_name", output.EventTriggerName)
+	d.Set("object_type_name", output.ObjectTypeName)
+	d.Set("segment_filter", output.SegmentFilter)
+
+	SetTagsOut(ctx, aws_sdkv2.StringMap(output.Tags))
+
+	return nil
+}
+
+func resourceEventTriggerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).CustomerProfilesClient()
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		domainName, eventTriggerName, err := EventTriggerParseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &customerprofiles_sdkv2.UpdateEventTriggerInput{
+			DomainName:             aws_sdkv2.String(domainName),
+			EventTriggerConditions: expandEventTriggerConditions(d.Get("event_trigger_condition").([]interface{})),
+			EventTriggerName:       aws_sdkv2.String(eventTriggerName),
+			ObjectTypeName:         aws_sdkv2.String(d.Get("object_type_name").(string)),
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws_sdkv2.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange("event_trigger_limits") {
+			// An empty limits object removes the limits.
+			input.EventTriggerLimits = &types.EventTriggerLimits{}
+
+			if v, ok := d.GetOk("event_trigger_limits"); ok {
+				input.EventTriggerLimits = expandEventTriggerLimits(v.([]interface{}))
+			}
+		}
+
+		if d.HasChange("segment_filter") {
+			input.SegmentFilter = aws_sdkv2.String(d.Get("segment_filter").(string))
+		}
+
+		_, err = client.UpdateEventTrigger(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Customer Profiles Event Trigger (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceEventTriggerRead(ctx, d, meta)
+}
+
+func resourceEventTriggerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).CustomerProfilesClient()
+
+	domainName, eventTriggerName, err := EventTriggerParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Customer Profiles Event Trigger: %s", d.Id())
+	_, err = client.DeleteEventTrigger(ctx, &customerprofiles_sdkv2.DeleteEventTriggerInput{
+		DomainName:       aws_sdkv2.String(domainName),
+		EventTriggerName: aws_sdkv2.String(eventTriggerName),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Customer Profiles Event Trigger (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const eventTriggerResourceIDSeparator = "/"
+
+func EventTriggerCreateResourceID(domainName, eventTriggerName string) string {
+	parts := []string{domainName, eventTriggerName}
+	id := strings.Join(parts, eventTriggerResourceIDSeparator)
+
+	return id
+}
+
+func EventTriggerParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, eventTriggerResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected domain-name%[2]sevent-trigger-name", id, eventTriggerResourceIDSeparator)
+}
+
+func FindEventTriggerByTwoPartKey(ctx context.Context, client *customerprofiles_sdkv2.Client, domainName, eventTriggerName string) (*customerprofiles_sdkv2.GetEventTriggerOutput, error) {
+	input := &customerprofiles_sdkv2.GetEventTriggerInput{
+		DomainName:       aws_sdkv2.String(domainName),
+		EventTriggerName: aws_sdkv2.String(eventTriggerName),
+	}
+
+	output, err := client.GetEventTrigger(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandEventTriggerConditions(tfList []interface{}) []types.EventTriggerCondition {
+	apiObjects := make([]types.EventTriggerCondition, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.EventTriggerCondition{
+			LogicalOperator: types.EventTriggerLogicalOperator(tfMap["logical_operator"].(string)),
+		}
+
+		for _, tfMapRaw := range tfMap["event_trigger_dimension"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.EventTriggerDimensions = append(apiObject.EventTriggerDimensions, types.EventTriggerDimension{
+				ObjectAttributes: expandObjectAttributes(tfMap["object_attribute"].([]interface{})),
+			})
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandObjectAttributes(tfList []interface{}) []types.ObjectAttribute {
+	apiObjects := make([]types.ObjectAttribute, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.ObjectAttribute{
+			ComparisonOperator: types.ComparisonOperator(tfMap["comparison_operator"].(string)),
+			Values:             flex.ExpandStringValueList(tfMap["values"].([]interface{})),
+		}
+
+		if v, ok := tfMap["field_name"].(string); ok && v != "" {
+			apiObject.FieldName = aws_sdkv2.String(v)
+		}
+
+		if v, ok := tfMap["source"].(string); ok && v != "" {
+			apiObject.Source = aws_sdkv2.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandEventTriggerLimits(tfList []interface{}) *types.EventTriggerLimits {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.EventTriggerLimits{}
+
+	if v, ok := tfMap["event_expiration"].(int); ok && v != 0 {
+		apiObject.EventExpiration = aws_sdkv2.Int64(int64(v))
+	}
+
+	for _, tfMapRaw := range tfMap["period"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		period := types.Period{
+			Unit:      types.PeriodUnit(tfMap["unit"].(string)),
+			Unlimited: tfMap["unlimited"].(bool),
+			Value:     aws_sdkv2.Int32(int32(tfMap["value"].(int))),
+		}
+
+		if v, ok := tfMap["max_invocations_per_profile"].(int); ok && v != 0 {
+			period.MaxInvocationsPerProfile = aws_sdkv2.Int32(int32(v))
+		}
+
+		apiObject.Periods = append(apiObject.Periods, period)
+	}
+
+	return apiObject
+}
+
+func flattenEventTriggerConditions(apiObjects []types.EventTriggerCondition) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		dimensions := make([]interface{}, 0, len(apiObject.EventTriggerDimensions))
+
+		for _, apiObject := range apiObject.EventTriggerDimensions {
+			dimensions = append(dimensions, map[string]interface{}{
+				"object_attribute": flattenObjectAttributes(apiObject.ObjectAttributes),
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"event_trigger_dimension": dimensions,
+			"logical_operator":        string(apiObject.LogicalOperator),
+		})
+	}
+
+	return tfList
+}
+
+func flattenObjectAttributes(apiObjects []types.ObjectAttribute) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"comparison_operator": string(apiObject.ComparisonOperator),
+			"field_name":          aws_sdkv2.ToString(apiObject.FieldName),
+			"source":              aws_sdkv2.ToString(apiObject.Source),
+			"values":              apiObject.Values,
+		})
+	}
+
+	return tfList
+}
+
+func flattenEventTriggerLimits(apiObject *types.EventTriggerLimits) []interface{} {
+	if apiObject == nil || (apiObject.EventExpiration == nil && len(apiObject.Periods) == 0) {
+		return nil
+	}
+
+	periods := make([]interface{}, 0, len(apiObject.Periods))
+
+	for _, apiObject := range apiObject.Periods {
+		periods = append(periods, map[string]interface{}{
+			"max_invocations_per_profile": aws_sdkv2.ToInt32(apiObject.MaxInvocationsPerProfile),
+			"unit":                        string(apiObject.Unit),
+			"unlimited":                   apiObject.Unlimited,
+			"value":                       aws_sdkv2.ToInt32(apiObject.Value),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"event_expiration": aws_sdkv2.ToInt64(apiObject.EventExpiration),
+		"period":           periods,
+	}}
+}
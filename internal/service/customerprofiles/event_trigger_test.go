@@ -0,0 +1,350 @@
+package customerprofiles_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	customerprofiles_sdkv2 "github.com/aws/aws-sdk-go-v2/service/customerprofiles"
+	"github.com/aws/aws-sdk-go/service/customerprofiles"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcustomerprofiles "github.com/hashicorp/terraform-provider-aws/internal/service/customerprofiles"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCustomerProfilesEventTrigger_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var eventTrigger customerprofiles_sdkv2.GetEventTriggerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_event_trigger.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventTriggerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventTriggerConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEventTriggerExists(ctx, resourceName, &eventTrigger),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "profile", regexp.MustCompile(`domains/.+/event-triggers/.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_name", "aws_customerprofiles_domain.test", "domain_name"),
+					resource.TestCheckResourceAttr(resourceName, "event_trigger_condition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_trigger_condition.0.logical_operator", "ANY"),
+					resource.TestCheckResourceAttr(resourceName, "event_trigger_condition.0.event_trigger_dimension.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_trigger_condition.0.event_trigger_dimension.0.object_attribute.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_trigger_condition.0.event_trigger_dimension.0.object_attribute.0.comparison_operator", "GREATER_THAN"),
+					resource.TestCheckResourceAttr(resourceName, "event_trigger_condition.0.event_trigger_dimension.0.object_attribute.0.field_name", "Amount"),
+					resource.TestCheckResourceAttr(resourceName, "event_trigger_condition.0.event_trigger_dimension.0.object_attribute.0.values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_trigger_condition.0.event_trigger_dimension.0.object_attribute.0.values.0", "100"),
+					resource.TestCheckResourceAttr(resourceName, "event_trigger_limits.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "event_trigger_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "object_type_name", "aws_customerprofiles_profile_object_type.test", "object_type_name"),
+					resource.TestCheckResourceAttr(resourceName, "segment_filter", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesEventTrigger_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var eventTrigger customerprofiles_sdkv2.GetEventTriggerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_event_trigger.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventTriggerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventTriggerConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventTriggerExists(ctx, resourceName, &eventTrigger),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcustomerprofiles.ResourceEventTrigger(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesEventTrigger_limits(t *testing.T) {
+	ctx := acctest.Context(t)
+	var eventTrigger customerprofiles_sdkv2.GetEventTriggerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_event_trigger.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventTriggerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventTriggerConfig_limits(rName, "initial description", 1, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEventTriggerExists(ctx, resourceName, &eventTrigger),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "initial description"),
+					resource.TestCheckResourceAttr(resourceName, "event_trigger_limits.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_trigger_limits.0.event_expiration", "60000"),
+					resource.TestCheckResourceAttr(resourceName, "event_trigger_limits.0.period.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_trigger_limits.0.period.0.max_invocations_per_profile", "5"),
+					resource.TestCheckResourceAttr(resourceName, "event_trigger_limits.0.period.0.unit", "HOURS"),
+					resource.TestCheckResourceAttr(resourceName, "event_trigger_limits.0.period.0.unlimited", "false"),
+					resource.TestCheckResourceAttr(resourceName, "event_trigger_limits.0.period.0.value", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEventTriggerConfig_limits(rName, "updated description", 2, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEventTriggerExists(ctx, resourceName, &eventTrigger),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated description"),
+					resource.TestCheckResourceAttr(resourceName, "event_trigger_limits.0.period.0.max_invocations_per_profile", "10"),
+					resource.TestCheckResourceAttr(resourceName, "event_trigger_limits.0.period.0.value", "2"),
+				),
+			},
+			{
+				Config: testAccEventTriggerConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEventTriggerExists(ctx, resourceName, &eventTrigger),
+					resource.TestCheckResourceAttr(resourceName, "event_trigger_limits.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesEventTrigger_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var eventTrigger customerprofiles_sdkv2.GetEventTriggerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_event_trigger.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventTriggerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventTriggerConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventTriggerExists(ctx, resourceName, &eventTrigger),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEventTriggerConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventTriggerExists(ctx, resourceName, &eventTrigger),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccEventTriggerConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventTriggerExists(ctx, resourceName, &eventTrigger),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEventTriggerExists(ctx context.Context, resourceName string, v *customerprofiles_sdkv2.GetEventTriggerOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		domainName, eventTriggerName, err := tfcustomerprofiles.EventTriggerParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		client := acctest.Provider.Meta().(*conns.AWSClient).CustomerProfilesClient()
+
+		output, err := tfcustomerprofiles.FindEventTriggerByTwoPartKey(ctx, client, domainName, eventTriggerName)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckEventTriggerDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acctest.Provider.Meta().(*conns.AWSClient).CustomerProfilesClient()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_customerprofiles_event_trigger" {
+				continue
+			}
+
+			domainName, eventTriggerName, err := tfcustomerprofiles.EventTriggerParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfcustomerprofiles.FindEventTriggerByTwoPartKey(ctx, client, domainName, eventTriggerName)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Customer Profiles Event Trigger %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccEventTriggerConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCalculatedAttributeDefinitionConfig_base(rName), fmt.Sprintf(`
+resource "aws_customerprofiles_event_trigger" "test" {
+  domain_name        = aws_customerprofiles_domain.test.domain_name
+  event_trigger_name = %[1]q
+  object_type_name   = aws_customerprofiles_profile_object_type.test.object_type_name
+
+  event_trigger_condition {
+    logical_operator = "ANY"
+
+    event_trigger_dimension {
+      object_attribute {
+        comparison_operator = "GREATER_THAN"
+        field_name          = "Amount"
+        values              = ["100"]
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccEventTriggerConfig_limits(rName, description string, value, maxInvocations int) string {
+	return acctest.ConfigCompose(testAccCalculatedAttributeDefinitionConfig_base(rName), fmt.Sprintf(`
+resource "aws_customerprofiles_event_trigger" "test" {
+  domain_name        = aws_customerprofiles_domain.test.domain_name
+  event_trigger_name = %[1]q
+  object_type_name   = aws_customerprofiles_profile_object_type.test.object_type_name
+  description        = %[2]q
+
+  event_trigger_condition {
+    logical_operator = "ANY"
+
+    event_trigger_dimension {
+      object_attribute {
+        comparison_operator = "GREATER_THAN"
+        field_name          = "Amount"
+        values              = ["100"]
+      }
+    }
+  }
+
+  event_trigger_limits {
+    event_expiration = 60000
+
+    period {
+      unit                        = "HOURS"
+      value                       = %[3]d
+      max_invocations_per_profile = %[4]d
+    }
+  }
+}
+`, rName, description, value, maxInvocations))
+}
+
+func testAccEventTriggerConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccCalculatedAttributeDefinitionConfig_base(rName), fmt.Sprintf(`
+resource "aws_customerprofiles_event_trigger" "test" {
+  domain_name        = aws_customerprofiles_domain.test.domain_name
+  event_trigger_name = %[1]q
+  object_type_name   = aws_customerprofiles_profile_object_type.test.object_type_name
+
+  event_trigger_condition {
+    logical_operator = "ANY"
+
+    event_trigger_dimension {
+      object_attribute {
+        comparison_operator = "GREATER_THAN"
+        field_name          = "Amount"
+        values              = ["100"]
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccEventTriggerConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccCalculatedAttributeDefinitionConfig_base(rName), fmt.Sprintf(`
+resource "aws_customerprofiles_event_trigger" "test" {
+  domain_name        = aws_customerprofiles_domain.test.domain_name
+  event_trigger_name = %[1]q
+  object_type_name   = aws_customerprofiles_profile_object_type.test.object_type_name
+
+  event_trigger_condition {
+    logical_operator = "ANY"
+
+    event_trigger_dimension {
+      object_attribute {
+        comparison_operator = "GREATER_THAN"
+        field_name          = "Amount"
+        values              = ["100"]
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
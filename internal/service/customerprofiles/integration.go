@@ -0,0 +1,735 @@
+package customerprofiles
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/customerprofiles"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The integration has no ARN of its own, so tags are only ever sent with PutIntegration.
+
+// @SDKResource("aws_customerprofiles_integration", name="Integration")
+// @Tags
+func ResourceIntegration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIntegrationCreate,
+		ReadWithoutTimeout:   resourceIntegrationRead,
+		UpdateWithoutTimeout: resourceIntegrationUpdate,
+		DeleteWithoutTimeout: resourceIntegrationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"flow_definition": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"flow_definition", "uri"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDescription: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 2048),
+						},
+						"flow_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"kms_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"source_flow_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"connector_profile_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"connector_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(customerprofiles.SourceConnectorType_Values(), false),
+									},
+									"incremental_pull_config": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"datetime_type_field_name": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 256),
+												},
+											},
+										},
+									},
+									"source_connector_properties": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"marketo":     integrationSourceObjectSchema(),
+												"s3":          integrationS3SourceSchema(),
+												"salesforce":  integrationSalesforceSourceSchema(),
+												"service_now": integrationSourceObjectSchema(),
+												"zendesk":     integrationSourceObjectSchema(),
+											},
+										},
+									},
+								},
+							},
+						},
+						"task": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"connector_operator": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"marketo": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(customerprofiles.MarketoConnectorOperator_Values(), false),
+												},
+												"s3": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(customerprofiles.S3ConnectorOperator_Values(), false),
+												},
+												"salesforce": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(customerprofiles.SalesforceConnectorOperator_Values(), false),
+												},
+												"service_now": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(customerprofiles.ServiceNowConnectorOperator_Values(), false),
+												},
+												"zendesk": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(customerprofiles.ZendeskConnectorOperator_Values(), false),
+												},
+											},
+										},
+									},
+									"destination_field": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 256),
+									},
+									"source_fields": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(0, 2048),
+										},
+									},
+									"task_properties": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(0, 255),
+										},
+									},
+									"task_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(customerprofiles.TaskType_Values(), false),
+									},
+								},
+							},
+						},
+						"trigger_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"trigger_properties": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"scheduled": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"data_pull_mode": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.StringInSlice(customerprofiles.DataPullMode_Values(), false),
+															},
+															"first_execution_from": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.IsRFC3339Time,
+															},
+															"schedule_end_time": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.IsRFC3339Time,
+															},
+															"schedule_expression": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 256),
+															},
+															"schedule_offset": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																ValidateFunc: validation.IntBetween(0, 36000),
+															},
+															"schedule_start_time": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.IsRFC3339Time,
+															},
+															"timezone": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.StringLenBetween(0, 256),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"trigger_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(customerprofiles.TriggerType_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"object_type_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"object_type_name", "object_type_names"},
+			},
+			"object_type_names": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"uri": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"workflow_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func integrationSourceObjectSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 512),
+				},
+			},
+		},
+	}
+}
+
+func integrationS3SourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bucket_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(3, 63),
+				},
+				"bucket_prefix": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 512),
+				},
+			},
+		},
+	}
+}
+
+func integrationSalesforceSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enable_dynamic_field_update": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+				"include_deleted_records": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+				"object": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 512),
+				},
+			},
+		},
+	}
+}
+
+func resourceIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CustomerProfilesConn()
+
+	domainName := d.Get("domain_name").(string)
+	input := expandPutIntegrationInput(d)
+	input.Tags = GetTagsIn(ctx)
+
+	output, err := conn.PutIntegrationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Customer Profiles Integration (%s): %s", domainName, err)
+	}
+
+	d.SetId(IntegrationCreateResourceID(domainName, aws.StringValue(output.Uri)))
+
+	return resourceIntegrationRead(ctx, d, meta)
+}
+
+func resourceIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CustomerProfilesConn()
+
+	domainName, uri, err := IntegrationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindIntegrationByTwoPartKey(ctx, conn, domainName, uri)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Customer Profiles Integration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Customer Profiles Integration (%s): %s", d.Id(), err)
+	}
+
+	// The flow definition is not returned by GetIntegration and is kept as configured.
+	d.Set("domain_name", output.DomainName)
+	d.Set("object_type_name", output.ObjectTypeName)
+	d.Set("object_type_names", aws.StringValueMap(output.ObjectTypeNames))
+	d.Set("uri", output.Uri)
+	d.Set("workflow_id", output.WorkflowId)
+
+	SetTagsOut(ctx, output.Tags)
+
+	return nil
+}
+
+func resourceIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CustomerProfilesConn()
+
+	// PutIntegration is an upsert; resend the full definition, tags included.
+	input := expandPutIntegrationInput(d)
+	input.Tags = GetTagsIn(ctx)
+
+	_, err := conn.PutIntegrationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating Customer Profiles Integration (%s): %s", d.Id(), err)
+	}
+
+	return resourceIntegrationRead(ctx, d, meta)
+}
+
+func resourceIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CustomerProfilesConn()
+
+	domainName, uri, err := IntegrationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Customer Profiles Integration: %s", d.Id())
+	_, err = conn.DeleteIntegrationWithContext(ctx, &customerprofiles.DeleteIntegrationInput{
+		DomainName: aws.String(domainName),
+		Uri:        aws.String(uri),
+	})
+
+	if tfawserr.ErrCodeEquals(err, customerprofiles.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Customer Profiles Integration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const integrationResourceIDSeparator = "/"
+
+func IntegrationCreateResourceID(domainName, uri string) string {
+	parts := []string{domainName, uri}
+	id := strings.Join(parts, integrationResourceIDSeparator)
+
+	return id
+}
+
+// IntegrationParseResourceID splits on the first separator only, as the URI is usually an ARN that can itself contain slashes.
+func IntegrationParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, integrationResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected domain-name%[2]suri", id, integrationResourceIDSeparator)
+}
+
+func FindIntegrationByTwoPartKey(ctx context.Context, conn *customerprofiles.CustomerProfiles, domainName, uri string) (*customerprofiles.GetIntegrationOutput, error) {
+	input := &customerprofiles.GetIntegrationInput{
+		DomainName: aws.String(domainName),
+		Uri:        aws.String(uri),
+	}
+
+	output, err := conn.GetIntegrationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, customerprofiles.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandPutIntegrationInput(d *schema.ResourceData) *customerprofiles.PutIntegrationInput {
+	input := &customerprofiles.PutIntegrationInput{
+		DomainName: aws.String(d.Get("domain_name").(string)),
+	}
+
+	if v, ok := d.GetOk("flow_definition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.FlowDefinition = expandFlowDefinition(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("object_type_name"); ok {
+		input.ObjectTypeName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("object_type_names"); ok && len(v.(map[string]interface{})) > 0 {
+		input.ObjectTypeNames = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	// The URI of a flow-backed integration is the flow ARN returned on creation.
+	if v, ok := d.GetOk("uri"); ok && input.FlowDefinition == nil {
+		input.Uri = aws.String(v.(string))
+	}
+
+	return input
+}
+
+func expandFlowDefinition(tfMap map[string]interface{}) *customerprofiles.FlowDefinition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &customerprofiles.FlowDefinition{
+		FlowName: aws.String(tfMap["flow_name"].(string)),
+		KmsArn:   aws.String(tfMap["kms_arn"].(string)),
+		Tasks:    expandTasks(tfMap["task"].([]interface{})),
+	}
+
+	if v, ok := tfMap[names.AttrDescription].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["source_flow_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SourceFlowConfig = expandSourceFlowConfig(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["trigger_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.TriggerConfig = expandTriggerConfig(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandSourceFlowConfig(tfMap map[string]interface{}) *customerprofiles.SourceFlowConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &customerprofiles.SourceFlowConfig{
+		ConnectorType: aws.String(tfMap["connector_type"].(string)),
+	}
+
+	if v, ok := tfMap["connector_profile_name"].(string); ok && v != "" {
+		apiObject.ConnectorProfileName = aws.String(v)
+	}
+
+	if v, ok := tfMap["incremental_pull_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.IncrementalPullConfig = &customerprofiles.IncrementalPullConfig{}
+
+		if v, ok := v[0].(map[string]interface{})["datetime_type_field_name"].(string); ok && v != "" {
+			apiObject.IncrementalPullConfig.DatetimeTypeFieldName = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["source_connector_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SourceConnectorProperties = expandSourceConnectorProperties(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandSourceConnectorProperties(tfMap map[string]interface{}) *customerprofiles.SourceConnectorProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &customerprofiles.SourceConnectorProperties{}
+
+	if v, ok := tfMap["marketo"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Marketo = &customerprofiles.MarketoSourceProperties{
+			Object: aws.String(v[0].(map[string]interface{})["object"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.S3 = &customerprofiles.S3SourceProperties{
+			BucketName: aws.String(tfMap["bucket_name"].(string)),
+		}
+
+		if v, ok := tfMap["bucket_prefix"].(string); ok && v != "" {
+			apiObject.S3.BucketPrefix = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["salesforce"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Salesforce = &customerprofiles.SalesforceSourceProperties{
+			EnableDynamicFieldUpdate: aws.Bool(tfMap["enable_dynamic_field_update"].(bool)),
+			IncludeDeletedRecords:    aws.Bool(tfMap["include_deleted_records"].(bool)),
+			Object:                   aws.String(tfMap["object"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["service_now"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ServiceNow = &customerprofiles.ServiceNowSourceProperties{
+			Object: aws.String(v[0].(map[string]interface{})["object"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["zendesk"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Zendesk = &customerprofiles.ZendeskSourceProperties{
+			Object: aws.String(v[0].(map[string]interface{})["object"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandTasks(tfList []interface{}) []*customerprofiles.Task {
+	var apiObjects []*customerprofiles.Task
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &customerprofiles.Task{
+			SourceFields: flex.ExpandStringList(tfMap["source_fields"].([]interface{})),
+			TaskType:     aws.String(tfMap["task_type"].(string)),
+		}
+
+		if v, ok := tfMap["connector_operator"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ConnectorOperator = expandConnectorOperator(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["destination_field"].(string); ok && v != "" {
+			apiObject.DestinationField = aws.String(v)
+		}
+
+		if v, ok := tfMap["task_properties"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.TaskProperties = flex.ExpandStringMap(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandConnectorOperator(tfMap map[string]interface{}) *customerprofiles.ConnectorOperator {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &customerprofiles.ConnectorOperator{}
+
+	if v, ok := tfMap["marketo"].(string); ok && v != "" {
+		apiObject.Marketo = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3"].(string); ok && v != "" {
+		apiObject.S3 = aws.String(v)
+	}
+
+	if v, ok := tfMap["salesforce"].(string); ok && v != "" {
+		apiObject.Salesforce = aws.String(v)
+	}
+
+	if v, ok := tfMap["service_now"].(string); ok && v != "" {
+		apiObject.ServiceNow = aws.String(v)
+	}
+
+	if v, ok := tfMap["zendesk"].(string); ok && v != "" {
+		apiObject.Zendesk = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTriggerConfig(tfMap map[string]interface{}) *customerprofiles.TriggerConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &customerprofiles.TriggerConfig{
+		TriggerType: aws.String(tfMap["trigger_type"].(string)),
+	}
+
+	if v, ok := tfMap["trigger_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.TriggerProperties = &customerprofiles.TriggerProperties{}
+
+		if v, ok := v[0].(map[string]interface{})["scheduled"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.TriggerProperties.Scheduled = expandScheduledTriggerProperties(v[0].(map[string]interface{}))
+		}
+	}
+
+	return apiObject
+}
+
+func expandScheduledTriggerProperties(tfMap map[string]interface{}) *customerprofiles.ScheduledTriggerProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &customerprofiles.ScheduledTriggerProperties{
+		ScheduleExpression: aws.String(tfMap["schedule_expression"].(string)),
+	}
+
+	if v, ok := tfMap["data_pull_mode"].(string); ok && v != "" {
+		apiObject.DataPullMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["first_execution_from"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+
+		apiObject.FirstExecutionFrom = aws.Time(v)
+	}
+
+	if v, ok := tfMap["schedule_end_time"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+
+		apiObject.ScheduleEndTime = aws.Time(v)
+	}
+
+	if v, ok := tfMap["schedule_offset"].(int); ok && v != 0 {
+		apiObject.ScheduleOffset = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["schedule_start_time"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+
+		apiObject.ScheduleStartTime = aws.Time(v)
+	}
+
+	if v, ok := tfMap["timezone"].(string); ok && v != "" {
+		apiObject.Timezone = aws.String(v)
+	}
+
+	return apiObject
+}
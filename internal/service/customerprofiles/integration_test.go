@@ -0,0 +1,312 @@
+package customerprofiles_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/customerprofiles"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcustomerprofiles "github.com/hashicorp/terraform-provider-aws/internal/service/customerprofiles"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCustomerProfilesIntegration_connect(t *testing.T) {
+	ctx := acctest.Context(t)
+	var integration customerprofiles.GetIntegrationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rInstanceAlias := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_customerprofiles_integration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationConfig_connect(rName, rInstanceAlias, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIntegrationExists(ctx, resourceName, &integration),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_name", "aws_customerprofiles_domain.test", "domain_name"),
+					resource.TestCheckResourceAttr(resourceName, "flow_definition.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "object_type_name", "aws_customerprofiles_profile_object_type.test", "object_type_name"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttrPair(resourceName, "uri", "aws_connect_instance.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIntegrationConfig_connect(rName, rInstanceAlias, "key1", "value1updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIntegrationExists(ctx, resourceName, &integration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesIntegration_flowDefinition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var integration customerprofiles.GetIntegrationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_integration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationConfig_flowDefinition(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIntegrationExists(ctx, resourceName, &integration),
+					resource.TestCheckResourceAttr(resourceName, "flow_definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "flow_definition.0.flow_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "flow_definition.0.source_flow_config.0.connector_type", "S3"),
+					resource.TestCheckResourceAttr(resourceName, "flow_definition.0.trigger_config.0.trigger_type", "OnDemand"),
+					resource.TestCheckResourceAttrSet(resourceName, "uri"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"flow_definition"},
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesIntegration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var integration customerprofiles.GetIntegrationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rInstanceAlias := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_customerprofiles_integration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationConfig_connect(rName, rInstanceAlias, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExists(ctx, resourceName, &integration),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcustomerprofiles.ResourceIntegration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIntegrationExists(ctx context.Context, resourceName string, v *customerprofiles.GetIntegrationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		domainName, uri, err := tfcustomerprofiles.IntegrationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CustomerProfilesConn()
+
+		output, err := tfcustomerprofiles.FindIntegrationByTwoPartKey(ctx, conn, domainName, uri)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckIntegrationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CustomerProfilesConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_customerprofiles_integration" {
+				continue
+			}
+
+			domainName, uri, err := tfcustomerprofiles.IntegrationParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfcustomerprofiles.FindIntegrationByTwoPartKey(ctx, conn, domainName, uri)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Customer Profiles Integration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccIntegrationConfig_connect(rName, rInstanceAlias, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_connect_instance" "test" {
+  identity_management_type = "CONNECT_MANAGED"
+  inbound_calls_enabled    = true
+  instance_alias           = %[2]q
+  outbound_calls_enabled   = true
+}
+
+resource "aws_customerprofiles_domain" "test" {
+  domain_name             = %[1]q
+  default_expiration_days = 365
+}
+
+resource "aws_customerprofiles_profile_object_type" "test" {
+  domain_name      = aws_customerprofiles_domain.test.domain_name
+  object_type_name = "CTR"
+  description      = "Contact trace records"
+  template_id      = "CTR-NoInferred"
+}
+
+resource "aws_customerprofiles_integration" "test" {
+  domain_name      = aws_customerprofiles_domain.test.domain_name
+  object_type_name = aws_customerprofiles_profile_object_type.test.object_type_name
+  uri              = aws_connect_instance.test.arn
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, rInstanceAlias, tagKey1, tagValue1)
+}
+
+func testAccIntegrationConfig_flowDefinition(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "AllowAppFlowSourceActions"
+      Effect = "Allow"
+      Action = ["s3:ListBucket", "s3:GetObject"]
+      Principal = {
+        Service = "appflow.${data.aws_partition.current.dns_suffix}"
+      }
+      Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+    }]
+  })
+}
+
+resource "aws_customerprofiles_domain" "test" {
+  domain_name             = %[1]q
+  default_expiration_days = 365
+}
+
+resource "aws_customerprofiles_profile_object_type" "test" {
+  domain_name      = aws_customerprofiles_domain.test.domain_name
+  object_type_name = %[1]q
+  description      = "S3 customers"
+
+  field {
+    name = "email"
+
+    object_type_field {
+      content_type = "EMAIL_ADDRESS"
+      source       = "_source.email"
+      target       = "_profile.EmailAddress"
+    }
+  }
+
+  key {
+    name = "_email"
+
+    object_type_key {
+      field_names          = ["email"]
+      standard_identifiers = ["PROFILE", "UNIQUE"]
+    }
+  }
+}
+
+resource "aws_customerprofiles_integration" "test" {
+  domain_name      = aws_customerprofiles_domain.test.domain_name
+  object_type_name = aws_customerprofiles_profile_object_type.test.object_type_name
+
+  flow_definition {
+    flow_name = %[1]q
+    kms_arn   = aws_kms_key.test.arn
+
+    source_flow_config {
+      connector_type = "S3"
+
+      source_connector_properties {
+        s3 {
+          bucket_name = aws_s3_bucket.test.bucket
+        }
+      }
+    }
+
+    task {
+      destination_field = "email"
+      source_fields     = ["email"]
+      task_type         = "Map"
+
+      connector_operator {
+        s3 = "NO_OP"
+      }
+
+      task_properties = {
+        "SOURCE_DATA_TYPE"      = "string"
+        "DESTINATION_DATA_TYPE" = "string"
+      }
+    }
+
+    trigger_config {
+      trigger_type = "OnDemand"
+    }
+  }
+
+  depends_on = [aws_s3_bucket_policy.test]
+}
+`, rName)
+}
@@ -0,0 +1,484 @@
+package customerprofiles
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/customerprofiles"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_customerprofiles_profile_object_type", name="Profile Object Type")
+// @Tags(identifierAttribute="arn")
+func ResourceProfileObjectType() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProfileObjectTypeCreate,
+		ReadWithoutTimeout:   resourceProfileObjectTypeRead,
+		UpdateWithoutTimeout: resourceProfileObjectTypeUpdate,
+		DeleteWithoutTimeout: resourceProfileObjectTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allow_profile_creation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"encryption_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"expiration_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 1098),
+			},
+			"field": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"object_type_field": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"content_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(customerprofiles.FieldContentType_Values(), false),
+									},
+									"source": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1000),
+									},
+									"target": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1000),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"key": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"object_type_key": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field_names": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 64),
+										},
+									},
+									"standard_identifiers": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(customerprofiles.StandardIdentifier_Values(), false),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"object_type_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_][a-zA-Z_0-9-]*$`), "must start with a letter or underscore and contain only alphanumeric, underscore (_), and hyphen (-) characters"),
+				),
+			},
+			"source_last_updated_timestamp_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"template_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceProfileObjectTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CustomerProfilesConn()
+
+	domainName := d.Get("domain_name").(string)
+	objectTypeName := d.Get("object_type_name").(string)
+	id := ProfileObjectTypeCreateResourceID(domainName, objectTypeName)
+	input := expandPutProfileObjectTypeInput(d)
+	input.Tags = GetTagsIn(ctx)
+
+	_, err := conn.PutProfileObjectTypeWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Customer Profiles Profile Object Type (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceProfileObjectTypeRead(ctx, d, meta)
+}
+
+func resourceProfileObjectTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CustomerProfilesConn()
+
+	domainName, objectTypeName, err := ProfileObjectTypeParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindProfileObjectTypeByTwoPartKey(ctx, conn, domainName, objectTypeName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Customer Profiles Profile Object Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Customer Profiles Profile Object Type (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Partition: meta.(*conns.AWSClient).Partition,
+		Region:    meta.(*conns.AWSClient).Region,
+		Resource:  fmt.Sprintf("domains/%s/object-types/%s", domainName, objectTypeName),
+		Service:   "profile",
+	}.String()
+	d.Set("allow_profile_creation", output.AllowProfileCreation)
+	d.Set(names.AttrARN, arn)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("domain_name", domainName)
+	d.Set("encryption_key", output.EncryptionKey)
+	d.Set("expiration_days", output.ExpirationDays)
+	if err := d.Set("field", flattenObjectTypeFields(output.Fields)); err != nil {
+		return diag.Errorf("setting field: %s", err)
+	}
+	if err := d.Set("key", flattenObjectTypeKeys(output.Keys)); err != nil {
+		return diag.Errorf("setting key: %s", err)
+	}
+	d.Set("object_type_name", output.ObjectTypeName)
+	d.Set("source_last_updated_timestamp_format", output.SourceLastUpdatedTimestampFormat)
+	d.Set("template_id", output.TemplateId)
+
+	SetTagsOut(ctx, output.Tags)
+
+	return nil
+}
+
+func resourceProfileObjectTypeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CustomerProfilesConn()
+
+	// PutProfileObjectType replaces the whole definition, so every argument is sent on update.
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		_, err := conn.PutProfileObjectTypeWithContext(ctx, expandPutProfileObjectTypeInput(d))
+
+		if err != nil {
+			return diag.Errorf("updating Customer Profiles Profile Object Type (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceProfileObjectTypeRead(ctx, d, meta)
+}
+
+func resourceProfileObjectTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CustomerProfilesConn()
+
+	domainName, objectTypeName, err := ProfileObjectTypeParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Customer Profiles Profile Object Type: %s", d.Id())
+	_, err = conn.DeleteProfileObjectTypeWithContext(ctx, &customerprofiles.DeleteProfileObjectTypeInput{
+		DomainName:     aws.String(domainName),
+		ObjectTypeName: aws.String(objectTypeName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, customerprofiles.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Customer Profiles Profile Object Type (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const profileObjectTypeResourceIDSeparator = "/"
+
+func ProfileObjectTypeCreateResourceID(domainName, objectTypeName string) string {
+	parts := []string{domainName, objectTypeName}
+	id := strings.Join(parts, profileObjectTypeResourceIDSeparator)
+
+	return id
+}
+
+func ProfileObjectTypeParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, profileObjectTypeResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected domain-name%[2]sobject-type-name", id, profileObjectTypeResourceIDSeparator)
+}
+
+func FindProfileObjectTypeByTwoPartKey(ctx context.Context, conn *customerprofiles.CustomerProfiles, domainName, objectTypeName string) (*customerprofiles.GetProfileObjectTypeOutput, error) {
+	input := &customerprofiles.GetProfileObjectTypeInput{
+		DomainName:     aws.String(domainName),
+		ObjectTypeName: aws.String(objectTypeName),
+	}
+
+	output, err := conn.GetProfileObjectTypeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, customerprofiles.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandPutProfileObjectTypeInput(d *schema.ResourceData) *customerprofiles.PutProfileObjectTypeInput {
+	input := &customerprofiles.PutProfileObjectTypeInput{
+		AllowProfileCreation: aws.Bool(d.Get("allow_profile_creation").(bool)),
+		Description:          aws.String(d.Get(names.AttrDescription).(string)),
+		DomainName:           aws.String(d.Get("domain_name").(string)),
+		ObjectTypeName:       aws.String(d.Get("object_type_name").(string)),
+	}
+
+	if v, ok := d.GetOk("encryption_key"); ok {
+		input.EncryptionKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("expiration_days"); ok {
+		input.ExpirationDays = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("field"); ok && v.(*schema.Set).Len() > 0 {
+		input.Fields = expandObjectTypeFields(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("key"); ok && v.(*schema.Set).Len() > 0 {
+		input.Keys = expandObjectTypeKeys(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("source_last_updated_timestamp_format"); ok {
+		input.SourceLastUpdatedTimestampFormat = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("template_id"); ok {
+		input.TemplateId = aws.String(v.(string))
+	}
+
+	return input
+}
+
+func expandObjectTypeFields(tfList []interface{}) map[string]*customerprofiles.ObjectTypeField {
+	apiObjects := make(map[string]*customerprofiles.ObjectTypeField)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		v, ok := tfMap["object_type_field"].([]interface{})
+
+		if !ok || len(v) == 0 || v[0] == nil {
+			continue
+		}
+
+		field := v[0].(map[string]interface{})
+		apiObject := &customerprofiles.ObjectTypeField{}
+
+		if v, ok := field["content_type"].(string); ok && v != "" {
+			apiObject.ContentType = aws.String(v)
+		}
+
+		if v, ok := field["source"].(string); ok && v != "" {
+			apiObject.Source = aws.String(v)
+		}
+
+		if v, ok := field["target"].(string); ok && v != "" {
+			apiObject.Target = aws.String(v)
+		}
+
+		apiObjects[tfMap["name"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandObjectTypeKeys(tfList []interface{}) map[string][]*customerprofiles.ObjectTypeKey {
+	apiObjects := make(map[string][]*customerprofiles.ObjectTypeKey)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		var keys []*customerprofiles.ObjectTypeKey
+
+		for _, keyRaw := range tfMap["object_type_key"].(*schema.Set).List() {
+			key, ok := keyRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject := &customerprofiles.ObjectTypeKey{}
+
+			if v, ok := key["field_names"].([]interface{}); ok && len(v) > 0 {
+				apiObject.FieldNames = flex.ExpandStringList(v)
+			}
+
+			if v, ok := key["standard_identifiers"].(*schema.Set); ok && v.Len() > 0 {
+				apiObject.StandardIdentifiers = flex.ExpandStringSet(v)
+			}
+
+			keys = append(keys, apiObject)
+		}
+
+		apiObjects[tfMap["name"].(string)] = keys
+	}
+
+	return apiObjects
+}
+
+func flattenObjectTypeFields(apiObjects map[string]*customerprofiles.ObjectTypeField) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for name, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name": name,
+			"object_type_field": []interface{}{map[string]interface{}{
+				"content_type": aws.StringValue(apiObject.ContentType),
+				"source":       aws.StringValue(apiObject.Source),
+				"target":       aws.StringValue(apiObject.Target),
+			}},
+		})
+	}
+
+	return tfList
+}
+
+func flattenObjectTypeKeys(apiObjects map[string][]*customerprofiles.ObjectTypeKey) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for name, keys := range apiObjects {
+		tfKeys := make([]interface{}, 0, len(keys))
+
+		for _, apiObject := range keys {
+			if apiObject == nil {
+				continue
+			}
+
+			tfKeys = append(tfKeys, map[string]interface{}{
+				"field_names":          aws.StringValueSlice(apiObject.FieldNames),
+				"standard_identifiers": aws.StringValueSlice(apiObject.StandardIdentifiers),
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":            name,
+			"object_type_key": tfKeys,
+		})
+	}
+
+	return tfList
+}
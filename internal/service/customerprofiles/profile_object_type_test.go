@@ -0,0 +1,242 @@
+package customerprofiles_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/customerprofiles"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcustomerprofiles "github.com/hashicorp/terraform-provider-aws/internal/service/customerprofiles"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCustomerProfilesProfileObjectType_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var objectType customerprofiles.GetProfileObjectTypeOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_profile_object_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileObjectTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileObjectTypeConfig_basic(rName, "initial description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileObjectTypeExists(ctx, resourceName, &objectType),
+					resource.TestCheckResourceAttr(resourceName, "allow_profile_creation", "true"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "profile", regexp.MustCompile(`domains/.+/object-types/.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "initial description"),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_name", "aws_customerprofiles_domain.test", "domain_name"),
+					resource.TestCheckResourceAttr(resourceName, "field.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "field.*", map[string]string{
+						"name":                             "email",
+						"object_type_field.0.content_type": "EMAIL_ADDRESS",
+						"object_type_field.0.source":       "_source.email",
+						"object_type_field.0.target":       "_profile.EmailAddress",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "key.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "key.*", map[string]string{
+						"name":              "_email",
+						"object_type_key.#": "1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "object_type_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProfileObjectTypeConfig_basic(rName, "updated description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileObjectTypeExists(ctx, resourceName, &objectType),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated description"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesProfileObjectType_template(t *testing.T) {
+	ctx := acctest.Context(t)
+	var objectType customerprofiles.GetProfileObjectTypeOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_profile_object_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileObjectTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileObjectTypeConfig_template(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileObjectTypeExists(ctx, resourceName, &objectType),
+					resource.TestCheckResourceAttrSet(resourceName, "field.#"),
+					resource.TestCheckResourceAttrSet(resourceName, "key.#"),
+					resource.TestCheckResourceAttr(resourceName, "template_id", "Salesforce-Account"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesProfileObjectType_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var objectType customerprofiles.GetProfileObjectTypeOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_profile_object_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileObjectTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileObjectTypeConfig_basic(rName, "initial description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfileObjectTypeExists(ctx, resourceName, &objectType),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcustomerprofiles.ResourceProfileObjectType(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckProfileObjectTypeExists(ctx context.Context, resourceName string, v *customerprofiles.GetProfileObjectTypeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		domainName, objectTypeName, err := tfcustomerprofiles.ProfileObjectTypeParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CustomerProfilesConn()
+
+		output, err := tfcustomerprofiles.FindProfileObjectTypeByTwoPartKey(ctx, conn, domainName, objectTypeName)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckProfileObjectTypeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CustomerProfilesConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_customerprofiles_profile_object_type" {
+				continue
+			}
+
+			domainName, objectTypeName, err := tfcustomerprofiles.ProfileObjectTypeParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfcustomerprofiles.FindProfileObjectTypeByTwoPartKey(ctx, conn, domainName, objectTypeName)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Customer Profiles Profile Object Type %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccProfileObjectTypeConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_customerprofiles_domain" "test" {
+  domain_name             = %[1]q
+  default_expiration_days = 365
+}
+`, rName)
+}
+
+func testAccProfileObjectTypeConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccProfileObjectTypeConfig_base(rName), fmt.Sprintf(`
+resource "aws_customerprofiles_profile_object_type" "test" {
+  domain_name            = aws_customerprofiles_domain.test.domain_name
+  object_type_name       = %[1]q
+  description            = %[2]q
+  allow_profile_creation = true
+
+  field {
+    name = "email"
+
+    object_type_field {
+      content_type = "EMAIL_ADDRESS"
+      source       = "_source.email"
+      target       = "_profile.EmailAddress"
+    }
+  }
+
+  field {
+    name = "name"
+
+    object_type_field {
+      content_type = "NAME"
+      source       = "_source.name"
+      target       = "_profile.FirstName"
+    }
+  }
+
+  key {
+    name = "_email"
+
+    object_type_key {
+      field_names          = ["email"]
+      standard_identifiers = ["PROFILE", "UNIQUE"]
+    }
+  }
+}
+`, rName, description))
+}
+
+func testAccProfileObjectTypeConfig_template(rName string) string {
+	return acctest.ConfigCompose(testAccProfileObjectTypeConfig_base(rName), fmt.Sprintf(`
+resource "aws_customerprofiles_profile_object_type" "test" {
+  domain_name      = aws_customerprofiles_domain.test.domain_name
+  object_type_name = %[1]q
+  description      = "Salesforce account"
+  template_id      = "Salesforce-Account"
+}
+`, rName))
+}
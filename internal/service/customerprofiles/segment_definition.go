@@ -0,0 +1,923 @@
+package customerprofiles
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	customerprofiles_sdkv2 "github.com/aws/aws-sdk-go-v2/service/customerprofiles"
+	"github.com/aws/aws-sdk-go-v2/service/customerprofiles/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_customerprofiles_segment_definition", name="Segment Definition")
+// @Tags(identifierAttribute="arn")
+func ResourceSegmentDefinition() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSegmentDefinitionCreate,
+		ReadWithoutTimeout:   resourceSegmentDefinitionRead,
+		UpdateWithoutTimeout: resourceSegmentDefinitionUpdate,
+		DeleteWithoutTimeout: resourceSegmentDefinitionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"segment_definition_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_][a-zA-Z_0-9-]*$`), "must start with a letter or underscore and contain only alphanumeric, underscore (_), and hyphen (-) characters"),
+				),
+			},
+			"segment_groups": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"segment_groups", "segment_sql_query"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimension": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"calculated_attribute": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													ForceNew: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"condition_overrides": {
+																Type:     schema.TypeList,
+																Optional: true,
+																ForceNew: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"range": {
+																			Type:     schema.TypeList,
+																			Required: true,
+																			ForceNew: true,
+																			MaxItems: 1,
+																			Elem: &schema.Resource{
+																				Schema: map[string]*schema.Schema{
+																					"end": {
+																						Type:     schema.TypeInt,
+																						Optional: true,
+																						ForceNew: true,
+																					},
+																					"start": {
+																						Type:     schema.TypeInt,
+																						Required: true,
+																						ForceNew: true,
+																					},
+																					"unit": {
+																						Type:             schema.TypeString,
+																						Required:         true,
+																						ForceNew:         true,
+																						ValidateDiagFunc: enum.Validate[types.RangeUnit](),
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+															"dimension_type": {
+																Type:             schema.TypeString,
+																Required:         true,
+																ForceNew:         true,
+																ValidateDiagFunc: enum.Validate[types.AttributeDimensionType](),
+															},
+															names.AttrName: {
+																Type:     schema.TypeString,
+																Required: true,
+																ForceNew: true,
+															},
+															"values": segmentDimensionValuesSchema(),
+														},
+													},
+												},
+												"profile_attributes": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"account_number":         segmentProfileDimensionSchema(enum.Validate[types.StringDimensionType]()),
+															"additional_information": segmentProfileDimensionSchema(enum.Validate[types.StringDimensionType]()),
+															"address":                segmentAddressDimensionSchema(),
+															"attribute": {
+																Type:     schema.TypeSet,
+																Optional: true,
+																ForceNew: true,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"dimension_type": {
+																			Type:             schema.TypeString,
+																			Required:         true,
+																			ForceNew:         true,
+																			ValidateDiagFunc: enum.Validate[types.AttributeDimensionType](),
+																		},
+																		names.AttrName: {
+																			Type:     schema.TypeString,
+																			Required: true,
+																			ForceNew: true,
+																		},
+																		"values": segmentDimensionValuesSchema(),
+																	},
+																},
+															},
+															"billing_address":        segmentAddressDimensionSchema(),
+															"birth_date":             segmentProfileDimensionSchema(enum.Validate[types.DateDimensionType]()),
+															"business_email_address": segmentProfileDimensionSchema(enum.Validate[types.StringDimensionType]()),
+															"business_name":          segmentProfileDimensionSchema(enum.Validate[types.StringDimensionType]()),
+															"business_phone_number":  segmentProfileDimensionSchema(enum.Validate[types.StringDimensionType]()),
+															"email_address":          segmentProfileDimensionSchema(enum.Validate[types.StringDimensionType]()),
+															"first_name":             segmentProfileDimensionSchema(enum.Validate[types.StringDimensionType]()),
+															"gender_string":          segmentProfileDimensionSchema(enum.Validate[types.StringDimensionType]()),
+															"home_phone_number":      segmentProfileDimensionSchema(enum.Validate[types.StringDimensionType]()),
+															"last_name":              segmentProfileDimensionSchema(enum.Validate[types.StringDimensionType]()),
+															"mailing_address":        segmentAddressDimensionSchema(),
+															"middle_name":            segmentProfileDimensionSchema(enum.Validate[types.StringDimensionType]()),
+															"mobile_phone_number":    segmentProfileDimensionSchema(enum.Validate[types.StringDimensionType]()),
+															"party_type_string":      segmentProfileDimensionSchema(enum.Validate[types.StringDimensionType]()),
+															"personal_email_address": segmentProfileDimensionSchema(enum.Validate[types.StringDimensionType]()),
+															"phone_number":           segmentProfileDimensionSchema(enum.Validate[types.StringDimensionType]()),
+															"profile_type":           segmentProfileDimensionSchema(enum.Validate[types.ProfileTypeDimensionType]()),
+															"shipping_address":       segmentAddressDimensionSchema(),
+														},
+													},
+												},
+											},
+										},
+									},
+									"source_segment": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"segment_definition_name": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"source_type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.IncludeOptions](),
+									},
+									"type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.IncludeOptions](),
+									},
+								},
+							},
+						},
+						"include": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.IncludeOptions](),
+						},
+					},
+				},
+			},
+			"segment_sort": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"data_type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.SegmentSortDataType](),
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"order": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.SegmentSortOrder](),
+									},
+									"type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.SortAttributeType](),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"segment_sql_query": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50000),
+			},
+			"segment_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func segmentDimensionValuesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		MinItems: 1,
+		MaxItems: 50,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringLenBetween(1, 255),
+		},
+	}
+}
+
+func segmentProfileDimensionSchema(validateDimensionType schema.SchemaValidateDiagFunc) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"dimension_type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					ValidateDiagFunc: validateDimensionType,
+				},
+				"values": segmentDimensionValuesSchema(),
+			},
+		},
+	}
+}
+
+func segmentAddressDimensionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"city":        segmentProfileDimensionSchema(enum.Validate[types.StringDimensionType]()),
+				"country":     segmentProfileDimensionSchema(enum.Validate[types.StringDimensionType]()),
+				"county":      segmentProfileDimensionSchema(enum.Validate[types.StringDimensionType]()),
+				"postal_code": segmentProfileDimensionSchema(enum.Validate[types.StringDimensionType]()),
+				"province":    segmentProfileDimensionSchema(enum.Validate[types.StringDimensionType]()),
+				"state":       segmentProfileDimensionSchema(enum.Validate[types.StringDimensionType]()),
+			},
+		},
+	}
+}
+
+func resourceSegmentDefinitionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).CustomerProfilesClient()
+
+	domainName := d.Get("domain_name").(string)
+	segmentDefinitionName := d.Get("segment_definition_name").(string)
+	id := SegmentDefinitionCreateResourceID(domainName, segmentDefinitionName)
+	input := &customerprofiles_sdkv2.CreateSegmentDefinitionInput{
+		DisplayName:           aws_sdkv2.String(d.Get("display_name").(string)),
+		DomainName:            aws_sdkv2.String(domainName),
+		SegmentDefinitionName: aws_sdkv2.String(segmentDefinitionName),
+		Tags:                  aws_sdkv2.ToStringMap(GetTagsIn(ctx)),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws_sdkv2.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("segment_groups"); ok {
+		input.SegmentGroups = expandSegmentGroup(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("segment_sort"); ok {
+		input.SegmentSort = expandSegmentSort(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("segment_sql_query"); ok {
+		input.SegmentSqlQuery = aws_sdkv2.String(v.(string))
+	}
+
+	_, err := client.CreateSegmentDefinition(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Customer Profiles Segment Definition (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceSegmentDefinitionRead(ctx, d, meta)
+}
+
+func resourceSegmentDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).CustomerProfilesClient()
+
+	domainName, segmentDefinitionName, err := SegmentDefinitionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindSegmentDefinitionByTwoPartKey(ctx, client, domainName, segmentDefinitionName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Customer Profiles Segment Definition (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Customer Profiles Segment Definition (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.SegmentDefinitionArn)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("display_name", output.DisplayName)
+	d.Set("domain_name", domainName)
+	d.Set("segment_definition_name", output.SegmentDefinitionName)
+	if err := d.Set("segment_groups", flattenSegmentGroup(output.SegmentGroups)); err != nil {
+		return diag.Errorf("setting segment_groups: %s", err)
+	}
+	if err := d.Set("segment_sort", flattenSegmentSort(output.SegmentSort)); err != nil {
+		return diag.Errorf("setting segment_sort: %s", err)
+	}
+	d.Set("segment_sql_query", output.SegmentSqlQuery)
+	d.Set("segment_type", output.SegmentType)
+
+	SetTagsOut(ctx, aws_sdkv2.StringMap(output.Tags))
+
+	return nil
+}
+
+func resourceSegmentDefinitionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceSegmentDefinitionRead(ctx, d, meta)
+}
+
+func resourceSegmentDefinitionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient).CustomerProfilesClient()
+
+	domainName, segmentDefinitionName, err := SegmentDefinitionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Customer Profiles Segment Definition: %s", d.Id())
+	_, err = client.DeleteSegmentDefinition(ctx, &customerprofiles_sdkv2.DeleteSegmentDefinitionInput{
+		DomainName:            aws_sdkv2.String(domainName),
+		SegmentDefinitionName: aws_sdkv2.String(segmentDefinitionName),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Customer Profiles Segment Definition (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const segmentDefinitionResourceIDSeparator = "/"
+
+func SegmentDefinitionCreateResourceID(domainName, segmentDefinitionName string) string {
+	parts := []string{domainName, segmentDefinitionName}
+	id := strings.Join(parts, segmentDefinitionResourceIDSeparator)
+
+	return id
+}
+
+func SegmentDefinitionParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, segmentDefinitionResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected domain-name%[2]ssegment-definition-name", id, segmentDefinitionResourceIDSeparator)
+}
+
+func FindSegmentDefinitionByTwoPartKey(ctx context.Context, client *customerprofiles_sdkv2.Client, domainName, segmentDefinitionName string) (*customerprofiles_sdkv2.GetSegmentDefinitionOutput, error) {
+	input := &customerprofiles_sdkv2.GetSegmentDefinitionInput{
+		DomainName:            aws_sdkv2.String(domainName),
+		SegmentDefinitionName: aws_sdkv2.String(segmentDefinitionName),
+	}
+
+	output, err := client.GetSegmentDefinition(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandSegmentGroup(tfList []interface{}) *types.SegmentGroup {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.SegmentGroup{}
+
+	if v, ok := tfMap["include"].(string); ok && v != "" {
+		apiObject.Include = types.IncludeOptions(v)
+	}
+
+	for _, tfMapRaw := range tfMap["group"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		group := types.Group{}
+
+		for _, tfMapRaw := range tfMap["dimension"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			if v, ok := tfMap["profile_attributes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				group.Dimensions = append(group.Dimensions, &types.DimensionMemberProfileAttributes{
+					Value: expandProfileAttributes(v[0].(map[string]interface{})),
+				})
+			}
+
+			if v, ok := tfMap["calculated_attribute"].(*schema.Set); ok && v.Len() > 0 {
+				group.Dimensions = append(group.Dimensions, &types.DimensionMemberCalculatedAttributes{
+					Value: expandCalculatedAttributeDimensions(v.List()),
+				})
+			}
+		}
+
+		for _, tfMapRaw := range tfMap["source_segment"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			group.SourceSegments = append(group.SourceSegments, types.SourceSegment{
+				SegmentDefinitionName: aws_sdkv2.String(tfMap["segment_definition_name"].(string)),
+			})
+		}
+
+		if v, ok := tfMap["source_type"].(string); ok && v != "" {
+			group.SourceType = types.IncludeOptions(v)
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			group.Type = types.IncludeOptions(v)
+		}
+
+		apiObject.Groups = append(apiObject.Groups, group)
+	}
+
+	return apiObject
+}
+
+func expandProfileAttributes(tfMap map[string]interface{}) types.ProfileAttributes {
+	apiObject := types.ProfileAttributes{
+		AccountNumber:        expandProfileDimension(tfMap["account_number"].([]interface{})),
+		Address:              expandAddressDimension(tfMap["address"].([]interface{})),
+		BillingAddress:       expandAddressDimension(tfMap["billing_address"].([]interface{})),
+		BusinessEmailAddress: expandProfileDimension(tfMap["business_email_address"].([]interface{})),
+		BusinessName:         expandProfileDimension(tfMap["business_name"].([]interface{})),
+		BusinessPhoneNumber:  expandProfileDimension(tfMap["business_phone_number"].([]interface{})),
+		EmailAddress:         expandProfileDimension(tfMap["email_address"].([]interface{})),
+		FirstName:            expandProfileDimension(tfMap["first_name"].([]interface{})),
+		GenderString:         expandProfileDimension(tfMap["gender_string"].([]interface{})),
+		HomePhoneNumber:      expandProfileDimension(tfMap["home_phone_number"].([]interface{})),
+		LastName:             expandProfileDimension(tfMap["last_name"].([]interface{})),
+		MailingAddress:       expandAddressDimension(tfMap["mailing_address"].([]interface{})),
+		MiddleName:           expandProfileDimension(tfMap["middle_name"].([]interface{})),
+		MobilePhoneNumber:    expandProfileDimension(tfMap["mobile_phone_number"].([]interface{})),
+		PartyTypeString:      expandProfileDimension(tfMap["party_type_string"].([]interface{})),
+		PersonalEmailAddress: expandProfileDimension(tfMap["personal_email_address"].([]interface{})),
+		PhoneNumber:          expandProfileDimension(tfMap["phone_number"].([]interface{})),
+		ShippingAddress:      expandAddressDimension(tfMap["shipping_address"].([]interface{})),
+	}
+
+	if v := expandProfileDimension(tfMap["additional_information"].([]interface{})); v != nil {
+		apiObject.AdditionalInformation = &types.ExtraLengthValueProfileDimension{
+			DimensionType: v.DimensionType,
+			Values:        v.Values,
+		}
+	}
+
+	if v, ok := tfMap["attribute"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Attributes = map[string]types.AttributeDimension{}
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Attributes[tfMap[names.AttrName].(string)] = types.AttributeDimension{
+				DimensionType: types.AttributeDimensionType(tfMap["dimension_type"].(string)),
+				Values:        flex.ExpandStringValueList(tfMap["values"].([]interface{})),
+			}
+		}
+	}
+
+	if v := expandProfileDimension(tfMap["birth_date"].([]interface{})); v != nil {
+		apiObject.BirthDate = &types.DateDimension{
+			DimensionType: types.DateDimensionType(v.DimensionType),
+			Values:        v.Values,
+		}
+	}
+
+	if v := expandProfileDimension(tfMap["profile_type"].([]interface{})); v != nil {
+		apiObject.ProfileType = &types.ProfileTypeDimension{
+			DimensionType: types.ProfileTypeDimensionType(v.DimensionType),
+		}
+
+		for _, v := range v.Values {
+			apiObject.ProfileType.Values = append(apiObject.ProfileType.Values, types.ProfileType(v))
+		}
+	}
+
+	return apiObject
+}
+
+func expandProfileDimension(tfList []interface{}) *types.ProfileDimension {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &types.ProfileDimension{
+		DimensionType: types.StringDimensionType(tfMap["dimension_type"].(string)),
+		Values:        flex.ExpandStringValueList(tfMap["values"].([]interface{})),
+	}
+}
+
+func expandAddressDimension(tfList []interface{}) *types.AddressDimension {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &types.AddressDimension{
+		City:       expandProfileDimension(tfMap["city"].([]interface{})),
+		Country:    expandProfileDimension(tfMap["country"].([]interface{})),
+		County:     expandProfileDimension(tfMap["county"].([]interface{})),
+		PostalCode: expandProfileDimension(tfMap["postal_code"].([]interface{})),
+		Province:   expandProfileDimension(tfMap["province"].([]interface{})),
+		State:      expandProfileDimension(tfMap["state"].([]interface{})),
+	}
+}
+
+func expandCalculatedAttributeDimensions(tfList []interface{}) map[string]types.CalculatedAttributeDimension {
+	apiObjects := map[string]types.CalculatedAttributeDimension{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.CalculatedAttributeDimension{
+			DimensionType: types.AttributeDimensionType(tfMap["dimension_type"].(string)),
+			Values:        flex.ExpandStringValueList(tfMap["values"].([]interface{})),
+		}
+
+		if v, ok := tfMap["condition_overrides"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.ConditionOverrides = &types.ConditionOverrides{}
+
+			if v, ok := tfMap["range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				apiObject.ConditionOverrides.Range = &types.RangeOverride{
+					End:   int32(tfMap["end"].(int)),
+					Start: aws_sdkv2.Int32(int32(tfMap["start"].(int))),
+					Unit:  types.RangeUnit(tfMap["unit"].(string)),
+				}
+			}
+		}
+
+		apiObjects[tfMap[names.AttrName].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandSegmentSort(tfList []interface{}) *types.SegmentSort {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.SegmentSort{}
+
+	for _, tfMapRaw := range tfMap["attribute"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		attribute := types.SortAttribute{
+			Name:  aws_sdkv2.String(tfMap[names.AttrName].(string)),
+			Order: types.SegmentSortOrder(tfMap["order"].(string)),
+		}
+
+		if v, ok := tfMap["data_type"].(string); ok && v != "" {
+			attribute.DataType = types.SegmentSortDataType(v)
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			attribute.Type = types.SortAttributeType(v)
+		}
+
+		apiObject.Attributes = append(apiObject.Attributes, attribute)
+	}
+
+	return apiObject
+}
+
+func flattenSegmentGroup(apiObject *types.SegmentGroup) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	groups := make([]interface{}, 0, len(apiObject.Groups))
+
+	for _, apiObject := range apiObject.Groups {
+		dimensions := make([]interface{}, 0, len(apiObject.Dimensions))
+
+		for _, apiObject := range apiObject.Dimensions {
+			switch v := apiObject.(type) {
+			case *types.DimensionMemberProfileAttributes:
+				dimensions = append(dimensions, map[string]interface{}{
+					"profile_attributes": []interface{}{flattenProfileAttributes(v.Value)},
+				})
+			case *types.DimensionMemberCalculatedAttributes:
+				dimensions = append(dimensions, map[string]interface{}{
+					"calculated_attribute": flattenCalculatedAttributeDimensions(v.Value),
+				})
+			}
+		}
+
+		sourceSegments := make([]interface{}, 0, len(apiObject.SourceSegments))
+
+		for _, apiObject := range apiObject.SourceSegments {
+			sourceSegments = append(sourceSegments, map[string]interface{}{
+				"segment_definition_name": aws_sdkv2.ToString(apiObject.SegmentDefinitionName),
+			})
+		}
+
+		groups = append(groups, map[string]interface{}{
+			"dimension":      dimensions,
+			"source_segment": sourceSegments,
+			"source_type":    string(apiObject.SourceType),
+			"type":           string(apiObject.Type),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"group":   groups,
+		"include": string(apiObject.Include),
+	}}
+}
+
+func flattenProfileAttributes(apiObject types.ProfileAttributes) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"account_number":         flattenProfileDimension(apiObject.AccountNumber),
+		"address":                flattenAddressDimension(apiObject.Address),
+		"billing_address":        flattenAddressDimension(apiObject.BillingAddress),
+		"business_email_address": flattenProfileDimension(apiObject.BusinessEmailAddress),
+		"business_name":          flattenProfileDimension(apiObject.BusinessName),
+		"business_phone_number":  flattenProfileDimension(apiObject.BusinessPhoneNumber),
+		"email_address":          flattenProfileDimension(apiObject.EmailAddress),
+		"first_name":             flattenProfileDimension(apiObject.FirstName),
+		"gender_string":          flattenProfileDimension(apiObject.GenderString),
+		"home_phone_number":      flattenProfileDimension(apiObject.HomePhoneNumber),
+		"last_name":              flattenProfileDimension(apiObject.LastName),
+		"mailing_address":        flattenAddressDimension(apiObject.MailingAddress),
+		"middle_name":            flattenProfileDimension(apiObject.MiddleName),
+		"mobile_phone_number":    flattenProfileDimension(apiObject.MobilePhoneNumber),
+		"party_type_string":      flattenProfileDimension(apiObject.PartyTypeString),
+		"personal_email_address": flattenProfileDimension(apiObject.PersonalEmailAddress),
+		"phone_number":           flattenProfileDimension(apiObject.PhoneNumber),
+		"shipping_address":       flattenAddressDimension(apiObject.ShippingAddress),
+	}
+
+	if v := apiObject.AdditionalInformation; v != nil {
+		tfMap["additional_information"] = []interface{}{map[string]interface{}{
+			"dimension_type": string(v.DimensionType),
+			"values":         v.Values,
+		}}
+	}
+
+	attributes := make([]interface{}, 0, len(apiObject.Attributes))
+
+	for name, v := range apiObject.Attributes {
+		attributes = append(attributes, map[string]interface{}{
+			"dimension_type": string(v.DimensionType),
+			names.AttrName:   name,
+			"values":         v.Values,
+		})
+	}
+
+	tfMap["attribute"] = attributes
+
+	if v := apiObject.BirthDate; v != nil {
+		tfMap["birth_date"] = []interface{}{map[string]interface{}{
+			"dimension_type": string(v.DimensionType),
+			"values":         v.Values,
+		}}
+	}
+
+	if v := apiObject.ProfileType; v != nil {
+		tfMap["profile_type"] = []interface{}{map[string]interface{}{
+			"dimension_type": string(v.DimensionType),
+			"values":         enum.Slice(v.Values...),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenProfileDimension(apiObject *types.ProfileDimension) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"dimension_type": string(apiObject.DimensionType),
+		"values":         apiObject.Values,
+	}}
+}
+
+func flattenAddressDimension(apiObject *types.AddressDimension) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"city":        flattenProfileDimension(apiObject.City),
+		"country":     flattenProfileDimension(apiObject.Country),
+		"county":      flattenProfileDimension(apiObject.County),
+		"postal_code": flattenProfileDimension(apiObject.PostalCode),
+		"province":    flattenProfileDimension(apiObject.Province),
+		"state":       flattenProfileDimension(apiObject.State),
+	}}
+}
+
+func flattenCalculatedAttributeDimensions(apiObjects map[string]types.CalculatedAttributeDimension) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for name, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"dimension_type": string(apiObject.DimensionType),
+			names.AttrName:   name,
+			"values":         apiObject.Values,
+		}
+
+		if v := apiObject.ConditionOverrides; v != nil && v.Range != nil {
+			tfMap["condition_overrides"] = []interface{}{map[string]interface{}{
+				"range": []interface{}{map[string]interface{}{
+					"end":   v.Range.End,
+					"start": aws_sdkv2.ToInt32(v.Range.Start),
+					"unit":  string(v.Range.Unit),
+				}},
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenSegmentSort(apiObject *types.SegmentSort) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	attributes := make([]interface{}, 0, len(apiObject.Attributes))
+
+	for _, apiObject := range apiObject.Attributes {
+		attributes = append(attributes, map[string]interface{}{
+			"data_type":    string(apiObject.DataType),
+			names.AttrName: aws_sdkv2.ToString(apiObject.Name),
+			"order":        string(apiObject.Order),
+			"type":         string(apiObject.Type),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"attribute": attributes,
+	}}
+}
@@ -0,0 +1,381 @@
+package customerprofiles_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	customerprofiles_sdkv2 "github.com/aws/aws-sdk-go-v2/service/customerprofiles"
+	"github.com/aws/aws-sdk-go/service/customerprofiles"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcustomerprofiles "github.com/hashicorp/terraform-provider-aws/internal/service/customerprofiles"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCustomerProfilesSegmentDefinition_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var segmentDefinition customerprofiles_sdkv2.GetSegmentDefinitionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_segment_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSegmentDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentDefinitionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSegmentDefinitionExists(ctx, resourceName, &segmentDefinition),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "profile", regexp.MustCompile(`domains/.+/segment-definitions/.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_name", "aws_customerprofiles_domain.test", "domain_name"),
+					resource.TestCheckResourceAttr(resourceName, "segment_definition_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "segment_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "segment_groups.0.include", "ALL"),
+					resource.TestCheckResourceAttr(resourceName, "segment_groups.0.group.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "segment_groups.0.group.0.dimension.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "segment_groups.0.group.0.dimension.0.profile_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "segment_groups.0.group.0.dimension.0.profile_attributes.0.first_name.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "segment_groups.0.group.0.dimension.0.profile_attributes.0.first_name.0.dimension_type", "INCLUSIVE"),
+					resource.TestCheckResourceAttr(resourceName, "segment_groups.0.group.0.dimension.0.profile_attributes.0.first_name.0.values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "segment_groups.0.group.0.dimension.0.profile_attributes.0.first_name.0.values.0", "Jane"),
+					resource.TestCheckResourceAttr(resourceName, "segment_groups.0.group.0.type", "ALL"),
+					resource.TestCheckResourceAttr(resourceName, "segment_sql_query", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesSegmentDefinition_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var segmentDefinition customerprofiles_sdkv2.GetSegmentDefinitionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_segment_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSegmentDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentDefinitionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentDefinitionExists(ctx, resourceName, &segmentDefinition),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcustomerprofiles.ResourceSegmentDefinition(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesSegmentDefinition_full(t *testing.T) {
+	ctx := acctest.Context(t)
+	var segmentDefinition customerprofiles_sdkv2.GetSegmentDefinitionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_segment_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSegmentDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentDefinitionConfig_full(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSegmentDefinitionExists(ctx, resourceName, &segmentDefinition),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "example"),
+					resource.TestCheckResourceAttr(resourceName, "segment_groups.0.include", "ANY"),
+					resource.TestCheckResourceAttr(resourceName, "segment_groups.0.group.0.dimension.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "segment_groups.0.group.0.dimension.0.profile_attributes.0.address.0.city.0.dimension_type", "INCLUSIVE"),
+					resource.TestCheckResourceAttr(resourceName, "segment_groups.0.group.0.dimension.0.profile_attributes.0.address.0.city.0.values.0", "Seattle"),
+					resource.TestCheckResourceAttr(resourceName, "segment_groups.0.group.0.dimension.0.profile_attributes.0.attribute.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "segment_groups.0.group.0.dimension.0.profile_attributes.0.attribute.*", map[string]string{
+						"dimension_type": "INCLUSIVE",
+						"name":           "tier",
+						"values.#":       "1",
+						"values.0":       "gold",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "segment_groups.0.group.0.dimension.1.calculated_attribute.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "segment_groups.0.group.0.dimension.1.calculated_attribute.*", map[string]string{
+						"dimension_type": "GREATER_THAN",
+						"name":           rName,
+						"values.#":       "1",
+						"values.0":       "1000",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "segment_groups.0.group.0.type", "ANY"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesSegmentDefinition_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var segmentDefinition customerprofiles_sdkv2.GetSegmentDefinitionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_customerprofiles_segment_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, customerprofiles.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSegmentDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentDefinitionConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentDefinitionExists(ctx, resourceName, &segmentDefinition),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSegmentDefinitionConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentDefinitionExists(ctx, resourceName, &segmentDefinition),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccSegmentDefinitionConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentDefinitionExists(ctx, resourceName, &segmentDefinition),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSegmentDefinitionExists(ctx context.Context, resourceName string, v *customerprofiles_sdkv2.GetSegmentDefinitionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		domainName, segmentDefinitionName, err := tfcustomerprofiles.SegmentDefinitionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		client := acctest.Provider.Meta().(*conns.AWSClient).CustomerProfilesClient()
+
+		output, err := tfcustomerprofiles.FindSegmentDefinitionByTwoPartKey(ctx, client, domainName, segmentDefinitionName)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSegmentDefinitionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acctest.Provider.Meta().(*conns.AWSClient).CustomerProfilesClient()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_customerprofiles_segment_definition" {
+				continue
+			}
+
+			domainName, segmentDefinitionName, err := tfcustomerprofiles.SegmentDefinitionParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfcustomerprofiles.FindSegmentDefinitionByTwoPartKey(ctx, client, domainName, segmentDefinitionName)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Customer Profiles Segment Definition %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSegmentDefinitionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDomainConfig_basic(rName, 120), fmt.Sprintf(`
+resource "aws_customerprofiles_segment_definition" "test" {
+  domain_name             = aws_customerprofiles_domain.test.domain_name
+  segment_definition_name = %[1]q
+  display_name            = %[1]q
+
+  segment_groups {
+    include = "ALL"
+
+    group {
+      type = "ALL"
+
+      dimension {
+        profile_attributes {
+          first_name {
+            dimension_type = "INCLUSIVE"
+            values         = ["Jane"]
+          }
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccSegmentDefinitionConfig_full(rName string) string {
+	return acctest.ConfigCompose(testAccCalculatedAttributeDefinitionConfig_base(rName), fmt.Sprintf(`
+resource "aws_customerprofiles_calculated_attribute_definition" "test" {
+  domain_name               = aws_customerprofiles_domain.test.domain_name
+  calculated_attribute_name = %[1]q
+  statistic                 = "SUM"
+
+  attribute_details {
+    attribute {
+      name = "Amount"
+    }
+
+    expression = "{Order.Amount}"
+  }
+
+  depends_on = [aws_customerprofiles_profile_object_type.test]
+}
+
+resource "aws_customerprofiles_segment_definition" "test" {
+  domain_name             = aws_customerprofiles_domain.test.domain_name
+  segment_definition_name = %[1]q
+  display_name            = %[1]q
+  description             = "example"
+
+  segment_groups {
+    include = "ANY"
+
+    group {
+      type = "ANY"
+
+      dimension {
+        profile_attributes {
+          address {
+            city {
+              dimension_type = "INCLUSIVE"
+              values         = ["Seattle"]
+            }
+          }
+
+          attribute {
+            name           = "tier"
+            dimension_type = "INCLUSIVE"
+            values         = ["gold"]
+          }
+        }
+      }
+
+      dimension {
+        calculated_attribute {
+          name           = aws_customerprofiles_calculated_attribute_definition.test.calculated_attribute_name
+          dimension_type = "GREATER_THAN"
+          values         = ["1000"]
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccSegmentDefinitionConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccDomainConfig_basic(rName, 120), fmt.Sprintf(`
+resource "aws_customerprofiles_segment_definition" "test" {
+  domain_name             = aws_customerprofiles_domain.test.domain_name
+  segment_definition_name = %[1]q
+  display_name            = %[1]q
+
+  segment_groups {
+    group {
+      dimension {
+        profile_attributes {
+          first_name {
+            dimension_type = "INCLUSIVE"
+            values         = ["Jane"]
+          }
+        }
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccSegmentDefinitionConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccDomainConfig_basic(rName, 120), fmt.Sprintf(`
+resource "aws_customerprofiles_segment_definition" "test" {
+  domain_name             = aws_customerprofiles_domain.test.domain_name
+  segment_definition_name = %[1]q
+  display_name            = %[1]q
+
+  segment_groups {
+    group {
+      dimension {
+        profile_attributes {
+          first_name {
+            dimension_type = "INCLUSIVE"
+            values         = ["Jane"]
+          }
+        }
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
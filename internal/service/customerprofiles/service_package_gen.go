@@ -0,0 +1,97 @@
+// Code generated by internal/generate/servicepackages/main.go; DO NOT EDIT.
+
+package customerprofiles
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type servicePackage struct{}
+
+func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
+	return []*types.ServicePackageFrameworkDataSource{}
+}
+
+func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
+	return []*types.ServicePackageFrameworkResource{}
+}
+
+func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
+	return []*types.ServicePackageSDKDataSource{}
+}
+
+func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceCalculatedAttributeDefinition,
+			TypeName: "aws_customerprofiles_calculated_attribute_definition",
+			Name:     "Calculated Attribute Definition",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceDomain,
+			TypeName: "aws_customerprofiles_domain",
+			Name:     "Domain",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceDomainLayout,
+			TypeName: "aws_customerprofiles_domain_layout",
+			Name:     "Domain Layout",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceEventStream,
+			TypeName: "aws_customerprofiles_event_stream",
+			Name:     "Event Stream",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceEventTrigger,
+			TypeName: "aws_customerprofiles_event_trigger",
+			Name:     "Event Trigger",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceIntegration,
+			TypeName: "aws_customerprofiles_integration",
+			Name:     "Integration",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
+		{
+			Factory:  ResourceProfileObjectType,
+			TypeName: "aws_customerprofiles_profile_object_type",
+			Name:     "Profile Object Type",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceSegmentDefinition,
+			TypeName: "aws_customerprofiles_segment_definition",
+			Name:     "Segment Definition",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+	}
+}
+
+func (p *servicePackage) ServicePackageName() string {
+	return names.CustomerProfiles
+}
+
+var ServicePackage = &servicePackage{}
@@ -0,0 +1,44 @@
+package qconnect
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/qconnect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindQuickResponseByTwoPartKey(ctx context.Context, conn *qconnect.QConnect, knowledgeBaseID, quickResponseID string) (*qconnect.QuickResponseData, error) {
+	input := &qconnect.GetQuickResponseInput{
+		KnowledgeBaseId: aws.String(knowledgeBaseID),
+		QuickResponseId: aws.String(quickResponseID),
+	}
+
+	output, err := conn.GetQuickResponseWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, qconnect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.QuickResponse == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.QuickResponse.Status); status == qconnect.QuickResponseStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output.QuickResponse, nil
+}
@@ -0,0 +1,410 @@
+package qconnect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/qconnect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	quickResponseContentTypeMarkdown  = "application/x.quickresponse;format=markdown"
+	quickResponseContentTypePlainText = "application/x.quickresponse;format=plain"
+)
+
+// @SDKResource("aws_qconnect_quick_response", name="Quick Response")
+// @Tags(identifierAttribute="arn")
+func ResourceQuickResponse() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceQuickResponseCreate,
+		ReadWithoutTimeout:   resourceQuickResponseRead,
+		UpdateWithoutTimeout: resourceQuickResponseUpdate,
+		DeleteWithoutTimeout: resourceQuickResponseDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"channels": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 10),
+				},
+			},
+			"content": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					quickResponseContentTypeMarkdown,
+					quickResponseContentTypePlainText,
+				}, false),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"grouping_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"criteria": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 2048),
+							},
+						},
+					},
+				},
+			},
+			"is_active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"knowledge_base_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"knowledge_base_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(2, 5),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"quick_response_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"shortcut_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 10),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceQuickResponseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QConnectConn()
+
+	knowledgeBaseID := d.Get("knowledge_base_id").(string)
+	name := d.Get(names.AttrName).(string)
+	input := &qconnect.CreateQuickResponseInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Content: &qconnect.QuickResponseDataProvider{
+			Content: aws.String(d.Get("content").(string)),
+		},
+		KnowledgeBaseId: aws.String(knowledgeBaseID),
+		Name:            aws.String(name),
+		Tags:            GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("channels"); ok && v.(*schema.Set).Len() > 0 {
+		input.Channels = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("content_type"); ok {
+		input.ContentType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("grouping_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.GroupingConfiguration = expandGroupingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOkExists("is_active"); ok {
+		input.IsActive = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("language"); ok {
+		input.Language = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("shortcut_key"); ok {
+		input.ShortcutKey = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateQuickResponseWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Q in Connect Quick Response (%s): %s", name, err)
+	}
+
+	quickResponseID := aws.StringValue(output.QuickResponse.QuickResponseId)
+	d.SetId(QuickResponseCreateResourceID(knowledgeBaseID, quickResponseID))
+
+	if _, err := waitQuickResponseCreated(ctx, conn, knowledgeBaseID, quickResponseID, quickResponseCreatedTimeout); err != nil {
+		return diag.Errorf("waiting for Q in Connect Quick Response (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceQuickResponseRead(ctx, d, meta)
+}
+
+func resourceQuickResponseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QConnectConn()
+
+	knowledgeBaseID, quickResponseID, err := QuickResponseParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	quickResponse, err := FindQuickResponseByTwoPartKey(ctx, conn, knowledgeBaseID, quickResponseID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Q in Connect Quick Response (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Q in Connect Quick Response (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, quickResponse.QuickResponseArn)
+	d.Set("channels", aws.StringValueSlice(quickResponse.Channels))
+	if v := quickResponse.Contents; v != nil {
+		// The contents are returned in both formats; keep the one matching the content type.
+		if aws.StringValue(quickResponse.ContentType) == quickResponseContentTypeMarkdown && v.Markdown != nil {
+			d.Set("content", v.Markdown.Content)
+		} else if v.PlainText != nil {
+			d.Set("content", v.PlainText.Content)
+		}
+	}
+	d.Set("content_type", quickResponse.ContentType)
+	d.Set("description", quickResponse.Description)
+	if quickResponse.GroupingConfiguration != nil {
+		if err := d.Set("grouping_configuration", []interface{}{flattenGroupingConfiguration(quickResponse.GroupingConfiguration)}); err != nil {
+			return diag.Errorf("setting grouping_configuration: %s", err)
+		}
+	} else {
+		d.Set("grouping_configuration", nil)
+	}
+	d.Set("is_active", quickResponse.IsActive)
+	d.Set("knowledge_base_arn", quickResponse.KnowledgeBaseArn)
+	d.Set("knowledge_base_id", quickResponse.KnowledgeBaseId)
+	d.Set("language", quickResponse.Language)
+	d.Set(names.AttrName, quickResponse.Name)
+	d.Set("quick_response_id", quickResponse.QuickResponseId)
+	d.Set("shortcut_key", quickResponse.ShortcutKey)
+	d.Set("status", quickResponse.Status)
+
+	SetTagsOut(ctx, quickResponse.Tags)
+
+	return nil
+}
+
+func resourceQuickResponseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QConnectConn()
+
+	knowledgeBaseID, quickResponseID, err := QuickResponseParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &qconnect.UpdateQuickResponseInput{
+			KnowledgeBaseId: aws.String(knowledgeBaseID),
+			QuickResponseId: aws.String(quickResponseID),
+		}
+
+		if d.HasChange("channels") {
+			input.Channels = flex.ExpandStringSet(d.Get("channels").(*schema.Set))
+		}
+
+		if d.HasChanges("content", "content_type") {
+			input.Content = &qconnect.QuickResponseDataProvider{
+				Content: aws.String(d.Get("content").(string)),
+			}
+			input.ContentType = aws.String(d.Get("content_type").(string))
+		}
+
+		if d.HasChange("description") {
+			if v, ok := d.GetOk("description"); ok {
+				input.Description = aws.String(v.(string))
+			} else {
+				input.RemoveDescription = aws.Bool(true)
+			}
+		}
+
+		if d.HasChange("grouping_configuration") {
+			if v, ok := d.GetOk("grouping_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.GroupingConfiguration = expandGroupingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.RemoveGroupingConfiguration = aws.Bool(true)
+			}
+		}
+
+		if d.HasChange("is_active") {
+			input.IsActive = aws.Bool(d.Get("is_active").(bool))
+		}
+
+		if d.HasChange("language") {
+			input.Language = aws.String(d.Get("language").(string))
+		}
+
+		if d.HasChange(names.AttrName) {
+			input.Name = aws.String(d.Get(names.AttrName).(string))
+		}
+
+		if d.HasChange("shortcut_key") {
+			if v, ok := d.GetOk("shortcut_key"); ok {
+				input.ShortcutKey = aws.String(v.(string))
+			} else {
+				input.RemoveShortcutKey = aws.Bool(true)
+			}
+		}
+
+		_, err := conn.UpdateQuickResponseWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Q in Connect Quick Response (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitQuickResponseCreated(ctx, conn, knowledgeBaseID, quickResponseID, quickResponseUpdatedTimeout); err != nil {
+			return diag.Errorf("waiting for Q in Connect Quick Response (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceQuickResponseRead(ctx, d, meta)
+}
+
+func resourceQuickResponseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QConnectConn()
+
+	knowledgeBaseID, quickResponseID, err := QuickResponseParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Q in Connect Quick Response: %s", d.Id())
+	_, err = conn.DeleteQuickResponseWithContext(ctx, &qconnect.DeleteQuickResponseInput{
+		KnowledgeBaseId: aws.String(knowledgeBaseID),
+		QuickResponseId: aws.String(quickResponseID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, qconnect.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Q in Connect Quick Response (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitQuickResponseDeleted(ctx, conn, knowledgeBaseID, quickResponseID, quickResponseDeletedTimeout); err != nil {
+		return diag.Errorf("waiting for Q in Connect Quick Response (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const quickResponseResourceIDSeparator = "/"
+
+func QuickResponseCreateResourceID(knowledgeBaseID, quickResponseID string) string {
+	parts := []string{knowledgeBaseID, quickResponseID}
+	id := strings.Join(parts, quickResponseResourceIDSeparator)
+
+	return id
+}
+
+func QuickResponseParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, quickResponseResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected knowledge-base-id%[2]squick-response-id", id, quickResponseResourceIDSeparator)
+}
+
+func expandGroupingConfiguration(tfMap map[string]interface{}) *qconnect.GroupingConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &qconnect.GroupingConfiguration{}
+
+	if v, ok := tfMap["criteria"].(string); ok && v != "" {
+		apiObject.Criteria = aws.String(v)
+	}
+
+	if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Values = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenGroupingConfiguration(apiObject *qconnect.GroupingConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"criteria": aws.StringValue(apiObject.Criteria),
+		"values":   aws.StringValueSlice(apiObject.Values),
+	}
+
+	return tfMap
+}
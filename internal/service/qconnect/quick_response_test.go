@@ -0,0 +1,300 @@
+package qconnect_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/qconnect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfqconnect "github.com/hashicorp/terraform-provider-aws/internal/service/qconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccQConnectQuickResponse_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var quickResponse qconnect.QuickResponseData
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qconnect_quick_response.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, qconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQuickResponseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuickResponseConfig_basic(rName, "Hello"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQuickResponseExists(ctx, resourceName, &quickResponse),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "wisdom", regexp.MustCompile(`quick-response/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "content", "Hello"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/x.quickresponse;format=plain"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "grouping_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "is_active", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "knowledge_base_id", "aws_wisdom_knowledge_base.test", "knowledge_base_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "quick_response_id"),
+					resource.TestCheckResourceAttr(resourceName, "shortcut_key", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccQConnectQuickResponse_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var quickResponse qconnect.QuickResponseData
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qconnect_quick_response.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, qconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQuickResponseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuickResponseConfig_basic(rName, "Hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickResponseExists(ctx, resourceName, &quickResponse),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfqconnect.ResourceQuickResponse(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccQConnectQuickResponse_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var quickResponse qconnect.QuickResponseData
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qconnect_quick_response.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, qconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQuickResponseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuickResponseConfig_basic(rName, "Hello"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQuickResponseExists(ctx, resourceName, &quickResponse),
+					resource.TestCheckResourceAttr(resourceName, "content", "Hello"),
+				),
+			},
+			{
+				Config: testAccQuickResponseConfig_full(rName, "**Hello** {{Attributes.Customer.FirstName}}", "greeting", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQuickResponseExists(ctx, resourceName, &quickResponse),
+					resource.TestCheckResourceAttr(resourceName, "channels.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "channels.*", "Chat"),
+					resource.TestCheckResourceAttr(resourceName, "content", "**Hello** {{Attributes.Customer.FirstName}}"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/x.quickresponse;format=markdown"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Greeting"),
+					resource.TestCheckResourceAttr(resourceName, "is_active", "false"),
+					resource.TestCheckResourceAttr(resourceName, "language", "en_US"),
+					resource.TestCheckResourceAttr(resourceName, "shortcut_key", "greeting"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQuickResponseConfig_basic(rName, "Hello again"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQuickResponseExists(ctx, resourceName, &quickResponse),
+					resource.TestCheckResourceAttr(resourceName, "content", "Hello again"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "shortcut_key", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQConnectQuickResponse_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var quickResponse qconnect.QuickResponseData
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qconnect_quick_response.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, qconnect.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQuickResponseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuickResponseConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickResponseExists(ctx, resourceName, &quickResponse),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQuickResponseConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickResponseExists(ctx, resourceName, &quickResponse),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccQuickResponseConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuickResponseExists(ctx, resourceName, &quickResponse),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckQuickResponseExists(ctx context.Context, resourceName string, v *qconnect.QuickResponseData) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		knowledgeBaseID, quickResponseID, err := tfqconnect.QuickResponseParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QConnectConn()
+
+		output, err := tfqconnect.FindQuickResponseByTwoPartKey(ctx, conn, knowledgeBaseID, quickResponseID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckQuickResponseDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QConnectConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_qconnect_quick_response" {
+				continue
+			}
+
+			knowledgeBaseID, quickResponseID, err := tfqconnect.QuickResponseParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfqconnect.FindQuickResponseByTwoPartKey(ctx, conn, knowledgeBaseID, quickResponseID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Q in Connect Quick Response %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccQuickResponseConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_wisdom_knowledge_base" "test" {
+  name                = %[1]q
+  knowledge_base_type = "QUICK_RESPONSES"
+}
+`, rName)
+}
+
+func testAccQuickResponseConfig_basic(rName, content string) string {
+	return acctest.ConfigCompose(testAccQuickResponseConfig_base(rName), fmt.Sprintf(`
+resource "aws_qconnect_quick_response" "test" {
+  knowledge_base_id = aws_wisdom_knowledge_base.test.knowledge_base_id
+  name              = %[1]q
+  content           = %[2]q
+}
+`, rName, content))
+}
+
+func testAccQuickResponseConfig_full(rName, content, shortcutKey string, isActive bool) string {
+	return acctest.ConfigCompose(testAccQuickResponseConfig_base(rName), fmt.Sprintf(`
+resource "aws_qconnect_quick_response" "test" {
+  knowledge_base_id = aws_wisdom_knowledge_base.test.knowledge_base_id
+  name              = %[1]q
+  content           = %[2]q
+  content_type      = "application/x.quickresponse;format=markdown"
+  channels          = ["Chat"]
+  description       = "Greeting"
+  is_active         = %[4]t
+  language          = "en_US"
+  shortcut_key      = %[3]q
+}
+`, rName, content, shortcutKey, isActive))
+}
+
+func testAccQuickResponseConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccQuickResponseConfig_base(rName), fmt.Sprintf(`
+resource "aws_qconnect_quick_response" "test" {
+  knowledge_base_id = aws_wisdom_knowledge_base.test.knowledge_base_id
+  name              = %[1]q
+  content           = "Hello"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccQuickResponseConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccQuickResponseConfig_base(rName), fmt.Sprintf(`
+resource "aws_qconnect_quick_response" "test" {
+  knowledge_base_id = aws_wisdom_knowledge_base.test.knowledge_base_id
+  name              = %[1]q
+  content           = "Hello"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
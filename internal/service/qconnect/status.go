@@ -0,0 +1,26 @@
+package qconnect
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/qconnect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusQuickResponse(ctx context.Context, conn *qconnect.QConnect, knowledgeBaseID, quickResponseID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindQuickResponseByTwoPartKey(ctx, conn, knowledgeBaseID, quickResponseID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
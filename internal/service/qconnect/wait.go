@@ -0,0 +1,50 @@
+package qconnect
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/qconnect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const (
+	quickResponseCreatedTimeout = 5 * time.Minute
+	quickResponseUpdatedTimeout = 5 * time.Minute
+	quickResponseDeletedTimeout = 5 * time.Minute
+)
+
+// An updated quick response returns to the CREATED status; there is no UPDATED status.
+func waitQuickResponseCreated(ctx context.Context, conn *qconnect.QConnect, knowledgeBaseID, quickResponseID string, timeout time.Duration) (*qconnect.QuickResponseData, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{qconnect.QuickResponseStatusCreateInProgress, qconnect.QuickResponseStatusUpdateInProgress},
+		Target:  []string{qconnect.QuickResponseStatusCreated},
+		Refresh: statusQuickResponse(ctx, conn, knowledgeBaseID, quickResponseID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qconnect.QuickResponseData); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitQuickResponseDeleted(ctx context.Context, conn *qconnect.QConnect, knowledgeBaseID, quickResponseID string, timeout time.Duration) (*qconnect.QuickResponseData, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{qconnect.QuickResponseStatusCreated, qconnect.QuickResponseStatusDeleteInProgress},
+		Target:  []string{},
+		Refresh: statusQuickResponse(ctx, conn, knowledgeBaseID, quickResponseID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qconnect.QuickResponseData); ok {
+		return output, err
+	}
+
+	return nil, err
+}
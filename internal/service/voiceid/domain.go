@@ -0,0 +1,220 @@
+package voiceid
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/voiceid"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_voiceid_domain", name="Domain")
+// @Tags(identifierAttribute="arn")
+func ResourceDomain() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDomainCreate,
+		ReadWithoutTimeout:   resourceDomainRead,
+		UpdateWithoutTimeout: resourceDomainUpdate,
+		DeleteWithoutTimeout: resourceDomainDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(domainCreatedTimeout),
+			Update: schema.DefaultTimeout(domainUpdatedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_watchlist_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"domain_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"server_side_encryption_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VoiceIDConn()
+
+	name := d.Get(names.AttrName).(string)
+	input := &voiceid.CreateDomainInput{
+		ClientToken:                       aws.String(id.UniqueId()),
+		Name:                              aws.String(name),
+		ServerSideEncryptionConfiguration: expandServerSideEncryptionConfiguration(d.Get("server_side_encryption_configuration").([]interface{})),
+		Tags:                              GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateDomainWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Voice ID Domain (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Domain.DomainId))
+
+	if _, err := waitDomainCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Voice ID Domain (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceDomainRead(ctx, d, meta)
+}
+
+func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VoiceIDConn()
+
+	domain, err := FindDomainByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Voice ID Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Voice ID Domain (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, domain.Arn)
+	if domain.WatchlistDetails != nil {
+		d.Set("default_watchlist_id", domain.WatchlistDetails.DefaultWatchlistId)
+	} else {
+		d.Set("default_watchlist_id", nil)
+	}
+	d.Set(names.AttrDescription, domain.Description)
+	d.Set("domain_status", domain.DomainStatus)
+	d.Set(names.AttrName, domain.Name)
+	if err := d.Set("server_side_encryption_configuration", flattenServerSideEncryptionConfiguration(domain.ServerSideEncryptionConfiguration)); err != nil {
+		return diag.Errorf("setting server_side_encryption_configuration: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VoiceIDConn()
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		// UpdateDomain replaces the whole domain definition, so every field is sent.
+		input := &voiceid.UpdateDomainInput{
+			DomainId:                          aws.String(d.Id()),
+			Name:                              aws.String(d.Get(names.AttrName).(string)),
+			ServerSideEncryptionConfiguration: expandServerSideEncryptionConfiguration(d.Get("server_side_encryption_configuration").([]interface{})),
+		}
+
+		if v, ok := d.GetOk(names.AttrDescription); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateDomainWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Voice ID Domain (%s): %s", d.Id(), err)
+		}
+
+		if d.HasChange("server_side_encryption_configuration") {
+			if _, err := waitDomainServerSideEncryptionUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.Errorf("waiting for Voice ID Domain (%s) server-side encryption update: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceDomainRead(ctx, d, meta)
+}
+
+func resourceDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VoiceIDConn()
+
+	log.Printf("[INFO] Deleting Voice ID Domain: %s", d.Id())
+	_, err := conn.DeleteDomainWithContext(ctx, &voiceid.DeleteDomainInput{
+		DomainId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, voiceid.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Voice ID Domain (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandServerSideEncryptionConfiguration(tfList []interface{}) *voiceid.ServerSideEncryptionConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &voiceid.ServerSideEncryptionConfiguration{}
+
+	if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+		apiObject.KmsKeyId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenServerSideEncryptionConfiguration(apiObject *voiceid.ServerSideEncryptionConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"kms_key_id": aws.StringValue(apiObject.KmsKeyId),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,62 @@
+package voiceid
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/voiceid"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindDomainByID(ctx context.Context, conn *voiceid.VoiceID, id string) (*voiceid.Domain, error) {
+	input := &voiceid.DescribeDomainInput{
+		DomainId: aws.String(id),
+	}
+
+	output, err := conn.DescribeDomainWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, voiceid.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Domain == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Domain, nil
+}
+
+func FindWatchlistByTwoPartKey(ctx context.Context, conn *voiceid.VoiceID, domainID, watchlistID string) (*voiceid.Watchlist, error) {
+	input := &voiceid.DescribeWatchlistInput{
+		DomainId:    aws.String(domainID),
+		WatchlistId: aws.String(watchlistID),
+	}
+
+	output, err := conn.DescribeWatchlistWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, voiceid.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Watchlist == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Watchlist, nil
+}
@@ -0,0 +1,47 @@
+package voiceid
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/voiceid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusDomain(ctx context.Context, conn *voiceid.VoiceID, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDomainByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.DomainStatus), nil
+	}
+}
+
+func statusDomainServerSideEncryptionUpdate(ctx context.Context, conn *voiceid.VoiceID, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDomainByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		// No re-encryption has ever been started for the domain.
+		if output.ServerSideEncryptionUpdateDetails == nil {
+			return output, voiceid.ServerSideEncryptionUpdateStatusCompleted, nil
+		}
+
+		return output, aws.StringValue(output.ServerSideEncryptionUpdateDetails.UpdateStatus), nil
+	}
+}
@@ -0,0 +1,56 @@
+package voiceid
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/voiceid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	domainCreatedTimeout = 5 * time.Minute
+	// Re-encrypting existing speaker and fraudster data can take a while on large domains.
+	domainUpdatedTimeout = 60 * time.Minute
+)
+
+func waitDomainCreated(ctx context.Context, conn *voiceid.VoiceID, id string, timeout time.Duration) (*voiceid.Domain, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{voiceid.DomainStatusPending},
+		Target:  []string{voiceid.DomainStatusActive},
+		Refresh: statusDomain(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*voiceid.Domain); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDomainServerSideEncryptionUpdated(ctx context.Context, conn *voiceid.VoiceID, id string, timeout time.Duration) (*voiceid.Domain, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{voiceid.ServerSideEncryptionUpdateStatusInProgress},
+		Target:  []string{voiceid.ServerSideEncryptionUpdateStatusCompleted},
+		Refresh: statusDomainServerSideEncryptionUpdate(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*voiceid.Domain); ok {
+		if details := output.ServerSideEncryptionUpdateDetails; details != nil && aws.StringValue(details.UpdateStatus) == voiceid.ServerSideEncryptionUpdateStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(details.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
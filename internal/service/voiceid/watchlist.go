@@ -0,0 +1,192 @@
+package voiceid
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/voiceid"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_voiceid_watchlist", name="Watchlist")
+func ResourceWatchlist() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWatchlistCreate,
+		ReadWithoutTimeout:   resourceWatchlistRead,
+		UpdateWithoutTimeout: resourceWatchlistUpdate,
+		DeleteWithoutTimeout: resourceWatchlistDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"default_watchlist": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"domain_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(22, 22),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"watchlist_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceWatchlistCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VoiceIDConn()
+
+	domainID := d.Get("domain_id").(string)
+	name := d.Get(names.AttrName).(string)
+	input := &voiceid.CreateWatchlistInput{
+		ClientToken: aws.String(id.UniqueId()),
+		DomainId:    aws.String(domainID),
+		Name:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateWatchlistWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Voice ID Watchlist (%s): %s", name, err)
+	}
+
+	d.SetId(WatchlistCreateResourceID(domainID, aws.StringValue(output.Watchlist.WatchlistId)))
+
+	return resourceWatchlistRead(ctx, d, meta)
+}
+
+func resourceWatchlistRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VoiceIDConn()
+
+	domainID, watchlistID, err := WatchlistParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	watchlist, err := FindWatchlistByTwoPartKey(ctx, conn, domainID, watchlistID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Voice ID Watchlist (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Voice ID Watchlist (%s): %s", d.Id(), err)
+	}
+
+	d.Set("default_watchlist", watchlist.DefaultWatchlist)
+	d.Set(names.AttrDescription, watchlist.Description)
+	d.Set("domain_id", watchlist.DomainId)
+	d.Set(names.AttrName, watchlist.Name)
+	d.Set("watchlist_id", watchlist.WatchlistId)
+
+	return nil
+}
+
+func resourceWatchlistUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VoiceIDConn()
+
+	domainID, watchlistID, err := WatchlistParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &voiceid.UpdateWatchlistInput{
+		DomainId:    aws.String(domainID),
+		WatchlistId: aws.String(watchlistID),
+	}
+
+	if d.HasChange(names.AttrDescription) {
+		input.Description = aws.String(d.Get(names.AttrDescription).(string))
+	}
+
+	if d.HasChange(names.AttrName) {
+		input.Name = aws.String(d.Get(names.AttrName).(string))
+	}
+
+	_, err = conn.UpdateWatchlistWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating Voice ID Watchlist (%s): %s", d.Id(), err)
+	}
+
+	return resourceWatchlistRead(ctx, d, meta)
+}
+
+func resourceWatchlistDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).VoiceIDConn()
+
+	domainID, watchlistID, err := WatchlistParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Voice ID Watchlist: %s", d.Id())
+	_, err = conn.DeleteWatchlistWithContext(ctx, &voiceid.DeleteWatchlistInput{
+		DomainId:    aws.String(domainID),
+		WatchlistId: aws.String(watchlistID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, voiceid.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Voice ID Watchlist (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const watchlistResourceIDSeparator = "/"
+
+func WatchlistCreateResourceID(domainID, watchlistID string) string {
+	parts := []string{domainID, watchlistID}
+	id := strings.Join(parts, watchlistResourceIDSeparator)
+
+	return id
+}
+
+func WatchlistParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, watchlistResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected domain-id%[2]swatchlist-id", id, watchlistResourceIDSeparator)
+}
@@ -0,0 +1,184 @@
+package voiceid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/voiceid"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfvoiceid "github.com/hashicorp/terraform-provider-aws/internal/service/voiceid"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVoiceIDWatchlist_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var watchlist voiceid.Watchlist
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_voiceid_watchlist.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, voiceid.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWatchlistDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWatchlistConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWatchlistExists(ctx, resourceName, &watchlist),
+					resource.TestCheckResourceAttr(resourceName, "default_watchlist", "false"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_id", "aws_voiceid_domain.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "watchlist_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVoiceIDWatchlist_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var watchlist voiceid.Watchlist
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_voiceid_watchlist.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, voiceid.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWatchlistDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWatchlistConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWatchlistExists(ctx, resourceName, &watchlist),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfvoiceid.ResourceWatchlist(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVoiceIDWatchlist_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var watchlist voiceid.Watchlist
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_voiceid_watchlist.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, voiceid.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWatchlistDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWatchlistConfig_description(rName, rName, "original"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWatchlistExists(ctx, resourceName, &watchlist),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "original"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+			{
+				Config: testAccWatchlistConfig_description(rName, rNameUpdated, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWatchlistExists(ctx, resourceName, &watchlist),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWatchlistExists(ctx context.Context, resourceName string, v *voiceid.Watchlist) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		domainID, watchlistID, err := tfvoiceid.WatchlistParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VoiceIDConn()
+
+		output, err := tfvoiceid.FindWatchlistByTwoPartKey(ctx, conn, domainID, watchlistID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckWatchlistDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VoiceIDConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_voiceid_watchlist" {
+				continue
+			}
+
+			domainID, watchlistID, err := tfvoiceid.WatchlistParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfvoiceid.FindWatchlistByTwoPartKey(ctx, conn, domainID, watchlistID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Voice ID Watchlist %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccWatchlistConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDomainConfig_basic(rName), fmt.Sprintf(`
+resource "aws_voiceid_watchlist" "test" {
+  domain_id = aws_voiceid_domain.test.id
+  name      = %[1]q
+}
+`, rName))
+}
+
+func testAccWatchlistConfig_description(rName, watchlistName, description string) string {
+	return acctest.ConfigCompose(testAccDomainConfig_basic(rName), fmt.Sprintf(`
+resource "aws_voiceid_watchlist" "test" {
+  domain_id   = aws_voiceid_domain.test.id
+  name        = %[1]q
+  description = %[2]q
+}
+`, watchlistName, description))
+}
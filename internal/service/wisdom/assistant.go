@@ -0,0 +1,195 @@
+package wisdom
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connectwisdomservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_wisdom_assistant", name="Assistant")
+// @Tags(identifierAttribute="arn")
+func ResourceAssistant() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssistantCreate,
+		ReadWithoutTimeout:   resourceAssistantRead,
+		UpdateWithoutTimeout: resourceAssistantUpdate,
+		DeleteWithoutTimeout: resourceAssistantDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"server_side_encryption_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 4096),
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      connectwisdomservice.AssistantTypeAgent,
+				ValidateFunc: validation.StringInSlice(connectwisdomservice.AssistantType_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAssistantCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WisdomConn()
+
+	name := d.Get(names.AttrName).(string)
+	input := &connectwisdomservice.CreateAssistantInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Name:        aws.String(name),
+		Tags:        GetTagsIn(ctx),
+		Type:        aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption_configuration"); ok && len(v.([]interface{})) > 0 {
+		input.ServerSideEncryptionConfiguration = expandServerSideEncryptionConfiguration(v.([]interface{}))
+	}
+
+	output, err := conn.CreateAssistantWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Wisdom Assistant (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Assistant.AssistantId))
+
+	if _, err := waitAssistantCreated(ctx, conn, d.Id(), assistantCreatedTimeout); err != nil {
+		return diag.Errorf("waiting for Wisdom Assistant (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceAssistantRead(ctx, d, meta)
+}
+
+func resourceAssistantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WisdomConn()
+
+	assistant, err := FindAssistantByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Wisdom Assistant (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Wisdom Assistant (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, assistant.AssistantArn)
+	d.Set(names.AttrDescription, assistant.Description)
+	d.Set(names.AttrName, assistant.Name)
+	if err := d.Set("server_side_encryption_configuration", flattenServerSideEncryptionConfiguration(assistant.ServerSideEncryptionConfiguration)); err != nil {
+		return diag.Errorf("setting server_side_encryption_configuration: %s", err)
+	}
+	d.Set("type", assistant.Type)
+
+	SetTagsOut(ctx, assistant.Tags)
+
+	return nil
+}
+
+func resourceAssistantUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceAssistantRead(ctx, d, meta)
+}
+
+func resourceAssistantDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WisdomConn()
+
+	log.Printf("[INFO] Deleting Wisdom Assistant: %s", d.Id())
+	_, err := conn.DeleteAssistantWithContext(ctx, &connectwisdomservice.DeleteAssistantInput{
+		AssistantId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connectwisdomservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Wisdom Assistant (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAssistantDeleted(ctx, conn, d.Id(), assistantDeletedTimeout); err != nil {
+		return diag.Errorf("waiting for Wisdom Assistant (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandServerSideEncryptionConfiguration(tfList []interface{}) *connectwisdomservice.ServerSideEncryptionConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &connectwisdomservice.ServerSideEncryptionConfiguration{}
+
+	if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+		apiObject.KmsKeyId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenServerSideEncryptionConfiguration(apiObject *connectwisdomservice.ServerSideEncryptionConfiguration) []interface{} {
+	if apiObject == nil || apiObject.KmsKeyId == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"kms_key_id": aws.StringValue(apiObject.KmsKeyId),
+	}}
+}
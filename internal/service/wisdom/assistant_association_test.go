@@ -0,0 +1,158 @@
+package wisdom_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/connectwisdomservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwisdom "github.com/hashicorp/terraform-provider-aws/internal/service/wisdom"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWisdomAssistantAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var association connectwisdomservice.AssistantAssociationData
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wisdom_assistant_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connectwisdomservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssistantAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssistantAssociationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssistantAssociationExists(ctx, resourceName, &association),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "wisdom", regexp.MustCompile(`association/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "assistant_arn", "aws_wisdom_assistant.test", names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "assistant_association_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "assistant_id", "aws_wisdom_assistant.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "association.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "association.0.knowledge_base_arn", "aws_wisdom_knowledge_base.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "association.0.knowledge_base_id", "aws_wisdom_knowledge_base.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "association_type", "KNOWLEDGE_BASE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWisdomAssistantAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var association connectwisdomservice.AssistantAssociationData
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wisdom_assistant_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connectwisdomservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssistantAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssistantAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssistantAssociationExists(ctx, resourceName, &association),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfwisdom.ResourceAssistantAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAssistantAssociationExists(ctx context.Context, resourceName string, v *connectwisdomservice.AssistantAssociationData) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		assistantID, associationID, err := tfwisdom.AssistantAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WisdomConn()
+
+		output, err := tfwisdom.FindAssistantAssociationByTwoPartKey(ctx, conn, assistantID, associationID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAssistantAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WisdomConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wisdom_assistant_association" {
+				continue
+			}
+
+			assistantID, associationID, err := tfwisdom.AssistantAssociationParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfwisdom.FindAssistantAssociationByTwoPartKey(ctx, conn, assistantID, associationID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Wisdom Assistant Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAssistantAssociationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_wisdom_assistant" "test" {
+  name = %[1]q
+}
+
+resource "aws_wisdom_knowledge_base" "test" {
+  name                = %[1]q
+  knowledge_base_type = "CUSTOM"
+}
+
+resource "aws_wisdom_assistant_association" "test" {
+  assistant_id = aws_wisdom_assistant.test.id
+
+  association {
+    knowledge_base_id = aws_wisdom_knowledge_base.test.id
+  }
+}
+`, rName)
+}
@@ -0,0 +1,510 @@
+package wisdom
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connectwisdomservice"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+// @SDKResource("aws_wisdom_content", name="Content")
+// @Tags(identifierAttribute="arn")
+func ResourceContent() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceContentCreate,
+		ReadWithoutTimeout:   resourceContentRead,
+		UpdateWithoutTimeout: resourceContentUpdate,
+		DeleteWithoutTimeout: resourceContentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^(text/(plain|html)|application/(pdf|vnd\.openxmlformats-officedocument\.wordprocessingml\.document))$`),
+					"must be one of text/plain, text/html, application/pdf or application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+				),
+			},
+			"knowledge_base_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"knowledge_base_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"link_out_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"override_link_out_uri": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 4096),
+			},
+			"revision_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"source_file", "source_s3"},
+			},
+			"source_s3": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"source_file", "source_s3"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"title": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			resourceContentContentHashCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func resourceContentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WisdomConn()
+
+	knowledgeBaseID := d.Get("knowledge_base_id").(string)
+	name := d.Get(names.AttrName).(string)
+
+	uploadID, err := uploadContent(ctx, conn, meta.(*conns.AWSClient), knowledgeBaseID, d)
+
+	if err != nil {
+		return diag.Errorf("creating Wisdom Content (%s): %s", name, err)
+	}
+
+	input := &connectwisdomservice.CreateContentInput{
+		ClientToken:     aws.String(id.UniqueId()),
+		KnowledgeBaseId: aws.String(knowledgeBaseID),
+		Name:            aws.String(name),
+		Tags:            GetTagsIn(ctx),
+		UploadId:        aws.String(uploadID),
+	}
+
+	if v, ok := d.GetOk("metadata"); ok && len(v.(map[string]interface{})) > 0 {
+		input.Metadata = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("override_link_out_uri"); ok {
+		input.OverrideLinkOutUri = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("title"); ok {
+		input.Title = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateContentWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Wisdom Content (%s): %s", name, err)
+	}
+
+	d.SetId(ContentCreateResourceID(knowledgeBaseID, aws.StringValue(output.Content.ContentId)))
+
+	if _, err := waitContentActive(ctx, conn, knowledgeBaseID, aws.StringValue(output.Content.ContentId), contentCreatedTimeout); err != nil {
+		return diag.Errorf("waiting for Wisdom Content (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceContentRead(ctx, d, meta)
+}
+
+func resourceContentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WisdomConn()
+
+	knowledgeBaseID, contentID, err := ContentParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	content, err := FindContentByTwoPartKey(ctx, conn, knowledgeBaseID, contentID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Wisdom Content (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Wisdom Content (%s): %s", d.Id(), err)
+	}
+
+	// The uploaded document is not returned, so content_hash, source_file and source_s3 are kept as configured.
+	d.Set(names.AttrARN, content.ContentArn)
+	d.Set("content_id", content.ContentId)
+	d.Set("content_type", content.ContentType)
+	d.Set("knowledge_base_arn", content.KnowledgeBaseArn)
+	d.Set("knowledge_base_id", content.KnowledgeBaseId)
+	d.Set("link_out_uri", content.LinkOutUri)
+	d.Set("metadata", aws.StringValueMap(content.Metadata))
+	d.Set(names.AttrName, content.Name)
+	d.Set("revision_id", content.RevisionId)
+	d.Set("status", content.Status)
+	d.Set("title", content.Title)
+
+	SetTagsOut(ctx, content.Tags)
+
+	return nil
+}
+
+func resourceContentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WisdomConn()
+
+	knowledgeBaseID, contentID, err := ContentParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		// The revision ID makes the update fail if the content has been changed outside of Terraform.
+		input := &connectwisdomservice.UpdateContentInput{
+			ContentId:       aws.String(contentID),
+			KnowledgeBaseId: aws.String(knowledgeBaseID),
+			RevisionId:      aws.String(d.Get("revision_id").(string)),
+		}
+
+		if d.HasChanges("content_hash", "content_type", "source_file", "source_s3") {
+			uploadID, err := uploadContent(ctx, conn, meta.(*conns.AWSClient), knowledgeBaseID, d)
+
+			if err != nil {
+				return diag.Errorf("updating Wisdom Content (%s): %s", d.Id(), err)
+			}
+
+			input.UploadId = aws.String(uploadID)
+		}
+
+		if d.HasChange("metadata") {
+			input.Metadata = flex.ExpandStringMap(d.Get("metadata").(map[string]interface{}))
+		}
+
+		if d.HasChange("override_link_out_uri") {
+			if v, ok := d.GetOk("override_link_out_uri"); ok {
+				input.OverrideLinkOutUri = aws.String(v.(string))
+			} else {
+				input.RemoveOverrideLinkOutUri = aws.Bool(true)
+			}
+		}
+
+		if d.HasChange("title") {
+			input.Title = aws.String(d.Get("title").(string))
+		}
+
+		_, err := conn.UpdateContentWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Wisdom Content (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitContentActive(ctx, conn, knowledgeBaseID, contentID, contentUpdatedTimeout); err != nil {
+			return diag.Errorf("waiting for Wisdom Content (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceContentRead(ctx, d, meta)
+}
+
+func resourceContentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WisdomConn()
+
+	knowledgeBaseID, contentID, err := ContentParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Wisdom Content: %s", d.Id())
+	_, err = conn.DeleteContentWithContext(ctx, &connectwisdomservice.DeleteContentInput{
+		ContentId:       aws.String(contentID),
+		KnowledgeBaseId: aws.String(knowledgeBaseID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connectwisdomservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Wisdom Content (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitContentDeleted(ctx, conn, knowledgeBaseID, contentID, contentDeletedTimeout); err != nil {
+		return diag.Errorf("waiting for Wisdom Content (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const contentResourceIDSeparator = "/"
+
+func ContentCreateResourceID(knowledgeBaseID, contentID string) string {
+	parts := []string{knowledgeBaseID, contentID}
+	id := strings.Join(parts, contentResourceIDSeparator)
+
+	return id
+}
+
+func ContentParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, contentResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected knowledge-base-id%[2]scontent-id", id, contentResourceIDSeparator)
+}
+
+// resourceContentContentHashCustomizeDiff sets content_hash to the hash of source_file or the ETag of source_s3,
+// so that changes to the document upload it again.
+func resourceContentContentHashCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("source_file") || !diff.NewValueKnown("source_s3.0.bucket") || !diff.NewValueKnown("source_s3.0.key") {
+		return diff.SetNewComputed("content_hash")
+	}
+
+	var hash string
+
+	if v := diff.Get("source_file").(string); v != "" {
+		var err error
+
+		hash, err = contentSourceFileHash(v)
+
+		// the file may not exist yet, e.g. when it is generated during apply
+		if errors.Is(err, fs.ErrNotExist) {
+			return diff.SetNewComputed("content_hash")
+		}
+
+		if err != nil {
+			return err
+		}
+	} else if v, ok := diff.Get("source_s3").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		bucket, key := tfMap["bucket"].(string), tfMap["key"].(string)
+
+		output, err := meta.(*conns.AWSClient).S3Conn().HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+
+		// the object may not exist yet, e.g. when it is created in the same apply
+		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchKey, s3.ErrCodeNoSuchBucket, "NotFound") {
+			return diff.SetNewComputed("content_hash")
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading S3 Object (s3://%s/%s): %w", bucket, key, err)
+		}
+
+		hash = contentS3ObjectHash(output.ETag)
+	}
+
+	if diff.Get("content_hash").(string) != hash {
+		return diff.SetNew("content_hash", hash)
+	}
+
+	return nil
+}
+
+// contentSourceFileHash returns the base64-encoded SHA256 hash of the local file, as filebase64sha256 does.
+func contentSourceFileHash(filename string) (string, error) {
+	filename, err := homedir.Expand(filename)
+	if err != nil {
+		return "", err
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return "", fmt.Errorf("unable to open %q: %w", filename, err)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("reading %q: %w", filename, err)
+	}
+
+	return base64.StdEncoding.EncodeToString(hash.Sum(nil)), nil
+}
+
+// contentS3ObjectHash returns an S3 object's ETag without the surrounding quotes, as aws_s3_object's etag does.
+func contentS3ObjectHash(etag *string) string {
+	return strings.Trim(aws.StringValue(etag), `"`)
+}
+
+// uploadContent uploads the configured document, a local file or an S3 object, to the knowledge base,
+// sets content_hash to the uploaded document's hash and returns the upload ID.
+func uploadContent(ctx context.Context, conn *connectwisdomservice.ConnectWisdomService, client *conns.AWSClient, knowledgeBaseID string, d *schema.ResourceData) (string, error) {
+	var body io.ReadCloser
+	var contentLength int64
+	var hash string
+
+	if v, ok := d.GetOk("source_file"); ok {
+		var err error
+
+		if hash, err = contentSourceFileHash(v.(string)); err != nil {
+			return "", err
+		}
+
+		filename, err := homedir.Expand(v.(string))
+
+		if err != nil {
+			return "", err
+		}
+
+		file, err := os.Open(filename)
+
+		if err != nil {
+			return "", fmt.Errorf("unable to open %q: %w", filename, err)
+		}
+
+		info, err := file.Stat()
+
+		if err != nil {
+			file.Close()
+			return "", fmt.Errorf("reading %q: %w", filename, err)
+		}
+
+		body, contentLength = file, info.Size()
+	} else if v, ok := d.GetOk("source_s3"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		bucket, key := tfMap["bucket"].(string), tfMap["key"].(string)
+
+		output, err := client.S3Conn().GetObjectWithContext(ctx, &s3.GetObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+
+		if err != nil {
+			return "", fmt.Errorf("reading S3 Object (s3://%s/%s): %w", bucket, key, err)
+		}
+
+		body, contentLength, hash = output.Body, aws.Int64Value(output.ContentLength), contentS3ObjectHash(output.ETag)
+	}
+
+	if body == nil {
+		return "", fmt.Errorf("one of source_file or source_s3 must be set")
+	}
+
+	defer body.Close()
+
+	output, err := conn.StartContentUploadWithContext(ctx, &connectwisdomservice.StartContentUploadInput{
+		ContentType:     aws.String(d.Get("content_type").(string)),
+		KnowledgeBaseId: aws.String(knowledgeBaseID),
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("starting content upload: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, aws.StringValue(output.Url), body)
+
+	if err != nil {
+		return "", err
+	}
+
+	request.ContentLength = contentLength
+
+	for k, v := range output.HeadersToInclude {
+		request.Header.Set(k, aws.StringValue(v))
+	}
+
+	// The provider's HTTP client is used so that its proxy, TLS and logging configuration applies to the upload.
+	response, err := client.HTTPClient().Do(request)
+
+	if err != nil {
+		return "", fmt.Errorf("uploading content: %w", err)
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return "", fmt.Errorf("uploading content: unexpected HTTP status %s", response.Status)
+	}
+
+	d.Set("content_hash", hash)
+
+	return aws.StringValue(output.UploadId), nil
+}
@@ -0,0 +1,283 @@
+package wisdom_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connectwisdomservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwisdom "github.com/hashicorp/terraform-provider-aws/internal/service/wisdom"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWisdomContent_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var content connectwisdomservice.ContentData
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wisdom_content.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connectwisdomservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentConfig_sourceFile(rName, "test-fixtures/content.txt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckContentExists(ctx, resourceName, &content),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "wisdom", regexp.MustCompile(`content/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "content_id"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/plain"),
+					resource.TestCheckResourceAttrPair(resourceName, "knowledge_base_arn", "aws_wisdom_knowledge_base.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "knowledge_base_id", "aws_wisdom_knowledge_base.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "revision_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", connectwisdomservice.ContentStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "title", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_hash", "source_file"},
+			},
+		},
+	})
+}
+
+func TestAccWisdomContent_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var content connectwisdomservice.ContentData
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wisdom_content.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connectwisdomservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentConfig_sourceFile(rName, "test-fixtures/content.txt"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContentExists(ctx, resourceName, &content),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfwisdom.ResourceContent(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWisdomContent_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 connectwisdomservice.ContentData
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wisdom_content.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connectwisdomservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentConfig_sourceFile(rName, "test-fixtures/content.txt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckContentExists(ctx, resourceName, &v1),
+				),
+			},
+			{
+				Config: testAccContentConfig_updated(rName, "test-fixtures/content_updated.txt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckContentExists(ctx, resourceName, &v2),
+					testAccCheckContentNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.category", "accounts"),
+					resource.TestCheckResourceAttr(resourceName, "override_link_out_uri", "https://example.com/articles/reset-password"),
+					resource.TestCheckResourceAttr(resourceName, "link_out_uri", "https://example.com/articles/reset-password"),
+					resource.TestCheckResourceAttr(resourceName, "title", "Reset your password"),
+					testAccCheckContentRevised(&v1, &v2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWisdomContent_sourceS3(t *testing.T) {
+	ctx := acctest.Context(t)
+	var content connectwisdomservice.ContentData
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wisdom_content.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, connectwisdomservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentConfig_sourceS3(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckContentExists(ctx, resourceName, &content),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/html"),
+					resource.TestCheckResourceAttr(resourceName, "source_s3.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", connectwisdomservice.ContentStatusActive),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContentExists(ctx context.Context, resourceName string, v *connectwisdomservice.ContentData) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		knowledgeBaseID, contentID, err := tfwisdom.ContentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WisdomConn()
+
+		output, err := tfwisdom.FindContentByTwoPartKey(ctx, conn, knowledgeBaseID, contentID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckContentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WisdomConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wisdom_content" {
+				continue
+			}
+
+			knowledgeBaseID, contentID, err := tfwisdom.ContentParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfwisdom.FindContentByTwoPartKey(ctx, conn, knowledgeBaseID, contentID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Wisdom Content %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckContentNotRecreated(before, after *connectwisdomservice.ContentData) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.ContentId), aws.StringValue(after.ContentId); before != after {
+			return fmt.Errorf("Wisdom Content (%s/%s) recreated", before, after)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckContentRevised(before, after *connectwisdomservice.ContentData) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(before.RevisionId) == aws.StringValue(after.RevisionId) {
+			return fmt.Errorf("Wisdom Content (%s) revision not updated", aws.StringValue(after.ContentId))
+		}
+
+		return nil
+	}
+}
+
+func testAccContentConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_wisdom_knowledge_base" "test" {
+  name                = %[1]q
+  knowledge_base_type = "CUSTOM"
+}
+`, rName)
+}
+
+func testAccContentConfig_sourceFile(rName, source string) string {
+	return acctest.ConfigCompose(testAccContentConfig_base(rName), fmt.Sprintf(`
+resource "aws_wisdom_content" "test" {
+  knowledge_base_id = aws_wisdom_knowledge_base.test.id
+  name              = %[1]q
+  content_type      = "text/plain"
+  source_file       = %[2]q
+}
+`, rName, source))
+}
+
+func testAccContentConfig_updated(rName, source string) string {
+	return acctest.ConfigCompose(testAccContentConfig_base(rName), fmt.Sprintf(`
+resource "aws_wisdom_content" "test" {
+  knowledge_base_id     = aws_wisdom_knowledge_base.test.id
+  name                  = %[1]q
+  content_type          = "text/plain"
+  source_file           = %[2]q
+  override_link_out_uri = "https://example.com/articles/reset-password"
+  title                 = "Reset your password"
+
+  metadata = {
+    category = "accounts"
+  }
+}
+`, rName, source))
+}
+
+func testAccContentConfig_sourceS3(rName string) string {
+	return acctest.ConfigCompose(testAccContentConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "articles/reset-password.html"
+  content = "<html><body><h1>Reset your password</h1></body></html>"
+}
+
+resource "aws_wisdom_content" "test" {
+  knowledge_base_id = aws_wisdom_knowledge_base.test.id
+  name              = %[1]q
+  content_type      = "text/html"
+
+  source_s3 {
+    bucket = aws_s3_object.test.bucket
+    key    = aws_s3_object.test.key
+  }
+}
+`, rName))
+}
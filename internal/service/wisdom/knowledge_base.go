@@ -0,0 +1,333 @@
+package wisdom
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connectwisdomservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_wisdom_knowledge_base", name="Knowledge Base")
+// @Tags(identifierAttribute="arn")
+func ResourceKnowledgeBase() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceKnowledgeBaseCreate,
+		ReadWithoutTimeout:   resourceKnowledgeBaseRead,
+		UpdateWithoutTimeout: resourceKnowledgeBaseUpdate,
+		DeleteWithoutTimeout: resourceKnowledgeBaseDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"knowledge_base_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(connectwisdomservice.KnowledgeBaseType_Values(), false),
+			},
+			"last_content_modification_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"rendering_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"template_uri": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 4096),
+						},
+					},
+				},
+			},
+			"server_side_encryption_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 4096),
+						},
+					},
+				},
+			},
+			"source_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app_integrations": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"app_integration_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"object_fields": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										MinItems: 1,
+										MaxItems: 100,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 4096),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceKnowledgeBaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WisdomConn()
+
+	name := d.Get(names.AttrName).(string)
+	input := &connectwisdomservice.CreateKnowledgeBaseInput{
+		ClientToken:       aws.String(id.UniqueId()),
+		KnowledgeBaseType: aws.String(d.Get("knowledge_base_type").(string)),
+		Name:              aws.String(name),
+		Tags:              GetTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("rendering_configuration"); ok && len(v.([]interface{})) > 0 {
+		input.RenderingConfiguration = expandRenderingConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption_configuration"); ok && len(v.([]interface{})) > 0 {
+		input.ServerSideEncryptionConfiguration = expandServerSideEncryptionConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("source_configuration"); ok && len(v.([]interface{})) > 0 {
+		input.SourceConfiguration = expandSourceConfiguration(v.([]interface{}))
+	}
+
+	output, err := conn.CreateKnowledgeBaseWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Wisdom Knowledge Base (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.KnowledgeBase.KnowledgeBaseId))
+
+	if _, err := waitKnowledgeBaseCreated(ctx, conn, d.Id(), knowledgeBaseCreatedTimeout); err != nil {
+		return diag.Errorf("waiting for Wisdom Knowledge Base (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceKnowledgeBaseRead(ctx, d, meta)
+}
+
+func resourceKnowledgeBaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WisdomConn()
+
+	knowledgeBase, err := FindKnowledgeBaseByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Wisdom Knowledge Base (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Wisdom Knowledge Base (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, knowledgeBase.KnowledgeBaseArn)
+	d.Set(names.AttrDescription, knowledgeBase.Description)
+	d.Set("knowledge_base_type", knowledgeBase.KnowledgeBaseType)
+	if knowledgeBase.LastContentModificationTime != nil {
+		d.Set("last_content_modification_time", aws.TimeValue(knowledgeBase.LastContentModificationTime).Format(time.RFC3339))
+	} else {
+		d.Set("last_content_modification_time", nil)
+	}
+	d.Set(names.AttrName, knowledgeBase.Name)
+	if err := d.Set("rendering_configuration", flattenRenderingConfiguration(knowledgeBase.RenderingConfiguration)); err != nil {
+		return diag.Errorf("setting rendering_configuration: %s", err)
+	}
+	if err := d.Set("server_side_encryption_configuration", flattenServerSideEncryptionConfiguration(knowledgeBase.ServerSideEncryptionConfiguration)); err != nil {
+		return diag.Errorf("setting server_side_encryption_configuration: %s", err)
+	}
+	if err := d.Set("source_configuration", flattenSourceConfiguration(knowledgeBase.SourceConfiguration)); err != nil {
+		return diag.Errorf("setting source_configuration: %s", err)
+	}
+
+	SetTagsOut(ctx, knowledgeBase.Tags)
+
+	return nil
+}
+
+func resourceKnowledgeBaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WisdomConn()
+
+	if d.HasChange("rendering_configuration") {
+		var templateURI string
+
+		if v := expandRenderingConfiguration(d.Get("rendering_configuration").([]interface{})); v != nil {
+			templateURI = aws.StringValue(v.TemplateUri)
+		}
+
+		if templateURI != "" {
+			_, err := conn.UpdateKnowledgeBaseTemplateUriWithContext(ctx, &connectwisdomservice.UpdateKnowledgeBaseTemplateUriInput{
+				KnowledgeBaseId: aws.String(d.Id()),
+				TemplateUri:     aws.String(templateURI),
+			})
+
+			if err != nil {
+				return diag.Errorf("updating Wisdom Knowledge Base (%s) template URI: %s", d.Id(), err)
+			}
+		} else {
+			_, err := conn.RemoveKnowledgeBaseTemplateUriWithContext(ctx, &connectwisdomservice.RemoveKnowledgeBaseTemplateUriInput{
+				KnowledgeBaseId: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return diag.Errorf("removing Wisdom Knowledge Base (%s) template URI: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceKnowledgeBaseRead(ctx, d, meta)
+}
+
+func resourceKnowledgeBaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WisdomConn()
+
+	log.Printf("[INFO] Deleting Wisdom Knowledge Base: %s", d.Id())
+	_, err := conn.DeleteKnowledgeBaseWithContext(ctx, &connectwisdomservice.DeleteKnowledgeBaseInput{
+		KnowledgeBaseId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connectwisdomservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Wisdom Knowledge Base (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitKnowledgeBaseDeleted(ctx, conn, d.Id(), knowledgeBaseDeletedTimeout); err != nil {
+		return diag.Errorf("waiting for Wisdom Knowledge Base (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandRenderingConfiguration(tfList []interface{}) *connectwisdomservice.RenderingConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &connectwisdomservice.RenderingConfiguration{}
+
+	if v, ok := tfMap["template_uri"].(string); ok && v != "" {
+		apiObject.TemplateUri = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSourceConfiguration(tfList []interface{}) *connectwisdomservice.SourceConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &connectwisdomservice.SourceConfiguration{}
+
+	if v, ok := tfMap["app_integrations"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.AppIntegrations = &connectwisdomservice.AppIntegrationsConfiguration{
+			AppIntegrationArn: aws.String(tfMap["app_integration_arn"].(string)),
+		}
+
+		if v, ok := tfMap["object_fields"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.AppIntegrations.ObjectFields = flex.ExpandStringSet(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenRenderingConfiguration(apiObject *connectwisdomservice.RenderingConfiguration) []interface{} {
+	if apiObject == nil || apiObject.TemplateUri == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"template_uri": aws.StringValue(apiObject.TemplateUri),
+	}}
+}
+
+func flattenSourceConfiguration(apiObject *connectwisdomservice.SourceConfiguration) []interface{} {
+	if apiObject == nil || apiObject.AppIntegrations == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"app_integrations": []interface{}{map[string]interface{}{
+			"app_integration_arn": aws.StringValue(apiObject.AppIntegrations.AppIntegrationArn),
+			"object_fields":       aws.StringValueSlice(apiObject.AppIntegrations.ObjectFields),
+		}},
+	}}
+}
@@ -0,0 +1,58 @@
+package wisdom
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connectwisdomservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusAssistant(ctx context.Context, conn *connectwisdomservice.ConnectWisdomService, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAssistantByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusKnowledgeBase(ctx context.Context, conn *connectwisdomservice.ConnectWisdomService, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindKnowledgeBaseByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusContent(ctx context.Context, conn *connectwisdomservice.ConnectWisdomService, knowledgeBaseID, contentID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindContentByTwoPartKey(ctx, conn, knowledgeBaseID, contentID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
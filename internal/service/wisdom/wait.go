@@ -0,0 +1,123 @@
+package wisdom
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/connectwisdomservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const (
+	assistantCreatedTimeout = 5 * time.Minute
+	assistantDeletedTimeout = 5 * time.Minute
+
+	contentCreatedTimeout = 5 * time.Minute
+	contentUpdatedTimeout = 5 * time.Minute
+	contentDeletedTimeout = 5 * time.Minute
+
+	knowledgeBaseCreatedTimeout = 5 * time.Minute
+	knowledgeBaseDeletedTimeout = 5 * time.Minute
+)
+
+func waitAssistantCreated(ctx context.Context, conn *connectwisdomservice.ConnectWisdomService, id string, timeout time.Duration) (*connectwisdomservice.AssistantData, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{connectwisdomservice.AssistantStatusCreateInProgress},
+		Target:  []string{connectwisdomservice.AssistantStatusActive},
+		Refresh: statusAssistant(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*connectwisdomservice.AssistantData); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAssistantDeleted(ctx context.Context, conn *connectwisdomservice.ConnectWisdomService, id string, timeout time.Duration) (*connectwisdomservice.AssistantData, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{connectwisdomservice.AssistantStatusActive, connectwisdomservice.AssistantStatusDeleteInProgress},
+		Target:  []string{},
+		Refresh: statusAssistant(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*connectwisdomservice.AssistantData); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitKnowledgeBaseCreated(ctx context.Context, conn *connectwisdomservice.ConnectWisdomService, id string, timeout time.Duration) (*connectwisdomservice.KnowledgeBaseData, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{connectwisdomservice.KnowledgeBaseStatusCreateInProgress},
+		Target:  []string{connectwisdomservice.KnowledgeBaseStatusActive},
+		Refresh: statusKnowledgeBase(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*connectwisdomservice.KnowledgeBaseData); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitKnowledgeBaseDeleted(ctx context.Context, conn *connectwisdomservice.ConnectWisdomService, id string, timeout time.Duration) (*connectwisdomservice.KnowledgeBaseData, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{connectwisdomservice.KnowledgeBaseStatusActive, connectwisdomservice.KnowledgeBaseStatusDeleteInProgress},
+		Target:  []string{},
+		Refresh: statusKnowledgeBase(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*connectwisdomservice.KnowledgeBaseData); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitContentActive(ctx context.Context, conn *connectwisdomservice.ConnectWisdomService, knowledgeBaseID, contentID string, timeout time.Duration) (*connectwisdomservice.ContentData, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{connectwisdomservice.ContentStatusCreateInProgress},
+		Target:  []string{connectwisdomservice.ContentStatusActive},
+		Refresh: statusContent(ctx, conn, knowledgeBaseID, contentID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*connectwisdomservice.ContentData); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitContentDeleted(ctx context.Context, conn *connectwisdomservice.ConnectWisdomService, knowledgeBaseID, contentID string, timeout time.Duration) (*connectwisdomservice.ContentData, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{connectwisdomservice.ContentStatusActive, connectwisdomservice.ContentStatusDeleteInProgress},
+		Target:  []string{},
+		Refresh: statusContent(ctx, conn, knowledgeBaseID, contentID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*connectwisdomservice.ContentData); ok {
+		return output, err
+	}
+
+	return nil, err
+}
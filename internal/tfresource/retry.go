@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aws/smithy-go"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -74,6 +75,26 @@ func RetryWhenAWSErrMessageContains(ctx context.Context, timeout time.Duration,
 	})
 }
 
+// RetryWhenConflict retries the specified function when it returns an AWS error indicating that the operation
+// conflicts with the current state of a dependent resource, e.g. deleting a resource that is still referenced
+// by another resource while the dependency is being removed.
+// Both AWS SDK for Go v1 and v2 errors are recognized.
+func RetryWhenConflict(ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
+	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
+		if tfawserr.ErrCodeEquals(err, errCodeConflictException, errCodeResourceInUseException) {
+			return true, err
+		}
+
+		if v, ok := errs.As[smithy.APIError](err); ok {
+			if code := v.ErrorCode(); code == errCodeConflictException || code == errCodeResourceInUseException {
+				return true, err
+			}
+		}
+
+		return false, err
+	})
+}
+
 func RetryWhenIsA[T error](ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
 	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
 		if errs.IsA[T](err) {
@@ -94,6 +115,11 @@ func RetryWhenIsAErrorMessageContains[T errs.ErrorWithErrorMessage](ctx context.
 	})
 }
 
+const (
+	errCodeConflictException      = "ConflictException"
+	errCodeResourceInUseException = "ResourceInUseException"
+)
+
 var ErrFoundResource = errors.New(`found resource`)
 
 // RetryUntilNotFound retries the specified function until it returns a retry.NotFoundError.
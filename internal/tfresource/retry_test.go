@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -81,6 +82,79 @@ func TestRetryWhenAWSErrCodeEquals(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 }
 
 //nolint:tparallel
+func TestRetryWhenConflict(t *testing.T) {
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	var retryCount int32
+
+	testCases := []struct {
+		Name        string
+		F           func() (interface{}, error)
+		ExpectError bool
+	}{
+		{
+			Name: "no error",
+			F: func() (interface{}, error) {
+				return nil, nil
+			},
+		},
+		{
+			Name: "non-retryable AWS error",
+			F: func() (interface{}, error) {
+				return nil, awserr.New("ValidationException", "TestMessage", nil)
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "retryable AWS error timeout",
+			F: func() (interface{}, error) {
+				return nil, awserr.New("ResourceInUseException", "TestMessage", nil)
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "retryable AWS error success",
+			F: func() (interface{}, error) {
+				if atomic.CompareAndSwapInt32(&retryCount, 0, 1) {
+					return nil, awserr.New("ConflictException", "TestMessage", nil)
+				}
+
+				return nil, nil
+			},
+		},
+		{
+			Name: "retryable AWS SDK v2 error timeout",
+			F: func() (interface{}, error) {
+				return nil, &smithy.GenericAPIError{Code: "ResourceInUseException", Message: "TestMessage"}
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "non-retryable AWS SDK v2 error",
+			F: func() (interface{}, error) {
+				return nil, &smithy.GenericAPIError{Code: "ValidationException", Message: "TestMessage"}
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases { //nolint:paralleltest
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			retryCount = 0
+
+			_, err := tfresource.RetryWhenConflict(ctx, 5*time.Second, testCase.F)
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestRetryWhenAWSErrMessageContains(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	ctx := acctest.Context(t)
 	t.Parallel()
@@ -0,0 +1,111 @@
+package verify
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	// Embed the IANA Time Zone database so that time zones can be validated
+	// regardless of the zoneinfo available on the host running Terraform.
+	_ "time/tzdata"
+)
+
+// Validators for values used by Amazon Connect and related services.
+
+const connectARNService = "connect"
+
+var (
+	connectIDRegexp  = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+	e164NumberRegexp = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
+)
+
+// ValidConnectID validates that a string value is an Amazon Connect resource identifier,
+// i.e. a lower-case UUID such as an instance, queue, contact flow or user ID.
+func ValidConnectID(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if !connectIDRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q (%q) must be an Amazon Connect identifier (UUID)", k, v))
+	}
+	return
+}
+
+// ValidConnectARN validates that a string value is an Amazon Connect ARN.
+// If resource types are supplied, the ARN must be for one of them, e.g. "instance", "queue" or "traffic-distribution-group".
+func ValidConnectARN(resourceTypes ...string) schema.SchemaValidateFunc {
+	return ValidARNCheck(func(v any, k string, parsedARN arn.ARN) (ws []string, errors []error) {
+		if parsedARN.Service != connectARNService {
+			errors = append(errors, fmt.Errorf("%q (%s) is not an Amazon Connect ARN", k, v))
+			return
+		}
+
+		if len(resourceTypes) == 0 {
+			return
+		}
+
+		resourceType := connectARNResourceType(parsedARN.Resource)
+
+		for _, t := range resourceTypes {
+			if resourceType == t {
+				return
+			}
+		}
+
+		errors = append(errors, fmt.Errorf("%q (%s) must be an Amazon Connect ARN for one of %s, got %q", k, v, strings.Join(resourceTypes, ", "), resourceType))
+		return
+	})
+}
+
+// connectARNResourceType returns the resource type of an Amazon Connect ARN resource.
+// Resources that belong to an instance have the form "instance/<instance-id>/<type>/<id>".
+func connectARNResourceType(resource string) string {
+	parts := strings.Split(resource, "/")
+
+	if parts[0] == "instance" && len(parts) >= 3 {
+		return parts[2]
+	}
+
+	return parts[0]
+}
+
+// ValidE164PhoneNumber validates that a string value is a phone number in E.164 format, e.g. "+12065550100".
+func ValidE164PhoneNumber(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if !e164NumberRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q (%q) must be a valid phone number in E.164 format", k, v))
+	}
+	return
+}
+
+// ValidTimeZone validates that a string value is an IANA time zone name, e.g. "America/New_York".
+func ValidTimeZone(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	// time.LoadLocation treats "" as UTC and "Local" as the host time zone, neither of which is an IANA name
+	if value == "" || value == "Local" {
+		errors = append(errors, fmt.Errorf("%q (%q) must be a valid IANA time zone", k, v))
+		return
+	}
+
+	if _, err := time.LoadLocation(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%q) must be a valid IANA time zone: %s", k, v, err))
+	}
+	return
+}
@@ -0,0 +1,137 @@
+package verify
+
+import (
+	"testing"
+)
+
+func TestValidConnectID(t *testing.T) {
+	t.Parallel()
+
+	validIDs := []string{
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111",
+		"12345678-abcd-1234-abcd-123456789012",
+	}
+	for _, v := range validIDs {
+		_, errors := ValidConnectID(v, "queue_id")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Connect ID: %q", v, errors)
+		}
+	}
+
+	invalidIDs := []string{
+		"",
+		"AAAAAAAA-BBBB-CCCC-DDDD-111111111111",
+		"87654321-defg-1234-defg-987654321234",
+		"12345678abcd1234abcd123456789012",
+		"arn:aws:connect:us-west-2:123456789012:instance/aaaaaaaa-bbbb-cccc-dddd-111111111111", //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range invalidIDs {
+		_, errors := ValidConnectID(v, "queue_id")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Connect ID", v)
+		}
+	}
+}
+
+func TestValidConnectARN(t *testing.T) {
+	t.Parallel()
+
+	validate := ValidConnectARN("instance", "traffic-distribution-group")
+
+	validARNs := []string{
+		"arn:aws:connect:us-west-2:123456789012:instance/aaaaaaaa-bbbb-cccc-dddd-111111111111",                   //lintignore:AWSAT003,AWSAT005
+		"arn:aws:connect:us-west-2:123456789012:traffic-distribution-group/aaaaaaaa-bbbb-cccc-dddd-111111111111", //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range validARNs {
+		_, errors := validate(v, "target_arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Connect ARN: %q", v, errors)
+		}
+	}
+
+	invalidARNs := []string{
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111",
+		"arn:aws:connect:us-west-2:123456789012:instance/aaaaaaaa-bbbb-cccc-dddd-111111111111/queue/aaaaaaaa-bbbb-cccc-dddd-222222222222", //lintignore:AWSAT003,AWSAT005
+		"arn:aws:connect:us-west-2:123456789012:phone-number/aaaaaaaa-bbbb-cccc-dddd-111111111111",                                        //lintignore:AWSAT003,AWSAT005
+		"arn:aws:sqs:us-west-2:123456789012:instance/aaaaaaaa-bbbb-cccc-dddd-111111111111",                                                //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range invalidARNs {
+		_, errors := validate(v, "target_arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Connect ARN", v)
+		}
+	}
+
+	validateQueue := ValidConnectARN("queue")
+	v := "arn:aws:connect:us-west-2:123456789012:instance/aaaaaaaa-bbbb-cccc-dddd-111111111111/queue/aaaaaaaa-bbbb-cccc-dddd-222222222222" //lintignore:AWSAT003,AWSAT005
+	if _, errors := validateQueue(v, "queue_arn"); len(errors) != 0 {
+		t.Fatalf("%q should be a valid Connect queue ARN: %q", v, errors)
+	}
+
+	validateAny := ValidConnectARN()
+	v = "arn:aws:connect:us-west-2:123456789012:phone-number/aaaaaaaa-bbbb-cccc-dddd-111111111111" //lintignore:AWSAT003,AWSAT005
+	if _, errors := validateAny(v, "arn"); len(errors) != 0 {
+		t.Fatalf("%q should be a valid Connect ARN: %q", v, errors)
+	}
+}
+
+func TestValidE164PhoneNumber(t *testing.T) {
+	t.Parallel()
+
+	validNumbers := []string{
+		"+12345678912",
+		"+6598765432",
+		"+442071838750",
+	}
+	for _, v := range validNumbers {
+		_, errors := ValidE164PhoneNumber(v, "phone_number")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid E.164 phone number: %q", v, errors)
+		}
+	}
+
+	invalidNumbers := []string{
+		"12345678912",
+		"+012345678",
+		"+1234567890123456",
+		"+1 234 567 8912",
+		"abc+12345678912",
+		"invalid",
+	}
+	for _, v := range invalidNumbers {
+		_, errors := ValidE164PhoneNumber(v, "phone_number")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid E.164 phone number: %q", v, errors)
+		}
+	}
+}
+
+func TestValidTimeZone(t *testing.T) {
+	t.Parallel()
+
+	validTimeZones := []string{
+		"America/New_York",
+		"Asia/Singapore",
+		"Europe/London",
+		"UTC",
+	}
+	for _, v := range validTimeZones {
+		_, errors := ValidTimeZone(v, "time_zone")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid time zone: %q", v, errors)
+		}
+	}
+
+	invalidTimeZones := []string{
+		"",
+		"Local",
+		"America/Atlantis",
+		"invalid",
+	}
+	for _, v := range invalidTimeZones {
+		_, errors := ValidTimeZone(v, "time_zone")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid time zone: %q", v, errors)
+		}
+	}
+}
@@ -71,6 +71,8 @@ const (
 	ComputeOptimizer             = "computeoptimizer"
 	ConfigService                = "configservice"
 	Connect                      = "connect"
+	ConnectCampaigns             = "connectcampaigns"
+	ConnectCases                 = "connectcases"
 	ConnectContactLens           = "connectcontactlens"
 	ConnectParticipant           = "connectparticipant"
 	ControlTower                 = "controltower"
@@ -187,7 +189,6 @@ const (
 	MTurk                        = "mturk"
 	MWAA                         = "mwaa"
 	MachineLearning              = "machinelearning"
-	Macie                        = "macie"
 	Macie2                       = "macie2"
 	ManagedBlockchain            = "managedblockchain"
 	MarketplaceCatalog           = "marketplacecatalog"
@@ -232,6 +233,7 @@ const (
 	Polly                        = "polly"
 	Pricing                      = "pricing"
 	Proton                       = "proton"
+	QConnect                     = "qconnect"
 	QLDB                         = "qldb"
 	QLDBSession                  = "qldbsession"
 	QuickSight                   = "quicksight"
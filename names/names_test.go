@@ -137,7 +137,6 @@ func TestServicesForDirectories(t *testing.T) {
 		"lookoutmetrics",
 		"lookoutvision",
 		"machinelearning",
-		"macie",
 		"managedblockchain",
 		"marketplacecatalog",
 		"marketplacecommerceanalytics",
@@ -190,9 +189,7 @@ func TestServicesForDirectories(t *testing.T) {
 		"timestreamquery",
 		"transcribestreaming",
 		"translate",
-		"voiceid",
 		"wellarchitected",
-		"wisdom",
 		"workdocs",
 		"workmail",
 		"workmailmessageflow",